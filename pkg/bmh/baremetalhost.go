@@ -2,6 +2,7 @@ package bmh
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -14,6 +15,7 @@ import (
 	bmhv1alpha1 "github.com/metal3-io/baremetal-operator/apis/metal3.io/v1alpha1"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/waiter"
 	"golang.org/x/exp/slices"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -24,7 +26,7 @@ import (
 type BmhBuilder struct {
 	Definition *bmhv1alpha1.BareMetalHost
 	Object     *bmhv1alpha1.BareMetalHost
-	apiClient  goclient.Client
+	apiClient  *clients.Settings
 	errorMsg   string
 }
 
@@ -48,7 +50,7 @@ func NewBuilder(
 	}
 
 	builder := &BmhBuilder{
-		apiClient: apiClient.Client,
+		apiClient: apiClient,
 		Definition: &bmhv1alpha1.BareMetalHost{
 			Spec: bmhv1alpha1.BareMetalHostSpec{
 
@@ -384,7 +386,7 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*BmhBuilder, error)
 	}
 
 	builder := &BmhBuilder{
-		apiClient: apiClient.Client,
+		apiClient: apiClient,
 		Definition: &bmhv1alpha1.BareMetalHost{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      name,
@@ -416,6 +418,11 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*BmhBuilder, error)
 
 // Create makes a bmh in the cluster and stores the created object in struct.
 func (builder *BmhBuilder) Create() (*BmhBuilder, error) {
+	return builder.CreateWithContext(context.Background())
+}
+
+// CreateWithContext makes a bmh in the cluster using the provided context and stores the created object in struct.
+func (builder *BmhBuilder) CreateWithContext(ctx context.Context) (*BmhBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
@@ -424,8 +431,8 @@ func (builder *BmhBuilder) Create() (*BmhBuilder, error) {
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
-	if !builder.Exists() {
-		err = builder.apiClient.Create(context.TODO(), builder.Definition)
+	if !builder.ExistsWithContext(ctx) {
+		err = builder.apiClient.Create(ctx, builder.Definition)
 		if err == nil {
 			builder.Object = builder.Definition
 		}
@@ -436,6 +443,11 @@ func (builder *BmhBuilder) Create() (*BmhBuilder, error) {
 
 // Delete removes bmh from a cluster.
 func (builder *BmhBuilder) Delete() (*BmhBuilder, error) {
+	return builder.DeleteWithContext(context.Background())
+}
+
+// DeleteWithContext removes bmh from a cluster using the provided context.
+func (builder *BmhBuilder) DeleteWithContext(ctx context.Context) (*BmhBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
@@ -443,7 +455,7 @@ func (builder *BmhBuilder) Delete() (*BmhBuilder, error) {
 	glog.V(100).Infof("Deleting the baremetalhost %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		glog.V(100).Infof("bmh %s namespace: %s cannot be deleted because it does not exist",
 			builder.Definition.Name, builder.Definition.Namespace)
 
@@ -452,7 +464,7 @@ func (builder *BmhBuilder) Delete() (*BmhBuilder, error) {
 		return builder, nil
 	}
 
-	err := builder.apiClient.Delete(context.TODO(), builder.Definition)
+	err := builder.apiClient.Delete(ctx, builder.Definition)
 
 	if err != nil {
 		return builder, fmt.Errorf("can not delete bmh: %w", err)
@@ -465,6 +477,11 @@ func (builder *BmhBuilder) Delete() (*BmhBuilder, error) {
 
 // Get returns bmh object if found.
 func (builder *BmhBuilder) Get() (*bmhv1alpha1.BareMetalHost, error) {
+	return builder.GetWithContext(context.Background())
+}
+
+// GetWithContext returns bmh object if found using the provided context.
+func (builder *BmhBuilder) GetWithContext(ctx context.Context) (*bmhv1alpha1.BareMetalHost, error) {
 	if valid, err := builder.validate(); !valid {
 		return nil, err
 	}
@@ -473,7 +490,7 @@ func (builder *BmhBuilder) Get() (*bmhv1alpha1.BareMetalHost, error) {
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	bmh := &bmhv1alpha1.BareMetalHost{}
-	err := builder.apiClient.Get(context.TODO(), goclient.ObjectKey{
+	err := builder.apiClient.Get(ctx, goclient.ObjectKey{
 		Name:      builder.Definition.Name,
 		Namespace: builder.Definition.Namespace,
 	}, bmh)
@@ -487,6 +504,11 @@ func (builder *BmhBuilder) Get() (*bmhv1alpha1.BareMetalHost, error) {
 
 // Exists checks whether the given bmh exists.
 func (builder *BmhBuilder) Exists() bool {
+	return builder.ExistsWithContext(context.Background())
+}
+
+// ExistsWithContext checks whether the given bmh exists using the provided context.
+func (builder *BmhBuilder) ExistsWithContext(ctx context.Context) bool {
 	if valid, _ := builder.validate(); !valid {
 		return false
 	}
@@ -495,7 +517,7 @@ func (builder *BmhBuilder) Exists() bool {
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
-	builder.Object, err = builder.Get()
+	builder.Object, err = builder.GetWithContext(ctx)
 
 	return err == nil || !k8serrors.IsNotFound(err)
 }
@@ -534,6 +556,12 @@ func (builder *BmhBuilder) GetBmhPowerOnStatus() bool {
 
 // CreateAndWaitUntilProvisioned creates bmh object and waits until bmh is provisioned.
 func (builder *BmhBuilder) CreateAndWaitUntilProvisioned(timeout time.Duration) (*BmhBuilder, error) {
+	return builder.CreateAndWaitUntilProvisionedWithContext(context.Background(), timeout)
+}
+
+// CreateAndWaitUntilProvisionedWithContext creates bmh object and waits until bmh is provisioned or ctx is done.
+func (builder *BmhBuilder) CreateAndWaitUntilProvisionedWithContext(
+	ctx context.Context, timeout time.Duration) (*BmhBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
@@ -542,61 +570,107 @@ func (builder *BmhBuilder) CreateAndWaitUntilProvisioned(timeout time.Duration)
 	waiting for the defined period until it is created`,
 		builder.Definition.Name, builder.Definition.Namespace)
 
-	builder, err := builder.Create()
+	builder, err := builder.CreateWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	err = builder.WaitUntilProvisioned(timeout)
+	err = builder.WaitUntilProvisionedWithContext(ctx, timeout)
 
 	return builder, err
 }
 
 // WaitUntilProvisioned waits for timeout duration or until bmh is provisioned.
 func (builder *BmhBuilder) WaitUntilProvisioned(timeout time.Duration) error {
-	return builder.WaitUntilInStatus(bmhv1alpha1.StateProvisioned, timeout)
+	return builder.WaitUntilProvisionedWithContext(context.Background(), timeout)
+}
+
+// WaitUntilProvisionedWithContext waits for timeout duration, until ctx is done or until bmh is provisioned.
+func (builder *BmhBuilder) WaitUntilProvisionedWithContext(ctx context.Context, timeout time.Duration) error {
+	return builder.WaitUntilInStatusWithContext(ctx, bmhv1alpha1.StateProvisioned, timeout)
 }
 
 // WaitUntilProvisioning waits for timeout duration or until bmh is provisioning.
 func (builder *BmhBuilder) WaitUntilProvisioning(timeout time.Duration) error {
-	return builder.WaitUntilInStatus(bmhv1alpha1.StateProvisioning, timeout)
+	return builder.WaitUntilProvisioningWithContext(context.Background(), timeout)
+}
+
+// WaitUntilProvisioningWithContext waits for timeout duration, until ctx is done or until bmh is provisioning.
+func (builder *BmhBuilder) WaitUntilProvisioningWithContext(ctx context.Context, timeout time.Duration) error {
+	return builder.WaitUntilInStatusWithContext(ctx, bmhv1alpha1.StateProvisioning, timeout)
 }
 
 // WaitUntilReady waits for timeout duration or until bmh is ready.
 func (builder *BmhBuilder) WaitUntilReady(timeout time.Duration) error {
-	return builder.WaitUntilInStatus(bmhv1alpha1.StateReady, timeout)
+	return builder.WaitUntilReadyWithContext(context.Background(), timeout)
+}
+
+// WaitUntilReadyWithContext waits for timeout duration, until ctx is done or until bmh is ready.
+func (builder *BmhBuilder) WaitUntilReadyWithContext(ctx context.Context, timeout time.Duration) error {
+	return builder.WaitUntilInStatusWithContext(ctx, bmhv1alpha1.StateReady, timeout)
 }
 
 // WaitUntilAvailable waits for timeout duration or until bmh is available.
 func (builder *BmhBuilder) WaitUntilAvailable(timeout time.Duration) error {
-	return builder.WaitUntilInStatus(bmhv1alpha1.StateAvailable, timeout)
+	return builder.WaitUntilAvailableWithContext(context.Background(), timeout)
+}
+
+// WaitUntilAvailableWithContext waits for timeout duration, until ctx is done or until bmh is available.
+func (builder *BmhBuilder) WaitUntilAvailableWithContext(ctx context.Context, timeout time.Duration) error {
+	return builder.WaitUntilInStatusWithContext(ctx, bmhv1alpha1.StateAvailable, timeout)
 }
 
-// WaitUntilInStatus waits for timeout duration or until bmh gets to a specific status.
+// WaitUntilInStatus waits for timeout duration or until bmh gets to a specific status. On timeout, the returned
+// waiter.WaitTimeoutError includes the last status and the events of the bmh.
 func (builder *BmhBuilder) WaitUntilInStatus(status bmhv1alpha1.ProvisioningState, timeout time.Duration) error {
+	return builder.WaitUntilInStatusWithContext(context.Background(), status, timeout)
+}
+
+// WaitUntilInStatusWithContext waits for timeout duration, until ctx is done or until bmh gets to a specific status.
+func (builder *BmhBuilder) WaitUntilInStatusWithContext(
+	ctx context.Context, status bmhv1alpha1.ProvisioningState, timeout time.Duration) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
 
-	return wait.PollUntilContextTimeout(
-		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
-			var err error
-			builder.Object, err = builder.Get()
+	glog.V(100).Infof("Waiting for the defined period until baremetalhost %s in namespace %s has status %s",
+		builder.Definition.Name, builder.Definition.Namespace, status)
 
-			if err != nil {
-				return false, nil
-			}
+	source := waiter.WithEvents(
+		waiter.NewRuntimeSource[bmhv1alpha1.BareMetalHost](
+			builder.apiClient.Client, builder.Definition.Namespace, builder.Definition.Name),
+		builder.apiClient, "BareMetalHost", builder.Definition.Namespace)
 
-			if builder.Object.Status.Provisioning.State == status {
-				return true, nil
-			}
+	bmh, err := waiter.For(ctx, source, waiter.Options{
+		Description: fmt.Sprintf("baremetalhost %s in namespace %s to have status %s",
+			builder.Definition.Name, builder.Definition.Namespace, status),
+		Timeout: timeout,
+	}, func(bmh *bmhv1alpha1.BareMetalHost) (bool, error) {
+		return bmh.Status.Provisioning.State == status, nil
+	})
+	if err != nil {
+		var timeoutErr *waiter.WaitTimeoutError
 
-			return false, err
-		})
+		if errors.As(err, &timeoutErr) && timeoutErr.LastObject != nil {
+			builder.Object, _ = timeoutErr.LastObject.(*bmhv1alpha1.BareMetalHost)
+		}
+
+		return err
+	}
+
+	builder.Object = bmh
+
+	return nil
 }
 
 // DeleteAndWaitUntilDeleted delete bmh object and waits until deleted.
 func (builder *BmhBuilder) DeleteAndWaitUntilDeleted(timeout time.Duration) (*BmhBuilder, error) {
+	return builder.DeleteAndWaitUntilDeletedWithContext(context.Background(), timeout)
+}
+
+// DeleteAndWaitUntilDeletedWithContext delete bmh object and waits until deleted or ctx is done.
+func (builder *BmhBuilder) DeleteAndWaitUntilDeletedWithContext(
+	ctx context.Context, timeout time.Duration) (*BmhBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
@@ -605,25 +679,30 @@ func (builder *BmhBuilder) DeleteAndWaitUntilDeleted(timeout time.Duration) (*Bm
 	waiting for the defined period until it is removed`,
 		builder.Definition.Name, builder.Definition.Namespace)
 
-	builder, err := builder.Delete()
+	builder, err := builder.DeleteWithContext(ctx)
 	if err != nil {
 		return builder, err
 	}
 
-	err = builder.WaitUntilDeleted(timeout)
+	err = builder.WaitUntilDeletedWithContext(ctx, timeout)
 
 	return nil, err
 }
 
 // WaitUntilDeleted waits for timeout duration or until bmh is deleted.
 func (builder *BmhBuilder) WaitUntilDeleted(timeout time.Duration) error {
+	return builder.WaitUntilDeletedWithContext(context.Background(), timeout)
+}
+
+// WaitUntilDeletedWithContext waits for timeout duration, until ctx is done or until bmh is deleted.
+func (builder *BmhBuilder) WaitUntilDeletedWithContext(ctx context.Context, timeout time.Duration) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
 
 	err := wait.PollUntilContextTimeout(
-		context.TODO(), time.Second, timeout, false, func(ctx context.Context) (bool, error) {
-			_, err := builder.Get()
+		ctx, time.Second, timeout, false, func(ctx context.Context) (bool, error) {
+			_, err := builder.GetWithContext(ctx)
 			if err == nil {
 				glog.V(100).Infof("bmh %s/%s still present",
 					builder.Definition.Namespace,
@@ -652,6 +731,13 @@ func (builder *BmhBuilder) WaitUntilDeleted(timeout time.Duration) error {
 
 // WaitUntilAnnotationExists waits up to the specified timeout until the annotation exists.
 func (builder *BmhBuilder) WaitUntilAnnotationExists(annotation string, timeout time.Duration) (*BmhBuilder, error) {
+	return builder.WaitUntilAnnotationExistsWithContext(context.Background(), annotation, timeout)
+}
+
+// WaitUntilAnnotationExistsWithContext waits up to the specified timeout or until ctx is done until the annotation
+// exists.
+func (builder *BmhBuilder) WaitUntilAnnotationExistsWithContext(
+	ctx context.Context, annotation string, timeout time.Duration) (*BmhBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return nil, err
 	}
@@ -666,15 +752,15 @@ func (builder *BmhBuilder) WaitUntilAnnotationExists(annotation string, timeout
 		"Waiting until BMH %s in namespace %s has annotation %s",
 		builder.Definition.Name, builder.Definition.Namespace, annotation)
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		return nil, fmt.Errorf(
 			"baremetalhost object %s does not exist in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
 	}
 
 	var err error
 	err = wait.PollUntilContextTimeout(
-		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
-			builder.Object, err = builder.Get()
+		ctx, time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			builder.Object, err = builder.GetWithContext(ctx)
 			if err != nil {
 				glog.V(100).Infof("failed to get bmh %s/%s: %v", builder.Definition.Namespace, builder.Definition.Name, err)
 
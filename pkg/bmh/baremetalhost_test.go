@@ -2,13 +2,16 @@ package bmh
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 	"time"
 
 	bmhv1alpha1 "github.com/metal3-io/baremetal-operator/apis/metal3.io/v1alpha1"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/waiter"
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
@@ -748,7 +751,7 @@ func TestBareMetalHostCreateAndWaitUntilProvisioned(t *testing.T) {
 		},
 		{
 			testBmHost:    buildValidBmHostBuilder(clients.GetTestClients(clients.TestClientParams{})),
-			expectedError: fmt.Errorf("context deadline exceeded"),
+			expectedError: context.DeadlineExceeded,
 		},
 		{
 			testBmHost:    buildInValidBmHostBuilder(buildBareMetalHostTestClientWithDummyObject()),
@@ -762,6 +765,8 @@ func TestBareMetalHostCreateAndWaitUntilProvisioned(t *testing.T) {
 			assert.Nil(t, err)
 			assert.Equal(t, ipAddressPoolBuilder.Definition.Name, ipAddressPoolBuilder.Object.Name)
 			assert.Equal(t, ipAddressPoolBuilder.Definition.Namespace, ipAddressPoolBuilder.Object.Namespace)
+		} else if errors.Is(testCase.expectedError, context.DeadlineExceeded) {
+			assert.ErrorIs(t, err, testCase.expectedError)
 		} else {
 			assert.Equal(t, testCase.expectedError.Error(), err.Error())
 		}
@@ -779,11 +784,11 @@ func TestBareMetalHostWaitUntilProvisioned(t *testing.T) {
 		},
 		{
 			testBmHost:    buildValidBmHostBuilder(buildBareMetalHostTestClientWithDummyObject(bmhv1alpha1.StateDeprovisioning)),
-			expectedError: fmt.Errorf("context deadline exceeded"),
+			expectedError: context.DeadlineExceeded,
 		},
 		{
 			testBmHost:    buildValidBmHostBuilder(clients.GetTestClients(clients.TestClientParams{})),
-			expectedError: fmt.Errorf("context deadline exceeded"),
+			expectedError: context.DeadlineExceeded,
 		},
 		{
 			testBmHost:    buildInValidBmHostBuilder(buildBareMetalHostTestClientWithDummyObject()),
@@ -793,14 +798,70 @@ func TestBareMetalHostWaitUntilProvisioned(t *testing.T) {
 
 	for _, testCase := range testCases {
 		err := testCase.testBmHost.WaitUntilProvisioned(1 * time.Millisecond)
-		if testCase.expectedError != nil {
-			assert.Equal(t, testCase.expectedError.Error(), err.Error())
-		} else {
+		switch {
+		case testCase.expectedError == nil:
 			assert.Nil(t, err)
+		case errors.Is(testCase.expectedError, context.DeadlineExceeded):
+			assert.ErrorIs(t, err, testCase.expectedError)
+		default:
+			assert.Equal(t, testCase.expectedError.Error(), err.Error())
 		}
 	}
 }
 
+func TestBareMetalHostWaitUntilProvisionedSimulated(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects:  buildDummyBmHostObject(bmhv1alpha1.StateProvisioning),
+		SchemeAttachers: testSchemes,
+		Simulators: []clients.ControllerSimulator{{
+			Object:   &bmhv1alpha1.BareMetalHost{},
+			Triggers: []clients.SimulatorTrigger{clients.SimulatorTriggerRead},
+			Reconcile: clients.SimulateAfter(2, func(object runtime.Object) {
+				object.(*bmhv1alpha1.BareMetalHost).Status.Provisioning.State = bmhv1alpha1.StateProvisioned
+			}),
+		}},
+	})
+
+	err := buildValidBmHostBuilder(testSettings).WaitUntilProvisioned(5 * time.Second)
+	assert.Nil(t, err)
+}
+
+func TestBareMetalHostWaitUntilProvisionedTimeout(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: append(buildDummyBmHostObject(bmhv1alpha1.StateProvisioning), &corev1.Event{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-event", Namespace: defaultBmHostNsName},
+			InvolvedObject: corev1.ObjectReference{
+				Kind: "BareMetalHost", Name: defaultBmHostName, Namespace: defaultBmHostNsName},
+			Type:    corev1.EventTypeWarning,
+			Reason:  "ProvisioningError",
+			Message: "image download failed",
+		}),
+		SchemeAttachers: testSchemes,
+	})
+
+	testBmHost := buildValidBmHostBuilder(testSettings)
+	err := testBmHost.WaitUntilProvisioned(500 * time.Millisecond)
+
+	var timeoutErr *waiter.WaitTimeoutError
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.True(t, errors.As(err, &timeoutErr))
+	assert.Len(t, timeoutErr.Events, 1)
+	assert.Contains(t, err.Error(), "Warning ProvisioningError: image download failed")
+	assert.NotNil(t, testBmHost.Object)
+	assert.Equal(t, bmhv1alpha1.StateProvisioning, testBmHost.Object.Status.Provisioning.State)
+}
+
+func TestBareMetalHostWaitUntilProvisionedWithContext(t *testing.T) {
+	testSettings := buildBareMetalHostTestClientWithDummyObject(bmhv1alpha1.StateProvisioning)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	err := buildValidBmHostBuilder(testSettings).WaitUntilProvisionedWithContext(ctx, time.Minute)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
 func TestBareMetalHostWaitUntilProvisioning(t *testing.T) {
 	testCases := []struct {
 		testBmHost    *BmhBuilder
@@ -812,11 +873,11 @@ func TestBareMetalHostWaitUntilProvisioning(t *testing.T) {
 		},
 		{
 			testBmHost:    buildValidBmHostBuilder(buildBareMetalHostTestClientWithDummyObject()),
-			expectedError: fmt.Errorf("context deadline exceeded"),
+			expectedError: context.DeadlineExceeded,
 		},
 		{
 			testBmHost:    buildValidBmHostBuilder(clients.GetTestClients(clients.TestClientParams{})),
-			expectedError: fmt.Errorf("context deadline exceeded"),
+			expectedError: context.DeadlineExceeded,
 		},
 		{
 			testBmHost:    buildInValidBmHostBuilder(buildBareMetalHostTestClientWithDummyObject()),
@@ -826,10 +887,13 @@ func TestBareMetalHostWaitUntilProvisioning(t *testing.T) {
 
 	for _, testCase := range testCases {
 		err := testCase.testBmHost.WaitUntilProvisioning(1 * time.Millisecond)
-		if testCase.expectedError != nil {
-			assert.Equal(t, testCase.expectedError.Error(), err.Error())
-		} else {
+		switch {
+		case testCase.expectedError == nil:
 			assert.Nil(t, err)
+		case errors.Is(testCase.expectedError, context.DeadlineExceeded):
+			assert.ErrorIs(t, err, testCase.expectedError)
+		default:
+			assert.Equal(t, testCase.expectedError.Error(), err.Error())
 		}
 	}
 }
@@ -845,11 +909,11 @@ func TestBareMetalHostWaitUntilReady(t *testing.T) {
 		},
 		{
 			testBmHost:    buildValidBmHostBuilder(buildBareMetalHostTestClientWithDummyObject()),
-			expectedError: fmt.Errorf("context deadline exceeded"),
+			expectedError: context.DeadlineExceeded,
 		},
 		{
 			testBmHost:    buildValidBmHostBuilder(clients.GetTestClients(clients.TestClientParams{})),
-			expectedError: fmt.Errorf("context deadline exceeded"),
+			expectedError: context.DeadlineExceeded,
 		},
 		{
 			testBmHost:    buildInValidBmHostBuilder(buildBareMetalHostTestClientWithDummyObject()),
@@ -859,10 +923,13 @@ func TestBareMetalHostWaitUntilReady(t *testing.T) {
 
 	for _, testCase := range testCases {
 		err := testCase.testBmHost.WaitUntilReady(1 * time.Millisecond)
-		if testCase.expectedError != nil {
-			assert.Equal(t, testCase.expectedError.Error(), err.Error())
-		} else {
+		switch {
+		case testCase.expectedError == nil:
 			assert.Nil(t, err)
+		case errors.Is(testCase.expectedError, context.DeadlineExceeded):
+			assert.ErrorIs(t, err, testCase.expectedError)
+		default:
+			assert.Equal(t, testCase.expectedError.Error(), err.Error())
 		}
 	}
 }
@@ -878,11 +945,11 @@ func TestBareMetalHostWaitUntilAvailable(t *testing.T) {
 		},
 		{
 			testBmHost:    buildValidBmHostBuilder(buildBareMetalHostTestClientWithDummyObject()),
-			expectedError: fmt.Errorf("context deadline exceeded"),
+			expectedError: context.DeadlineExceeded,
 		},
 		{
 			testBmHost:    buildValidBmHostBuilder(clients.GetTestClients(clients.TestClientParams{})),
-			expectedError: fmt.Errorf("context deadline exceeded"),
+			expectedError: context.DeadlineExceeded,
 		},
 		{
 			testBmHost:    buildInValidBmHostBuilder(buildBareMetalHostTestClientWithDummyObject()),
@@ -892,10 +959,13 @@ func TestBareMetalHostWaitUntilAvailable(t *testing.T) {
 
 	for _, testCase := range testCases {
 		err := testCase.testBmHost.WaitUntilAvailable(1 * time.Millisecond)
-		if testCase.expectedError != nil {
-			assert.Equal(t, testCase.expectedError.Error(), err.Error())
-		} else {
+		switch {
+		case testCase.expectedError == nil:
 			assert.Nil(t, err)
+		case errors.Is(testCase.expectedError, context.DeadlineExceeded):
+			assert.ErrorIs(t, err, testCase.expectedError)
+		default:
+			assert.Equal(t, testCase.expectedError.Error(), err.Error())
 		}
 	}
 }
@@ -911,15 +981,15 @@ func TestBareMetalHostWaitUntilInStatus(t *testing.T) {
 		},
 		{
 			testBmHost:    buildValidBmHostBuilder(buildBareMetalHostTestClientWithDummyObject(bmhv1alpha1.StateProvisioning)),
-			expectedError: fmt.Errorf("context deadline exceeded"),
+			expectedError: context.DeadlineExceeded,
 		},
 		{
 			testBmHost:    buildValidBmHostBuilder(buildBareMetalHostTestClientWithDummyObject()),
-			expectedError: fmt.Errorf("context deadline exceeded"),
+			expectedError: context.DeadlineExceeded,
 		},
 		{
 			testBmHost:    buildValidBmHostBuilder(clients.GetTestClients(clients.TestClientParams{})),
-			expectedError: fmt.Errorf("context deadline exceeded"),
+			expectedError: context.DeadlineExceeded,
 		},
 		{
 			testBmHost:    buildInValidBmHostBuilder(buildBareMetalHostTestClientWithDummyObject()),
@@ -929,10 +999,13 @@ func TestBareMetalHostWaitUntilInStatus(t *testing.T) {
 
 	for _, testCase := range testCases {
 		err := testCase.testBmHost.WaitUntilInStatus(bmhv1alpha1.StateAvailable, 1*time.Millisecond)
-		if testCase.expectedError != nil {
-			assert.Equal(t, testCase.expectedError.Error(), err.Error())
-		} else {
+		switch {
+		case testCase.expectedError == nil:
 			assert.Nil(t, err)
+		case errors.Is(testCase.expectedError, context.DeadlineExceeded):
+			assert.ErrorIs(t, err, testCase.expectedError)
+		default:
+			assert.Equal(t, testCase.expectedError.Error(), err.Error())
 		}
 	}
 }
@@ -72,6 +72,11 @@ func PullDataImage(apiClient *clients.Settings, name, nsname string) (*DataImage
 
 // Delete removes the dataimage from the cluster.
 func (builder *DataImageBuilder) Delete() (*DataImageBuilder, error) {
+	return builder.DeleteWithContext(context.Background())
+}
+
+// DeleteWithContext removes the dataimage from the cluster using the provided context.
+func (builder *DataImageBuilder) DeleteWithContext(ctx context.Context) (*DataImageBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
@@ -79,7 +84,7 @@ func (builder *DataImageBuilder) Delete() (*DataImageBuilder, error) {
 	glog.V(100).Infof("Deleting the dataimage %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		glog.V(100).Infof("dataimage %s namespace: %s cannot be deleted because it does not exist",
 			builder.Definition.Name, builder.Definition.Namespace)
 
@@ -88,7 +93,7 @@ func (builder *DataImageBuilder) Delete() (*DataImageBuilder, error) {
 		return builder, nil
 	}
 
-	err := builder.apiClient.Delete(context.TODO(), builder.Definition)
+	err := builder.apiClient.Delete(ctx, builder.Definition)
 
 	if err != nil {
 		return builder, fmt.Errorf("cannot delete dataimage: %w", err)
@@ -101,6 +106,11 @@ func (builder *DataImageBuilder) Delete() (*DataImageBuilder, error) {
 
 // Get returns dataimage object if found.
 func (builder *DataImageBuilder) Get() (*bmhv1alpha1.DataImage, error) {
+	return builder.GetWithContext(context.Background())
+}
+
+// GetWithContext returns dataimage object if found using the provided context.
+func (builder *DataImageBuilder) GetWithContext(ctx context.Context) (*bmhv1alpha1.DataImage, error) {
 	if valid, err := builder.validate(); !valid {
 		return nil, err
 	}
@@ -109,7 +119,7 @@ func (builder *DataImageBuilder) Get() (*bmhv1alpha1.DataImage, error) {
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	dataimage := &bmhv1alpha1.DataImage{}
-	err := builder.apiClient.Get(context.TODO(), goclient.ObjectKey{
+	err := builder.apiClient.Get(ctx, goclient.ObjectKey{
 		Name:      builder.Definition.Name,
 		Namespace: builder.Definition.Namespace,
 	}, dataimage)
@@ -123,6 +133,11 @@ func (builder *DataImageBuilder) Get() (*bmhv1alpha1.DataImage, error) {
 
 // Exists checks whether the given dataimage exists.
 func (builder *DataImageBuilder) Exists() bool {
+	return builder.ExistsWithContext(context.Background())
+}
+
+// ExistsWithContext checks whether the given dataimage exists using the provided context.
+func (builder *DataImageBuilder) ExistsWithContext(ctx context.Context) bool {
 	if valid, _ := builder.validate(); !valid {
 		return false
 	}
@@ -131,7 +146,7 @@ func (builder *DataImageBuilder) Exists() bool {
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
-	builder.Object, err = builder.Get()
+	builder.Object, err = builder.GetWithContext(ctx)
 
 	return err == nil || !k8serrors.IsNotFound(err)
 }
@@ -73,6 +73,11 @@ func PullHFS(apiClient *clients.Settings, name, nsname string) (*HFSBuilder, err
 
 // Get returns the HostFirmwareSettings object if found.
 func (builder *HFSBuilder) Get() (*bmhv1alpha1.HostFirmwareSettings, error) {
+	return builder.GetWithContext(context.Background())
+}
+
+// GetWithContext returns the HostFirmwareSettings object if found using the provided context.
+func (builder *HFSBuilder) GetWithContext(ctx context.Context) (*bmhv1alpha1.HostFirmwareSettings, error) {
 	if valid, err := builder.validate(); !valid {
 		return nil, err
 	}
@@ -81,7 +86,7 @@ func (builder *HFSBuilder) Get() (*bmhv1alpha1.HostFirmwareSettings, error) {
 		"Getting HostFirmwareSettings object %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
 
 	hostFirmwareSettings := &bmhv1alpha1.HostFirmwareSettings{}
-	err := builder.apiClient.Get(context.TODO(), goclient.ObjectKey{
+	err := builder.apiClient.Get(ctx, goclient.ObjectKey{
 		Name:      builder.Definition.Name,
 		Namespace: builder.Definition.Namespace,
 	}, hostFirmwareSettings)
@@ -99,6 +104,11 @@ func (builder *HFSBuilder) Get() (*bmhv1alpha1.HostFirmwareSettings, error) {
 
 // Exists checks whether the given HostFirmwareSettings exists on the cluster.
 func (builder *HFSBuilder) Exists() bool {
+	return builder.ExistsWithContext(context.Background())
+}
+
+// ExistsWithContext checks whether the given HostFirmwareSettings exists on the cluster using the provided context.
+func (builder *HFSBuilder) ExistsWithContext(ctx context.Context) bool {
 	if valid, _ := builder.validate(); !valid {
 		return false
 	}
@@ -107,13 +117,19 @@ func (builder *HFSBuilder) Exists() bool {
 		"Checking if HostFirmwareSettings %s exists in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
-	builder.Object, err = builder.Get()
+	builder.Object, err = builder.GetWithContext(ctx)
 
 	return err == nil || !k8serrors.IsNotFound(err)
 }
 
 // Create makes a HostFirmwareSettings on the cluster if it does not already exist.
 func (builder *HFSBuilder) Create() (*HFSBuilder, error) {
+	return builder.CreateWithContext(context.Background())
+}
+
+// CreateWithContext makes a HostFirmwareSettings on the cluster if it does not already exist using the provided
+// context.
+func (builder *HFSBuilder) CreateWithContext(ctx context.Context) (*HFSBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return nil, err
 	}
@@ -121,11 +137,11 @@ func (builder *HFSBuilder) Create() (*HFSBuilder, error) {
 	glog.V(100).Infof(
 		"Creating HostFirmwareSettings %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
 
-	if builder.Exists() {
+	if builder.ExistsWithContext(ctx) {
 		return builder, nil
 	}
 
-	err := builder.apiClient.Create(context.TODO(), builder.Definition)
+	err := builder.apiClient.Create(ctx, builder.Definition)
 	if err != nil {
 		return nil, err
 	}
@@ -137,6 +153,11 @@ func (builder *HFSBuilder) Create() (*HFSBuilder, error) {
 
 // Delete removes a HostFirmwareSettings from the cluster if it exists.
 func (builder *HFSBuilder) Delete() error {
+	return builder.DeleteWithContext(context.Background())
+}
+
+// DeleteWithContext removes a HostFirmwareSettings from the cluster if it exists using the provided context.
+func (builder *HFSBuilder) DeleteWithContext(ctx context.Context) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
@@ -144,7 +165,7 @@ func (builder *HFSBuilder) Delete() error {
 	glog.V(100).Infof(
 		"Deleting HostFirmwareSettings %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		glog.V(100).Infof(
 			"HostFirmwareSettings %s in namespace %s does not exist",
 			builder.Definition.Name, builder.Definition.Namespace)
@@ -154,7 +175,7 @@ func (builder *HFSBuilder) Delete() error {
 		return nil
 	}
 
-	err := builder.apiClient.Delete(context.TODO(), builder.Object)
+	err := builder.apiClient.Delete(ctx, builder.Object)
 	if err != nil {
 		return err
 	}
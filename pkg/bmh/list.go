@@ -20,6 +20,15 @@ const (
 
 // List returns bareMetalHosts inventory in the given namespace.
 func List(apiClient *clients.Settings, nsname string, options ...goclient.ListOptions) ([]*BmhBuilder, error) {
+	return ListWithContext(context.Background(), apiClient, nsname, options...)
+}
+
+// ListWithContext returns bareMetalHosts inventory in the given namespace using the provided context.
+func ListWithContext(
+	ctx context.Context,
+	apiClient *clients.Settings,
+	nsname string,
+	options ...goclient.ListOptions) ([]*BmhBuilder, error) {
 	if apiClient == nil || apiClient.Client == nil {
 		glog.V(100).Infof("BareMetalHosts 'apiClient' parameter can not be empty")
 
@@ -50,11 +59,18 @@ func List(apiClient *clients.Settings, nsname string, options ...goclient.ListOp
 
 	glog.V(100).Infof(logMessage)
 
-	return list(apiClient, passedOptions)
+	return list(ctx, apiClient, passedOptions)
 }
 
 // ListInAllNamespaces lists the BareMetalHosts across all namespaces on the provided cluster.
 func ListInAllNamespaces(apiClient *clients.Settings, options ...goclient.ListOptions) ([]*BmhBuilder, error) {
+	return ListInAllNamespacesWithContext(context.Background(), apiClient, options...)
+}
+
+// ListInAllNamespacesWithContext lists the BareMetalHosts across all namespaces on the provided cluster using the
+// provided context.
+func ListInAllNamespacesWithContext(
+	ctx context.Context, apiClient *clients.Settings, options ...goclient.ListOptions) ([]*BmhBuilder, error) {
 	if apiClient == nil || apiClient.Client == nil {
 		glog.V(100).Info("BareMetalHost's 'apiClient' parameter cannot be empty")
 
@@ -77,19 +93,31 @@ func ListInAllNamespaces(apiClient *clients.Settings, options ...goclient.ListOp
 
 	glog.V(100).Info(logMessage)
 
-	return list(apiClient, passedOptions)
+	return list(ctx, apiClient, passedOptions)
 }
 
 // WaitForAllBareMetalHostsInGoodOperationalState waits for all baremetalhosts to be in good Operational State
 // for a time duration up to the timeout.
 func WaitForAllBareMetalHostsInGoodOperationalState(apiClient *clients.Settings,
+	nsname string,
+	timeout time.Duration,
+	options ...goclient.ListOptions) (bool, error) {
+	return WaitForAllBareMetalHostsInGoodOperationalStateWithContext(
+		context.Background(), apiClient, nsname, timeout, options...)
+}
+
+// WaitForAllBareMetalHostsInGoodOperationalStateWithContext waits for all baremetalhosts to be in good Operational
+// State for a time duration up to the timeout or until ctx is done.
+func WaitForAllBareMetalHostsInGoodOperationalStateWithContext(
+	ctx context.Context,
+	apiClient *clients.Settings,
 	nsname string,
 	timeout time.Duration,
 	options ...goclient.ListOptions) (bool, error) {
 	glog.V(100).Infof("Waiting for all bareMetalHosts in %s namespace to have OK operationalStatus",
 		nsname)
 
-	bmhList, err := List(apiClient, nsname, options...)
+	bmhList, err := ListWithContext(ctx, apiClient, nsname, options...)
 	if err != nil {
 		glog.V(100).Infof("Failed to list all bareMetalHosts in the %s namespace due to %s",
 			nsname, err.Error())
@@ -100,7 +128,7 @@ func WaitForAllBareMetalHostsInGoodOperationalState(apiClient *clients.Settings,
 	// Wait 5 secs in each iteration before condition function () returns true or errors or times out
 	// after availableDuration
 	err = wait.PollUntilContextTimeout(
-		context.TODO(), fiveScds, timeout, true, func(ctx context.Context) (bool, error) {
+		ctx, fiveScds, timeout, true, func(ctx context.Context) (bool, error) {
 			for _, baremetalhost := range bmhList {
 				status := baremetalhost.GetBmhOperationalState()
 
@@ -129,8 +157,8 @@ func WaitForAllBareMetalHostsInGoodOperationalState(apiClient *clients.Settings,
 	return false, err
 }
 
-// list lists the BareMetalHosts according to the provided options.
-func list(apiClient *clients.Settings, options goclient.ListOptions) ([]*BmhBuilder, error) {
+// list lists the BareMetalHosts according to the provided options using the provided context.
+func list(ctx context.Context, apiClient *clients.Settings, options goclient.ListOptions) ([]*BmhBuilder, error) {
 	err := apiClient.AttachScheme(bmhv1alpha1.AddToScheme)
 	if err != nil {
 		glog.V(100).Infof("Failed to add bmhv1alpha1 scheme to client schemes")
@@ -139,7 +167,7 @@ func list(apiClient *clients.Settings, options goclient.ListOptions) ([]*BmhBuil
 	}
 
 	var bmhList bmhv1alpha1.BareMetalHostList
-	err = apiClient.List(context.TODO(), &bmhList, &options)
+	err = apiClient.List(ctx, &bmhList, &options)
 
 	if err != nil {
 		glog.V(100).Infof("Failed to list bareMetalHosts due to %s", err.Error())
@@ -152,7 +180,7 @@ func list(apiClient *clients.Settings, options goclient.ListOptions) ([]*BmhBuil
 	for _, baremetalhost := range bmhList.Items {
 		copiedBmh := baremetalhost
 		bmhBuilder := &BmhBuilder{
-			apiClient:  apiClient.Client,
+			apiClient:  apiClient,
 			Object:     &copiedBmh,
 			Definition: &copiedBmh,
 		}
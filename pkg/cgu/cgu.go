@@ -2,6 +2,7 @@ package cgu
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -9,7 +10,9 @@ import (
 	"github.com/golang/glog"
 	"github.com/openshift-kni/cluster-group-upgrades-operator/pkg/api/clustergroupupgrades/v1alpha1"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/common"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/waiter"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -25,8 +28,10 @@ type CguBuilder struct {
 	Definition *v1alpha1.ClusterGroupUpgrade
 	// created cgu object.
 	Object *v1alpha1.ClusterGroupUpgrade
+	// copy of the object the definition was last pulled, created or updated from. It is not refreshed by Exists.
+	base *v1alpha1.ClusterGroupUpgrade
 	// api client to interact with the cluster.
-	apiClient goclient.Client
+	apiClient *clients.Settings
 	// used to store latest error message upon defining or mutating application definition.
 	errorMsg string
 }
@@ -51,7 +56,7 @@ func NewCguBuilder(apiClient *clients.Settings, name, nsname string, maxConcurre
 	}
 
 	builder := &CguBuilder{
-		apiClient: apiClient.Client,
+		apiClient: apiClient,
 		Definition: &v1alpha1.ClusterGroupUpgrade{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      name,
@@ -168,7 +173,7 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*CguBuilder, error)
 	}
 
 	builder := CguBuilder{
-		apiClient: apiClient.Client,
+		apiClient: apiClient,
 		Definition: &v1alpha1.ClusterGroupUpgrade{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      name,
@@ -193,13 +198,19 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*CguBuilder, error)
 		return nil, fmt.Errorf("cgu object %s does not exist in namespace %s", name, nsname)
 	}
 
-	builder.Definition = builder.Object
+	builder.Definition = builder.Object.DeepCopy()
+	builder.base = builder.Object.DeepCopy()
 
 	return &builder, nil
 }
 
 // Get returns ClusterGroupUpgrade object if found.
 func (builder *CguBuilder) Get() (*v1alpha1.ClusterGroupUpgrade, error) {
+	return builder.GetWithContext(context.Background())
+}
+
+// GetWithContext returns ClusterGroupUpgrade object if found using the provided context.
+func (builder *CguBuilder) GetWithContext(ctx context.Context) (*v1alpha1.ClusterGroupUpgrade, error) {
 	if valid, err := builder.validate(); !valid {
 		return nil, err
 	}
@@ -209,7 +220,7 @@ func (builder *CguBuilder) Get() (*v1alpha1.ClusterGroupUpgrade, error) {
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	clusterGroupUpgrade := &v1alpha1.ClusterGroupUpgrade{}
-	err := builder.apiClient.Get(context.TODO(),
+	err := builder.apiClient.Get(ctx,
 		goclient.ObjectKey{Name: builder.Definition.Name, Namespace: builder.Definition.Namespace},
 		clusterGroupUpgrade)
 
@@ -226,6 +237,11 @@ func (builder *CguBuilder) Get() (*v1alpha1.ClusterGroupUpgrade, error) {
 
 // Exists checks whether the given cgu exists.
 func (builder *CguBuilder) Exists() bool {
+	return builder.ExistsWithContext(context.Background())
+}
+
+// ExistsWithContext checks whether the given cgu exists using the provided context.
+func (builder *CguBuilder) ExistsWithContext(ctx context.Context) bool {
 	if valid, _ := builder.validate(); !valid {
 		return false
 	}
@@ -234,13 +250,18 @@ func (builder *CguBuilder) Exists() bool {
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
-	builder.Object, err = builder.Get()
+	builder.Object, err = builder.GetWithContext(ctx)
 
 	return err == nil || !k8serrors.IsNotFound(err)
 }
 
 // Create makes a cgu in the cluster and stores the created object in struct.
 func (builder *CguBuilder) Create() (*CguBuilder, error) {
+	return builder.CreateWithContext(context.Background())
+}
+
+// CreateWithContext makes a cgu in the cluster using the provided context and stores the created object in struct.
+func (builder *CguBuilder) CreateWithContext(ctx context.Context) (*CguBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
@@ -249,13 +270,15 @@ func (builder *CguBuilder) Create() (*CguBuilder, error) {
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
-	if !builder.Exists() {
-		err = builder.apiClient.Create(context.TODO(), builder.Definition)
+	if !builder.ExistsWithContext(ctx) {
+		err = builder.apiClient.Create(ctx, builder.Definition)
 		if err != nil {
 			glog.V(100).Infof("Failed to create clusterGroupUpgrade")
 
 			return nil, err
 		}
+
+		builder.base = builder.Definition.DeepCopy()
 	}
 
 	builder.Object = builder.Definition
@@ -265,6 +288,11 @@ func (builder *CguBuilder) Create() (*CguBuilder, error) {
 
 // Delete removes a cgu from a cluster.
 func (builder *CguBuilder) Delete() (*CguBuilder, error) {
+	return builder.DeleteWithContext(context.Background())
+}
+
+// DeleteWithContext removes a cgu from a cluster using the provided context.
+func (builder *CguBuilder) DeleteWithContext(ctx context.Context) (*CguBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
@@ -272,7 +300,7 @@ func (builder *CguBuilder) Delete() (*CguBuilder, error) {
 	glog.V(100).Infof("Deleting the cgu %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		glog.V(100).Infof("cgu %s in namespace %s does not exist",
 			builder.Definition.Name, builder.Definition.Namespace)
 
@@ -281,7 +309,7 @@ func (builder *CguBuilder) Delete() (*CguBuilder, error) {
 		return builder, nil
 	}
 
-	err := builder.apiClient.Delete(context.TODO(), builder.Definition)
+	err := builder.apiClient.Delete(ctx, builder.Definition)
 
 	if err != nil {
 		return builder, fmt.Errorf("can not delete cgu: %w", err)
@@ -294,23 +322,29 @@ func (builder *CguBuilder) Delete() (*CguBuilder, error) {
 
 // Update renovates the existing cgu object with the cgu definition in builder.
 func (builder *CguBuilder) Update(force bool) (*CguBuilder, error) {
+	return builder.UpdateWithContext(context.Background(), force)
+}
+
+// UpdateWithContext renovates the existing cgu object with the cgu definition in builder using the provided context.
+func (builder *CguBuilder) UpdateWithContext(ctx context.Context, force bool) (*CguBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
 
 	glog.V(100).Infof("Updating the cgu object", builder.Definition.Name)
 
-	err := builder.apiClient.Update(context.TODO(), builder.Definition)
+	err := builder.apiClient.Update(ctx, builder.Definition)
 
 	if err == nil {
 		builder.Object = builder.Definition
+		builder.base = builder.Definition.DeepCopy()
 	} else if force {
 		glog.V(100).Infof(
 			msg.FailToUpdateNotification("cgu", builder.Definition.Name))
 
 		// Deleting the cgu may take time, so wait for it to be deleted before recreating. Otherwise,
 		// the create happens before the delete finishes and this update results in just deletion.
-		builder, err := builder.DeleteAndWait(time.Minute)
+		builder, err := builder.DeleteAndWaitWithContext(ctx, time.Minute)
 		builder.Definition.ResourceVersion = ""
 
 		if err != nil {
@@ -320,14 +354,43 @@ func (builder *CguBuilder) Update(force bool) (*CguBuilder, error) {
 			return nil, err
 		}
 
-		return builder.Create()
+		return builder.CreateWithContext(ctx)
 	}
 
 	return builder, err
 }
 
+// UpdateWithRetry updates the cgu with merge semantics. If the cgu was modified on the cluster since it was pulled,
+// created or last updated, the changes made through the builder are re-applied to the latest version and the update
+// is retried according to backoff, for example retry.DefaultRetry. Use Update with force to delete and recreate the
+// cgu instead.
+func (builder *CguBuilder) UpdateWithRetry(ctx context.Context, backoff wait.Backoff) (*CguBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	glog.V(100).Infof("Updating cgu %s in namespace %s with retry on conflict",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	cgu, err := common.UpdateObjectWithRetry(ctx, builder.apiClient, backoff, builder.base, builder.Definition)
+	if err != nil {
+		return builder, err
+	}
+
+	builder.Object = cgu
+	builder.Definition = cgu.DeepCopy()
+	builder.base = cgu.DeepCopy()
+
+	return builder, nil
+}
+
 // DeleteAndWait deletes the cgu object and waits until the cgu is deleted.
 func (builder *CguBuilder) DeleteAndWait(timeout time.Duration) (*CguBuilder, error) {
+	return builder.DeleteAndWaitWithContext(context.Background(), timeout)
+}
+
+// DeleteAndWaitWithContext deletes the cgu object and waits until the cgu is deleted or ctx is done.
+func (builder *CguBuilder) DeleteAndWaitWithContext(ctx context.Context, timeout time.Duration) (*CguBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
@@ -335,18 +398,24 @@ func (builder *CguBuilder) DeleteAndWait(timeout time.Duration) (*CguBuilder, er
 	glog.V(100).Infof("Deleting cgu %s in namespace %s and waiting for the defined period until it is removed",
 		builder.Definition.Name, builder.Definition.Namespace)
 
-	builder, err := builder.Delete()
+	builder, err := builder.DeleteWithContext(ctx)
 	if err != nil {
 		return builder, err
 	}
 
-	err = builder.WaitUntilDeleted(timeout)
+	err = builder.WaitUntilDeletedWithContext(ctx, timeout)
 
 	return builder, err
 }
 
 // WaitUntilDeleted waits for the duration of the defined timeout or until the cgu is deleted.
 func (builder *CguBuilder) WaitUntilDeleted(timeout time.Duration) error {
+	return builder.WaitUntilDeletedWithContext(context.Background(), timeout)
+}
+
+// WaitUntilDeletedWithContext waits for the duration of the defined timeout, until ctx is done or until the cgu is
+// deleted.
+func (builder *CguBuilder) WaitUntilDeletedWithContext(ctx context.Context, timeout time.Duration) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
@@ -356,8 +425,8 @@ func (builder *CguBuilder) WaitUntilDeleted(timeout time.Duration) error {
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	return wait.PollUntilContextTimeout(
-		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
-			_, err := builder.Get()
+		ctx, time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			_, err := builder.GetWithContext(ctx)
 			if err == nil {
 				glog.V(100).Infof("cgu %s/%s still present", builder.Definition.Name, builder.Definition.Namespace)
 
@@ -378,65 +447,96 @@ func (builder *CguBuilder) WaitUntilDeleted(timeout time.Duration) error {
 
 // WaitForCondition waits until the CGU has a condition that matches the expected, checking only the Type, Status,
 // Reason, and Message fields. For the message field, it matches if the message contains the expected. Zero fields in
-// the expected condition are ignored.
+// the expected condition are ignored. On timeout, the returned waiter.WaitTimeoutError includes the last status, the
+// condition transitions and the events of the CGU.
 func (builder *CguBuilder) WaitForCondition(expected metav1.Condition, timeout time.Duration) (*CguBuilder, error) {
+	return builder.WaitForConditionWithContext(context.Background(), expected, timeout)
+}
+
+// WaitForConditionWithContext waits until the CGU has a condition that matches the expected or ctx is done. The
+// condition is matched as in WaitForCondition.
+func (builder *CguBuilder) WaitForConditionWithContext(
+	ctx context.Context, expected metav1.Condition, timeout time.Duration) (*CguBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		glog.V(100).Infof("The CGU does not exist on the cluster")
 
 		return builder, fmt.Errorf(
 			"cgu object %s does not exist in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
 	}
 
-	err := wait.PollUntilContextTimeout(
-		context.TODO(), 3*time.Second, timeout, true, func(ctx context.Context) (bool, error) {
-			var err error
-			builder.Object, err = builder.Get()
-
-			if err != nil {
-				glog.V(100).Info("failed to get cgu %s/%s: %w", builder.Definition.Name, builder.Definition.Namespace, err)
+	source := waiter.WithEvents(
+		waiter.NewRuntimeSource[v1alpha1.ClusterGroupUpgrade](
+			builder.apiClient.Client, builder.Definition.Namespace, builder.Definition.Name),
+		builder.apiClient, "ClusterGroupUpgrade", builder.Definition.Namespace)
+
+	cgu, err := waiter.For(ctx, source, waiter.Options{
+		Description: fmt.Sprintf("cgu %s in namespace %s to have condition %s",
+			builder.Definition.Name, builder.Definition.Namespace, describeCondition(expected)),
+		Timeout:      timeout,
+		PollInterval: 3 * time.Second,
+	}, func(cgu *v1alpha1.ClusterGroupUpgrade) (bool, error) {
+		for _, condition := range cgu.Status.Conditions {
+			if expected.Type != "" && condition.Type != expected.Type {
+				continue
+			}
 
-				return false, nil
+			if expected.Status != "" && condition.Status != expected.Status {
+				continue
 			}
 
-			builder.Definition = builder.Object
+			if expected.Reason != "" && condition.Reason != expected.Reason {
+				continue
+			}
 
-			for _, condition := range builder.Object.Status.Conditions {
-				if expected.Type != "" && condition.Type != expected.Type {
-					continue
-				}
+			if expected.Message != "" && !strings.Contains(condition.Message, expected.Message) {
+				continue
+			}
 
-				if expected.Status != "" && condition.Status != expected.Status {
-					continue
-				}
+			return true, nil
+		}
 
-				if expected.Reason != "" && condition.Reason != expected.Reason {
-					continue
-				}
+		return false, nil
+	})
 
-				if expected.Message != "" && !strings.Contains(condition.Message, expected.Message) {
-					continue
-				}
+	var timeoutErr *waiter.WaitTimeoutError
 
-				return true, nil
-			}
+	if errors.As(err, &timeoutErr) && timeoutErr.LastObject != nil {
+		cgu, _ = timeoutErr.LastObject.(*v1alpha1.ClusterGroupUpgrade)
+	}
 
-			return false, nil
-		})
+	if cgu != nil {
+		builder.Object = cgu
+		builder.Definition = cgu
+		builder.base = cgu.DeepCopy()
+	}
 
 	return builder, err
 }
 
 // WaitUntilComplete waits the specified timeout for the CGU to complete.
 func (builder *CguBuilder) WaitUntilComplete(timeout time.Duration) (*CguBuilder, error) {
-	return builder.WaitForCondition(conditionComplete, timeout)
+	return builder.WaitUntilCompleteWithContext(context.Background(), timeout)
+}
+
+// WaitUntilCompleteWithContext waits the specified timeout or until ctx is done for the CGU to complete.
+func (builder *CguBuilder) WaitUntilCompleteWithContext(
+	ctx context.Context, timeout time.Duration) (*CguBuilder, error) {
+	return builder.WaitForConditionWithContext(ctx, conditionComplete, timeout)
 }
 
 // WaitUntilClusterInState waits the specified timeout for a cluster in the CGU to be in the specified state.
 func (builder *CguBuilder) WaitUntilClusterInState(cluster, state string, timeout time.Duration) (*CguBuilder, error) {
+	return builder.WaitUntilClusterInStateWithContext(context.Background(), cluster, state, timeout)
+}
+
+// WaitUntilClusterInStateWithContext waits the specified timeout or until ctx is done for a cluster in the CGU to be in
+// the specified state.
+func (builder *CguBuilder) WaitUntilClusterInStateWithContext(
+	ctx context.Context, cluster, state string, timeout time.Duration) (*CguBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return nil, err
 	}
@@ -457,15 +557,15 @@ func (builder *CguBuilder) WaitUntilClusterInState(cluster, state string, timeou
 		"Waiting until cluster %s on CGU %s in namespace %s is in state %s",
 		cluster, builder.Definition.Name, builder.Definition.Namespace, state)
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		return nil, fmt.Errorf(
 			"cgu object %s does not exist in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
 	}
 
 	var err error
 	err = wait.PollUntilContextTimeout(
-		context.TODO(), 3*time.Second, timeout, true, func(ctx context.Context) (bool, error) {
-			builder.Object, err = builder.Get()
+		ctx, 3*time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			builder.Object, err = builder.GetWithContext(ctx)
 			if err != nil {
 				return false, nil
 			}
@@ -491,16 +591,36 @@ func (builder *CguBuilder) WaitUntilClusterInState(cluster, state string, timeou
 
 // WaitUntilClusterComplete waits the specified timeout for a cluster in the CGU to complete remidation.
 func (builder *CguBuilder) WaitUntilClusterComplete(cluster string, timeout time.Duration) (*CguBuilder, error) {
-	return builder.WaitUntilClusterInState(cluster, v1alpha1.Completed, timeout)
+	return builder.WaitUntilClusterCompleteWithContext(context.Background(), cluster, timeout)
+}
+
+// WaitUntilClusterCompleteWithContext waits the specified timeout or until ctx is done for a cluster in the CGU to
+// complete remidation.
+func (builder *CguBuilder) WaitUntilClusterCompleteWithContext(
+	ctx context.Context, cluster string, timeout time.Duration) (*CguBuilder, error) {
+	return builder.WaitUntilClusterInStateWithContext(ctx, cluster, v1alpha1.Completed, timeout)
 }
 
 // WaitUntilClusterInProgress waits the specified timeout for a cluster in the CGU to start remidation.
 func (builder *CguBuilder) WaitUntilClusterInProgress(cluster string, timeout time.Duration) (*CguBuilder, error) {
-	return builder.WaitUntilClusterInState(cluster, v1alpha1.InProgress, timeout)
+	return builder.WaitUntilClusterInProgressWithContext(context.Background(), cluster, timeout)
+}
+
+// WaitUntilClusterInProgressWithContext waits the specified timeout or until ctx is done for a cluster in the CGU to
+// start remidation.
+func (builder *CguBuilder) WaitUntilClusterInProgressWithContext(
+	ctx context.Context, cluster string, timeout time.Duration) (*CguBuilder, error) {
+	return builder.WaitUntilClusterInStateWithContext(ctx, cluster, v1alpha1.InProgress, timeout)
 }
 
 // WaitUntilBackupStarts waits the specified timeout for the backup to start.
 func (builder *CguBuilder) WaitUntilBackupStarts(timeout time.Duration) (*CguBuilder, error) {
+	return builder.WaitUntilBackupStartsWithContext(context.Background(), timeout)
+}
+
+// WaitUntilBackupStartsWithContext waits the specified timeout or until ctx is done for the backup to start.
+func (builder *CguBuilder) WaitUntilBackupStartsWithContext(
+	ctx context.Context, timeout time.Duration) (*CguBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
@@ -508,15 +628,15 @@ func (builder *CguBuilder) WaitUntilBackupStarts(timeout time.Duration) (*CguBui
 	glog.V(100).Infof(
 		"Waiting for CGU %s in namespace %s to start backup", builder.Definition.Name, builder.Definition.Namespace)
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		glog.V(100).Infof("The CGU does not exist on the cluster")
 
 		return builder, fmt.Errorf("%s", builder.errorMsg)
 	}
 
 	var err error
-	err = wait.PollUntilContextTimeout(context.TODO(), 3*time.Second, timeout, true, func(context.Context) (bool, error) {
-		builder.Object, err = builder.Get()
+	err = wait.PollUntilContextTimeout(ctx, 3*time.Second, timeout, true, func(context.Context) (bool, error) {
+		builder.Object, err = builder.GetWithContext(ctx)
 		if err != nil {
 			glog.V(100).Infof(
 				"Failed to get CGU %s in namespace %s due to: %w", builder.Definition.Name, builder.Definition.Namespace, err)
@@ -569,3 +689,26 @@ func (builder *CguBuilder) validate() (bool, error) {
 
 	return true, nil
 }
+
+// describeCondition returns the non-zero fields of the expected condition, for use in wait descriptions.
+func describeCondition(expected metav1.Condition) string {
+	var fields []string
+
+	if expected.Type != "" {
+		fields = append(fields, "type "+expected.Type)
+	}
+
+	if expected.Status != "" {
+		fields = append(fields, "status "+string(expected.Status))
+	}
+
+	if expected.Reason != "" {
+		fields = append(fields, "reason "+expected.Reason)
+	}
+
+	if expected.Message != "" {
+		fields = append(fields, fmt.Sprintf("message containing %q", expected.Message))
+	}
+
+	return strings.Join(fields, ", ")
+}
@@ -2,15 +2,18 @@ package cgu
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 	"time"
 
 	"github.com/openshift-kni/cluster-group-upgrades-operator/pkg/api/clustergroupupgrades/v1alpha1"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/waiter"
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/retry"
 )
 
 var (
@@ -383,6 +386,49 @@ func TestCguUpdate(t *testing.T) {
 	}
 }
 
+func TestCguUpdateWithRetry(t *testing.T) {
+	testCases := []struct {
+		concurrentChange bool
+	}{
+		{
+			concurrentChange: true,
+		},
+		{
+			concurrentChange: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := buildTestClientWithDummyCguObject()
+
+		testBuilder, err := Pull(testSettings, defaultCguName, defaultCguNsName)
+		assert.Nil(t, err)
+
+		if testCase.concurrentChange {
+			concurrentBuilder, err := Pull(testSettings, defaultCguName, defaultCguNsName)
+			assert.Nil(t, err)
+
+			concurrentBuilder.Definition.Spec.Clusters = []string{defaultCguClusterName}
+
+			_, err = concurrentBuilder.Update(false)
+			assert.Nil(t, err)
+		}
+
+		testBuilder.Definition.Spec.Backup = true
+
+		testBuilder, err = testBuilder.UpdateWithRetry(t.Context(), retry.DefaultRetry)
+		assert.Nil(t, err)
+
+		cgu, err := testBuilder.Get()
+		assert.Nil(t, err)
+		assert.True(t, cgu.Spec.Backup)
+
+		if testCase.concurrentChange {
+			assert.Equal(t, []string{defaultCguClusterName}, cgu.Spec.Clusters)
+		}
+	}
+}
+
 func TestCguDeleteAndWait(t *testing.T) {
 	testCases := []struct {
 		testCgu       *CguBuilder
@@ -508,13 +554,19 @@ func TestCguWaitForCondition(t *testing.T) {
 		}
 
 		_, err := cguBuilder.WaitForCondition(testCase.condition, time.Second)
-		assert.Equal(t, testCase.expectedError, err)
+
+		if errors.Is(testCase.expectedError, context.DeadlineExceeded) {
+			assert.ErrorIs(t, err, testCase.expectedError)
+		} else {
+			assert.Equal(t, testCase.expectedError, err)
+		}
 	}
 }
 
 func TestCguWaitUntilComplete(t *testing.T) {
 	testCases := []struct {
 		complete      bool
+		simulated     bool
 		expectedError error
 	}{
 		{
@@ -525,6 +577,11 @@ func TestCguWaitUntilComplete(t *testing.T) {
 			complete:      false,
 			expectedError: context.DeadlineExceeded,
 		},
+		{
+			complete:      false,
+			simulated:     true,
+			expectedError: nil,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -534,18 +591,63 @@ func TestCguWaitUntilComplete(t *testing.T) {
 			cgu.Status.Conditions = append(cgu.Status.Conditions, conditionComplete)
 		}
 
+		var simulators []clients.ControllerSimulator
+
+		if testCase.simulated {
+			simulators = append(simulators, clients.ControllerSimulator{
+				Object:   &v1alpha1.ClusterGroupUpgrade{},
+				Triggers: []clients.SimulatorTrigger{clients.SimulatorTriggerRead},
+				Reconcile: clients.SimulateAfter(2, func(object runtime.Object) {
+					cgu := object.(*v1alpha1.ClusterGroupUpgrade)
+					cgu.Status.Conditions = []metav1.Condition{conditionComplete}
+				}),
+			})
+		}
+
 		testSettings := clients.GetTestClients(clients.TestClientParams{
 			K8sMockObjects:  []runtime.Object{cgu},
 			SchemeAttachers: testSchemes,
+			Simulators:      simulators,
 		})
 
 		cguBuilder := buildValidCguTestBuilder(testSettings)
 		_, err := cguBuilder.WaitUntilComplete(time.Second)
 
-		assert.Equal(t, testCase.expectedError, err)
+		assert.ErrorIs(t, err, testCase.expectedError)
 	}
 }
 
+func TestCguWaitUntilCompleteTimeout(t *testing.T) {
+	cgu := buildDummyCgu(defaultCguName, defaultCguNsName, defaultCguMaxConcurrency)
+	cgu.Status.Conditions = []metav1.Condition{{
+		Type: "Progressing", Status: metav1.ConditionFalse, Reason: "MissingBackup"}}
+
+	testSettings := clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects:  []runtime.Object{cgu},
+		SchemeAttachers: testSchemes,
+	})
+
+	cguBuilder, err := buildValidCguTestBuilder(testSettings).WaitUntilComplete(500 * time.Millisecond)
+
+	var timeoutErr *waiter.WaitTimeoutError
+
+	assert.True(t, errors.As(err, &timeoutErr))
+	assert.Len(t, timeoutErr.Conditions, 1)
+	assert.Equal(t, "Progressing=False (MissingBackup)", timeoutErr.Conditions[0].String())
+	assert.Equal(t, fmt.Sprintf("timed out waiting for cgu %s in namespace %s to have condition type Succeeded, "+
+		"status True: context deadline exceeded (conditions: Progressing=False (MissingBackup))",
+		defaultCguName, defaultCguNsName), err.Error())
+	assert.NotNil(t, cguBuilder.Object)
+}
+
+func TestCguWaitUntilCompleteWithContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	_, err := buildValidCguTestBuilder(buildTestClientWithDummyCguObject()).WaitUntilCompleteWithContext(ctx, time.Minute)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
 func TestCguWaitUntilClusterInState(t *testing.T) {
 	testCases := []struct {
 		cluster       string
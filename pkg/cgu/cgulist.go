@@ -12,6 +12,12 @@ import (
 
 // ListInAllNamespaces returns a cluster-wide cgu inventory.
 func ListInAllNamespaces(apiClient *clients.Settings, options ...client.ListOptions) ([]*CguBuilder, error) {
+	return ListInAllNamespacesWithContext(context.Background(), apiClient, options...)
+}
+
+// ListInAllNamespacesWithContext returns a cluster-wide cgu inventory using the provided context.
+func ListInAllNamespacesWithContext(
+	ctx context.Context, apiClient *clients.Settings, options ...client.ListOptions) ([]*CguBuilder, error) {
 	logMessage := "Listing CGUS in all namespaces"
 	passedOptions := client.ListOptions{}
 
@@ -42,7 +48,7 @@ func ListInAllNamespaces(apiClient *clients.Settings, options ...client.ListOpti
 	glog.V(100).Infof(logMessage)
 
 	cguList := &v1alpha1.ClusterGroupUpgradeList{}
-	err = apiClient.List(context.TODO(), cguList, &passedOptions)
+	err = apiClient.List(ctx, cguList, &passedOptions)
 
 	if err != nil {
 		glog.V(100).Infof("Failed to list all CGUs in all namespaces due to %s", err.Error())
@@ -55,9 +61,10 @@ func ListInAllNamespaces(apiClient *clients.Settings, options ...client.ListOpti
 	for _, policy := range cguList.Items {
 		copiedCgu := policy
 		cguBuilder := &CguBuilder{
-			apiClient:  apiClient.Client,
+			apiClient:  apiClient,
 			Object:     &copiedCgu,
 			Definition: &copiedCgu,
+			base:       copiedCgu.DeepCopy(),
 		}
 
 		cguObjects = append(cguObjects, cguBuilder)
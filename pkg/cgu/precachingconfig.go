@@ -122,6 +122,11 @@ func PullPreCachingConfig(apiClient *clients.Settings, name, nsname string) (*Pr
 
 // Exists checks whether the given PreCachingConfig exists on the apiClient.
 func (builder *PreCachingConfigBuilder) Exists() bool {
+	return builder.ExistsWithContext(context.Background())
+}
+
+// ExistsWithContext checks whether the given PreCachingConfig exists on the apiClient using the provided context.
+func (builder *PreCachingConfigBuilder) ExistsWithContext(ctx context.Context) bool {
 	if valid, _ := builder.validate(); !valid {
 		return false
 	}
@@ -130,13 +135,19 @@ func (builder *PreCachingConfigBuilder) Exists() bool {
 		"Checking if preCachingConfig %s exists in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
-	builder.Object, err = builder.Get()
+	builder.Object, err = builder.GetWithContext(ctx)
 
 	return err == nil || !k8serrors.IsNotFound(err)
 }
 
 // Get pulls the PreCachingConfig from the apiClient into the PreCachingConfigBuilder.
 func (builder *PreCachingConfigBuilder) Get() (*v1alpha1.PreCachingConfig, error) {
+	return builder.GetWithContext(context.Background())
+}
+
+// GetWithContext pulls the PreCachingConfig from the apiClient into the PreCachingConfigBuilder using the provided
+// context.
+func (builder *PreCachingConfigBuilder) GetWithContext(ctx context.Context) (*v1alpha1.PreCachingConfig, error) {
 	if valid, err := builder.validate(); !valid {
 		return nil, err
 	}
@@ -145,7 +156,7 @@ func (builder *PreCachingConfigBuilder) Get() (*v1alpha1.PreCachingConfig, error
 
 	preCachingConfig := &v1alpha1.PreCachingConfig{}
 
-	err := builder.apiClient.Get(context.TODO(), runtimeclient.ObjectKey{
+	err := builder.apiClient.Get(ctx, runtimeclient.ObjectKey{
 		Name:      builder.Definition.Name,
 		Namespace: builder.Definition.Namespace,
 	}, preCachingConfig)
@@ -158,6 +169,11 @@ func (builder *PreCachingConfigBuilder) Get() (*v1alpha1.PreCachingConfig, error
 
 // Create makes a PreCachingConfig on the apiClient if it does not already exist.
 func (builder *PreCachingConfigBuilder) Create() (*PreCachingConfigBuilder, error) {
+	return builder.CreateWithContext(context.Background())
+}
+
+// CreateWithContext makes a PreCachingConfig on the apiClient if it does not already exist using the provided context.
+func (builder *PreCachingConfigBuilder) CreateWithContext(ctx context.Context) (*PreCachingConfigBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return nil, err
 	}
@@ -165,11 +181,11 @@ func (builder *PreCachingConfigBuilder) Create() (*PreCachingConfigBuilder, erro
 	glog.V(100).Infof(
 		"Creating the PreCachingConfig %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
 
-	if builder.Exists() {
+	if builder.ExistsWithContext(ctx) {
 		return builder, nil
 	}
 
-	err := builder.apiClient.Create(context.TODO(), builder.Definition)
+	err := builder.apiClient.Create(ctx, builder.Definition)
 	if err != nil {
 		return nil, err
 	}
@@ -181,6 +197,11 @@ func (builder *PreCachingConfigBuilder) Create() (*PreCachingConfigBuilder, erro
 
 // Delete removes a PreCachingConfig from the apiClient if it exists.
 func (builder *PreCachingConfigBuilder) Delete() error {
+	return builder.DeleteWithContext(context.Background())
+}
+
+// DeleteWithContext removes a PreCachingConfig from the apiClient if it exists using the provided context.
+func (builder *PreCachingConfigBuilder) DeleteWithContext(ctx context.Context) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
@@ -188,13 +209,13 @@ func (builder *PreCachingConfigBuilder) Delete() error {
 	glog.V(100).Infof(
 		"Deleting the PreCachingConfig %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		builder.Object = nil
 
 		return nil
 	}
 
-	err := builder.apiClient.Delete(context.TODO(), builder.Definition)
+	err := builder.apiClient.Delete(ctx, builder.Definition)
 	if err != nil {
 		return err
 	}
@@ -207,6 +228,13 @@ func (builder *PreCachingConfigBuilder) Delete() error {
 // Update changes the existing PreCachingConfig object on the apiClient, falling back to deleting and recreating it if
 // force is set.
 func (builder *PreCachingConfigBuilder) Update(force bool) (*PreCachingConfigBuilder, error) {
+	return builder.UpdateWithContext(context.Background(), force)
+}
+
+// UpdateWithContext changes the existing PreCachingConfig object on the apiClient using the provided context, falling
+// back to deleting and recreating it if force is set.
+func (builder *PreCachingConfigBuilder) UpdateWithContext(
+	ctx context.Context, force bool) (*PreCachingConfigBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return nil, err
 	}
@@ -214,19 +242,19 @@ func (builder *PreCachingConfigBuilder) Update(force bool) (*PreCachingConfigBui
 	glog.V(100).Infof(
 		"Updating the PreCachingConfig %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
 
-	err := builder.apiClient.Update(context.TODO(), builder.Definition)
+	err := builder.apiClient.Update(ctx, builder.Definition)
 	if err != nil {
 		if force {
 			glog.V(100).Infof(msg.FailToUpdateNotification("preCachingConfig", builder.Definition.Name))
 
-			err := builder.Delete()
+			err := builder.DeleteWithContext(ctx)
 			if err != nil {
 				glog.V(100).Infof(msg.FailToUpdateError("preCachingConfig", builder.Definition.Name))
 
 				return nil, err
 			}
 
-			return builder.Create()
+			return builder.CreateWithContext(ctx)
 		}
 
 		return nil, err
@@ -0,0 +1,91 @@
+package clients
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var applyConflictManagerRegex = regexp.MustCompile(`conflict with "([^"]*)"`)
+
+// ApplyConflict describes a single field owned by another field manager that a server-side apply attempted to
+// change.
+type ApplyConflict struct {
+	// Manager is the name of the field manager that currently owns the field.
+	Manager string
+	// Field is the path of the conflicting field, for example .spec.replicas.
+	Field string
+}
+
+// ApplyConflictError is returned by server-side apply when the applied configuration changes fields owned by other
+// field managers and ownership was not forced. It wraps the original API error so k8serrors.IsConflict still holds.
+type ApplyConflictError struct {
+	// FieldManager is the field manager that attempted the apply.
+	FieldManager string
+	// Conflicts lists the fields owned by other managers.
+	Conflicts []ApplyConflict
+
+	err error
+}
+
+// NewApplyConflictError converts a Conflict error returned by a server-side apply into an ApplyConflictError. Errors
+// that are not conflicts are returned unchanged.
+func NewApplyConflictError(fieldManager string, err error) error {
+	if err == nil || !k8serrors.IsConflict(err) {
+		return err
+	}
+
+	conflictError := &ApplyConflictError{FieldManager: fieldManager, err: err}
+
+	var statusErr k8serrors.APIStatus
+	if !errors.As(err, &statusErr) || statusErr.Status().Details == nil {
+		return conflictError
+	}
+
+	for _, cause := range statusErr.Status().Details.Causes {
+		if cause.Type != metav1.CauseTypeFieldManagerConflict {
+			continue
+		}
+
+		conflict := ApplyConflict{Field: cause.Field}
+
+		if match := applyConflictManagerRegex.FindStringSubmatch(cause.Message); len(match) == 2 {
+			conflict.Manager = match[1]
+		}
+
+		conflictError.Conflicts = append(conflictError.Conflicts, conflict)
+	}
+
+	return conflictError
+}
+
+// Error returns a description of the conflicting fields and their owners.
+func (conflictError *ApplyConflictError) Error() string {
+	if len(conflictError.Conflicts) == 0 {
+		return fmt.Sprintf("server-side apply by %s failed with conflicts: %v", conflictError.FieldManager, conflictError.err)
+	}
+
+	conflicts := make([]string, 0, len(conflictError.Conflicts))
+	for _, conflict := range conflictError.Conflicts {
+		conflicts = append(conflicts, fmt.Sprintf("%s owned by %q", conflict.Field, conflict.Manager))
+	}
+
+	return fmt.Sprintf("server-side apply by %s failed with %d conflict(s): %s",
+		conflictError.FieldManager, len(conflicts), strings.Join(conflicts, ", "))
+}
+
+// Unwrap returns the original API error.
+func (conflictError *ApplyConflictError) Unwrap() error {
+	return conflictError.err
+}
+
+// IsApplyConflict returns true if err is or wraps an ApplyConflictError.
+func IsApplyConflict(err error) bool {
+	var conflictError *ApplyConflictError
+
+	return errors.As(err, &conflictError)
+}
@@ -1,6 +1,7 @@
 package clients
 
 import (
+	"context"
 	"fmt"
 	"os"
 
@@ -29,20 +30,13 @@ import (
 	coreV1Client "k8s.io/client-go/kubernetes/typed/core/v1"
 	storageV1Client "k8s.io/client-go/kubernetes/typed/storage/v1"
 
-	appsv1 "k8s.io/api/apps/v1"
-	scalingv1 "k8s.io/api/autoscaling/v1"
-	corev1 "k8s.io/api/core/v1"
-	netv1 "k8s.io/api/networking/v1"
 	policyv1 "k8s.io/api/policy/v1"
-	rbacv1 "k8s.io/api/rbac/v1"
-	storagev1 "k8s.io/api/storage/v1"
-	k8sFakeClient "k8s.io/client-go/kubernetes/fake"
 	fakeRuntimeClient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 
 	operatorv1 "github.com/openshift/api/operator/v1"
 	machinev1beta1client "github.com/openshift/client-go/machine/clientset/versioned/typed/machine/v1beta1"
 	operatorv1alpha1 "github.com/openshift/client-go/operator/clientset/versioned/typed/operator/v1alpha1"
-	dynamicFake "k8s.io/client-go/dynamic/fake"
 	policyv1clientTyped "k8s.io/client-go/kubernetes/typed/policy/v1"
 )
 
@@ -120,7 +114,8 @@ func New(kubeconfig string) *Settings {
 		return nil
 	}
 
-	clientSet.Client, err = runtimeClient.New(config, runtimeClient.Options{
+	// The client supports watches so that wait helpers can follow changes rather than polling.
+	clientSet.Client, err = runtimeClient.NewWithWatch(config, runtimeClient.Options{
 		Scheme: clientSet.scheme,
 	})
 
@@ -204,6 +199,11 @@ type TestClientParams struct {
 	K8sMockObjects  []runtime.Object
 	GVK             []schema.GroupVersionKind
 	SchemeAttachers []SchemeAttacher
+	// Simulators stand in for controllers, updating the objects of the fake cluster as tests interact with it.
+	Simulators []ControllerSimulator
+	// Context bounds the simulators using the timer trigger, which stop once it is done. They are not started when it
+	// is nil, so that they cannot outlive the test.
+	Context context.Context
 
 	// Note: Add more fields below if/when needed.
 }
@@ -219,94 +219,15 @@ func GetTestClients(tcp TestClientParams) *Settings {
 // GetModifiableTestClients returns a fake clientset
 // and a modifiable clientbuilder for testing.
 //
-//nolint:funlen,gocyclo
+// All clients of the returned clientset, typed, dynamic and runtime, share a single fake cluster: an object seeded in
+// tcp.K8sMockObjects or created through any of the clients can be read, updated, and deleted through all of them.
+// The schemes of fakeClusterSchemeAttachers are registered in addition to tcp.SchemeAttachers. Each of tcp.GVK not
+// already part of the scheme is registered for the mock object whose type name matches its kind, or else the object at
+// the same index. A mock object whose type is still missing from the scheme is logged and skipped, so a test missing a
+// scheme attacher runs against a cluster without that object.
 func GetModifiableTestClients(tcp TestClientParams) (*Settings, *fakeRuntimeClient.ClientBuilder) {
 	clientSet := &Settings{}
 
-	var k8sClientObjects, genericClientObjects []runtime.Object
-
-	//nolint:varnamelen
-	for _, v := range tcp.K8sMockObjects {
-		// Based on what type of object is, populate certain object slices
-		// with what is supported by a certain client.
-		// Add more items below if/when needed.
-		switch v.(type) {
-		// K8s Client Objects
-		case *corev1.ServiceAccount:
-			k8sClientObjects = append(k8sClientObjects, v)
-		case *rbacv1.ClusterRole:
-			k8sClientObjects = append(k8sClientObjects, v)
-		case *rbacv1.ClusterRoleBinding:
-			k8sClientObjects = append(k8sClientObjects, v)
-		case *rbacv1.Role:
-			k8sClientObjects = append(k8sClientObjects, v)
-		case *rbacv1.RoleBinding:
-			k8sClientObjects = append(k8sClientObjects, v)
-		case *corev1.Pod:
-			k8sClientObjects = append(k8sClientObjects, v)
-		case *corev1.Service:
-			k8sClientObjects = append(k8sClientObjects, v)
-		case *corev1.Node:
-			k8sClientObjects = append(k8sClientObjects, v)
-		case *corev1.Secret:
-			k8sClientObjects = append(k8sClientObjects, v)
-		case *appsv1.Deployment:
-			k8sClientObjects = append(k8sClientObjects, v)
-		case *appsv1.StatefulSet:
-			k8sClientObjects = append(k8sClientObjects, v)
-		case *appsv1.ReplicaSet:
-			k8sClientObjects = append(k8sClientObjects, v)
-		case *corev1.ResourceQuota:
-			k8sClientObjects = append(k8sClientObjects, v)
-		case *corev1.PersistentVolume:
-			k8sClientObjects = append(k8sClientObjects, v)
-		case *corev1.PersistentVolumeClaim:
-			k8sClientObjects = append(k8sClientObjects, v)
-		case *policyv1.PodDisruptionBudget:
-			k8sClientObjects = append(k8sClientObjects, v)
-		case *scalingv1.HorizontalPodAutoscaler:
-			k8sClientObjects = append(k8sClientObjects, v)
-		case *storagev1.StorageClass:
-			k8sClientObjects = append(k8sClientObjects, v)
-		case *corev1.ConfigMap:
-			k8sClientObjects = append(k8sClientObjects, v)
-		case *corev1.Event:
-			k8sClientObjects = append(k8sClientObjects, v)
-		case *netv1.NetworkPolicy:
-			k8sClientObjects = append(k8sClientObjects, v)
-		case *appsv1.DaemonSet:
-			k8sClientObjects = append(k8sClientObjects, v)
-		case *corev1.Namespace:
-			k8sClientObjects = append(k8sClientObjects, v)
-		// Generic Client Objects
-		case *operatorv1.KubeAPIServer:
-			genericClientObjects = append(genericClientObjects, v)
-		case *operatorv1.OpenShiftAPIServer:
-			genericClientObjects = append(genericClientObjects, v)
-		case *routev1.Route:
-			genericClientObjects = append(genericClientObjects, v)
-		case *configV1.Node:
-			genericClientObjects = append(genericClientObjects, v)
-		case *operatorv1.IngressController:
-			genericClientObjects = append(genericClientObjects, v)
-		case *operatorv1.Console:
-			genericClientObjects = append(genericClientObjects, v)
-		case *configV1.ClusterOperator:
-			genericClientObjects = append(genericClientObjects, v)
-		case *agentInstallV1Beta1.AgentServiceConfig:
-			genericClientObjects = append(genericClientObjects, v)
-		}
-	}
-
-	// Assign the fake clientset to the clientSet
-	clientSet.K8sClient = k8sFakeClient.NewSimpleClientset(k8sClientObjects...)
-	clientSet.CoreV1Interface = clientSet.K8sClient.CoreV1()
-	clientSet.AppsV1Interface = clientSet.K8sClient.AppsV1()
-	clientSet.NetworkingV1Interface = clientSet.K8sClient.NetworkingV1()
-	clientSet.RbacV1Interface = clientSet.K8sClient.RbacV1()
-	clientSet.StorageV1Interface = clientSet.K8sClient.StorageV1()
-	clientSet.PolicyV1Interface = clientSet.K8sClient.PolicyV1()
-
 	// Update the generic client with schemes of generic resources
 	clientSet.scheme = runtime.NewScheme()
 
@@ -315,26 +236,52 @@ func GetModifiableTestClients(tcp TestClientParams) (*Settings, *fakeRuntimeClie
 		return nil, nil
 	}
 
-	if len(tcp.GVK) > 0 && len(genericClientObjects) > 0 {
-		clientSet.scheme.AddKnownTypeWithName(
-			tcp.GVK[0], genericClientObjects[0])
+	for _, attacher := range append(fakeClusterSchemeAttachers, tcp.SchemeAttachers...) {
+		err := clientSet.AttachScheme(attacher)
+		if err != nil {
+			return nil, nil
+		}
 	}
 
-	if len(tcp.K8sMockObjects) > 0 && len(tcp.SchemeAttachers) > 0 {
-		genericClientObjects = append(genericClientObjects, tcp.K8sMockObjects...)
-	} else {
-		clientSet.Interface = dynamicFake.NewSimpleDynamicClient(clientSet.scheme, genericClientObjects...)
+	registerTestGVKs(clientSet.scheme, tcp.GVK, tcp.K8sMockObjects)
+
+	tracker, err := newFakeClusterTracker(clientSet.scheme, tcp.Simulators)
+	if err != nil {
+		glog.V(100).Infof("Failed to create fake cluster: %v", err)
+
+		return nil, nil
 	}
 
-	for _, attacher := range tcp.SchemeAttachers {
-		err := clientSet.AttachScheme(attacher)
-		if err != nil {
-			return nil, nil
+	for _, object := range tcp.K8sMockObjects {
+		if err := tracker.Add(object); err != nil {
+			glog.V(100).Infof("Skipping mock object %T not supported by the fake cluster, its scheme may be missing "+
+				"from TestClientParams.SchemeAttachers: %v", object, err)
 		}
 	}
-	// Add fake runtime client to clientSet runtime client
+
+	if tcp.Context != nil {
+		tracker.startTimers(tcp.Context)
+	}
+
+	// Assign the fake clientset to the clientSet
+	clientSet.K8sClient = newFakeTypedClient(tracker)
+	clientSet.CoreV1Interface = clientSet.K8sClient.CoreV1()
+	clientSet.AppsV1Interface = clientSet.K8sClient.AppsV1()
+	clientSet.NetworkingV1Interface = clientSet.K8sClient.NetworkingV1()
+	clientSet.RbacV1Interface = clientSet.K8sClient.RbacV1()
+	clientSet.StorageV1Interface = clientSet.K8sClient.StorageV1()
+	clientSet.PolicyV1Interface = clientSet.K8sClient.PolicyV1()
+	clientSet.Interface = newFakeDynamicClient(tracker)
+
+	// Add fake runtime client to clientSet runtime client. The fake client does not support server-side apply so apply
+	// patches are emulated by an interceptor. Reads are intercepted to invoke the controller simulators.
 	clientBuilder := fakeRuntimeClient.NewClientBuilder().WithScheme(clientSet.scheme).
-		WithRuntimeObjects(genericClientObjects...)
+		WithObjectTracker(tracker).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Get:   tracker.simulateRuntimeGet,
+			List:  tracker.simulateRuntimeList,
+			Patch: fakeServerSideApply,
+		})
 
 	return clientSet, clientBuilder
 }
@@ -0,0 +1,418 @@
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// fieldPath is the path of a single field managed by a field manager, for example [spec replicas].
+type fieldPath []string
+
+func (path fieldPath) String() string {
+	return "." + strings.Join(path, ".")
+}
+
+// applyMetadataFields are the metadata fields that are taken from the applied configuration. The remaining metadata is
+// managed by the server.
+var applyMetadataFields = []string{"labels", "annotations", "ownerReferences", "finalizers"}
+
+// fakeServerSideApply emulates server-side apply on top of the controller-runtime fake client, which rejects apply
+// patches. Field ownership is tracked in the managedFields of the stored object with maps treated granularly and
+// lists treated atomically. Only fields owned by other Apply managers can conflict. All other patch types are passed
+// through to the fake client.
+//
+//nolint:funlen
+func fakeServerSideApply(
+	ctx context.Context,
+	client runtimeClient.WithWatch,
+	obj runtimeClient.Object,
+	patch runtimeClient.Patch,
+	opts ...runtimeClient.PatchOption) error {
+	if patch.Type() != types.ApplyPatchType {
+		return client.Patch(ctx, obj, patch, opts...)
+	}
+
+	patchOptions := &runtimeClient.PatchOptions{}
+	patchOptions.ApplyOptions(opts)
+
+	if patchOptions.FieldManager == "" {
+		return k8serrors.NewBadRequest("PATCH with apply requires a field manager")
+	}
+
+	data, err := patch.Data(obj)
+	if err != nil {
+		return err
+	}
+
+	applied, err := applyConfiguration(data)
+	if err != nil {
+		return k8serrors.NewBadRequest(err.Error())
+	}
+
+	appliedPaths := collectFieldPaths(applied, nil)
+	apiVersion := obj.GetObjectKind().GroupVersionKind().GroupVersion().String()
+
+	existing, ok := obj.DeepCopyObject().(runtimeClient.Object)
+	if !ok {
+		return fmt.Errorf("failed to copy object %s", obj.GetName())
+	}
+
+	err = client.Get(ctx, runtimeClient.ObjectKeyFromObject(obj), existing)
+	if k8serrors.IsNotFound(err) {
+		if err = decodeInto(applied, obj); err != nil {
+			return err
+		}
+
+		obj.SetManagedFields([]metav1.ManagedFieldsEntry{
+			newApplyEntry(patchOptions.FieldManager, apiVersion, appliedPaths)})
+
+		return client.Create(ctx, obj)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	current := map[string]any{}
+	if err = decodeInto(existing, &current); err != nil {
+		return err
+	}
+
+	owners := map[string][]fieldPath{}
+	entries := existing.GetManagedFields()
+
+	for _, entry := range entries {
+		if entry.Operation == metav1.ManagedFieldsOperationApply && entry.FieldsV1 != nil {
+			owners[entry.Manager] = decodeFieldsV1(entry.FieldsV1.Raw)
+		}
+	}
+
+	var causes []metav1.StatusCause
+
+	for manager, paths := range owners {
+		if manager == patchOptions.FieldManager {
+			continue
+		}
+
+		var remaining []fieldPath
+
+		for _, path := range paths {
+			if !containsPath(appliedPaths, path) {
+				remaining = append(remaining, path)
+
+				continue
+			}
+
+			if reflect.DeepEqual(valueAt(current, path), valueAt(applied, path)) {
+				remaining = append(remaining, path)
+
+				continue
+			}
+
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldManagerConflict,
+				Message: fmt.Sprintf("conflict with %q", manager),
+				Field:   path.String(),
+			})
+		}
+
+		owners[manager] = remaining
+	}
+
+	if len(causes) > 0 && (patchOptions.Force == nil || !*patchOptions.Force) {
+		sort.Slice(causes, func(i, j int) bool { return causes[i].Field < causes[j].Field })
+
+		return k8serrors.NewApplyConflict(causes, fmt.Sprintf("Apply failed with %d conflict(s)", len(causes)))
+	}
+
+	// Fields previously applied by this manager but omitted now are removed unless another manager still owns them.
+	for _, path := range owners[patchOptions.FieldManager] {
+		if containsPath(appliedPaths, path) || ownedByOthers(owners, patchOptions.FieldManager, path) {
+			continue
+		}
+
+		removeValueAt(current, path)
+	}
+
+	owners[patchOptions.FieldManager] = appliedPaths
+	mergeInto(current, applied)
+
+	if err = decodeInto(current, obj); err != nil {
+		return err
+	}
+
+	obj.SetManagedFields(updateApplyEntries(entries, owners, patchOptions.FieldManager, apiVersion))
+
+	return client.Update(ctx, obj)
+}
+
+// applyConfiguration decodes the apply patch and strips the fields that are not part of the applied configuration:
+// null values, status and server-managed metadata.
+func applyConfiguration(data []byte) (map[string]any, error) {
+	applied := map[string]any{}
+	if err := json.Unmarshal(data, &applied); err != nil {
+		return nil, fmt.Errorf("failed to decode apply patch: %w", err)
+	}
+
+	delete(applied, "status")
+
+	if metadata, ok := applied["metadata"].(map[string]any); ok {
+		kept := map[string]any{}
+
+		for _, field := range append([]string{"name", "namespace"}, applyMetadataFields...) {
+			if value, found := metadata[field]; found {
+				kept[field] = value
+			}
+		}
+
+		applied["metadata"] = kept
+	}
+
+	pruneNulls(applied)
+
+	return applied, nil
+}
+
+// collectFieldPaths returns the paths of all leaf fields in object. Lists are considered leaves.
+func collectFieldPaths(object map[string]any, prefix fieldPath) []fieldPath {
+	var paths []fieldPath
+
+	for key, value := range object {
+		if len(prefix) == 1 && prefix[0] == "metadata" && (key == "name" || key == "namespace") {
+			continue
+		}
+
+		path := append(append(fieldPath{}, prefix...), key)
+
+		if nested, ok := value.(map[string]any); ok && len(nested) > 0 {
+			paths = append(paths, collectFieldPaths(nested, path)...)
+
+			continue
+		}
+
+		paths = append(paths, path)
+	}
+
+	return paths
+}
+
+// encodeFieldsV1 encodes paths in the FieldsV1 format used by managedFields.
+func encodeFieldsV1(paths []fieldPath) *metav1.FieldsV1 {
+	root := map[string]any{}
+
+	for _, path := range paths {
+		node := root
+
+		for _, key := range path {
+			child, ok := node["f:"+key].(map[string]any)
+			if !ok {
+				child = map[string]any{}
+				node["f:"+key] = child
+			}
+
+			node = child
+		}
+	}
+
+	raw, _ := json.Marshal(root)
+
+	return &metav1.FieldsV1{Raw: raw}
+}
+
+// decodeFieldsV1 returns the leaf paths stored in a FieldsV1 set. Only the "f:" field keys are understood.
+func decodeFieldsV1(raw []byte) []fieldPath {
+	root := map[string]any{}
+	if err := json.Unmarshal(raw, &root); err != nil {
+		return nil
+	}
+
+	var walk func(node map[string]any, prefix fieldPath) []fieldPath
+
+	walk = func(node map[string]any, prefix fieldPath) []fieldPath {
+		var paths []fieldPath
+
+		for key, value := range node {
+			if !strings.HasPrefix(key, "f:") {
+				continue
+			}
+
+			path := append(append(fieldPath{}, prefix...), strings.TrimPrefix(key, "f:"))
+
+			if nested, ok := value.(map[string]any); ok && len(nested) > 0 {
+				paths = append(paths, walk(nested, path)...)
+
+				continue
+			}
+
+			paths = append(paths, path)
+		}
+
+		return paths
+	}
+
+	return walk(root, nil)
+}
+
+// newApplyEntry returns the managedFields entry of an Apply operation by manager.
+func newApplyEntry(manager, apiVersion string, paths []fieldPath) metav1.ManagedFieldsEntry {
+	now := metav1.Now()
+
+	return metav1.ManagedFieldsEntry{
+		Manager:    manager,
+		Operation:  metav1.ManagedFieldsOperationApply,
+		APIVersion: apiVersion,
+		Time:       &now,
+		FieldsType: "FieldsV1",
+		FieldsV1:   encodeFieldsV1(paths),
+	}
+}
+
+// updateApplyEntries rewrites the Apply entries of entries from owners, adding an entry for manager if needed and
+// dropping managers that no longer own any field.
+func updateApplyEntries(
+	entries []metav1.ManagedFieldsEntry,
+	owners map[string][]fieldPath,
+	manager, apiVersion string) []metav1.ManagedFieldsEntry {
+	var updated []metav1.ManagedFieldsEntry
+
+	found := false
+
+	for _, entry := range entries {
+		if entry.Operation != metav1.ManagedFieldsOperationApply {
+			updated = append(updated, entry)
+
+			continue
+		}
+
+		if entry.Manager == manager {
+			found = true
+			updated = append(updated, newApplyEntry(manager, apiVersion, owners[manager]))
+
+			continue
+		}
+
+		if len(owners[entry.Manager]) == 0 {
+			continue
+		}
+
+		entry.FieldsV1 = encodeFieldsV1(owners[entry.Manager])
+		updated = append(updated, entry)
+	}
+
+	if !found {
+		updated = append(updated, newApplyEntry(manager, apiVersion, owners[manager]))
+	}
+
+	return updated
+}
+
+// containsPath returns true if paths contains path or a parent or child of path.
+func containsPath(paths []fieldPath, path fieldPath) bool {
+	for _, candidate := range paths {
+		length := min(len(candidate), len(path))
+
+		if reflect.DeepEqual(candidate[:length], path[:length]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ownedByOthers returns true if any manager other than manager owns path.
+func ownedByOthers(owners map[string][]fieldPath, manager string, path fieldPath) bool {
+	for owner, paths := range owners {
+		if owner != manager && containsPath(paths, path) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// valueAt returns the value at path in object or nil if it is not set.
+func valueAt(object map[string]any, path fieldPath) any {
+	var current any = object
+
+	for _, key := range path {
+		node, ok := current.(map[string]any)
+		if !ok {
+			return nil
+		}
+
+		current = node[key]
+	}
+
+	return current
+}
+
+// removeValueAt deletes the value at path from object if it is set.
+func removeValueAt(object map[string]any, path fieldPath) {
+	node := object
+
+	for _, key := range path[:len(path)-1] {
+		child, ok := node[key].(map[string]any)
+		if !ok {
+			return
+		}
+
+		node = child
+	}
+
+	delete(node, path[len(path)-1])
+}
+
+// mergeInto recursively merges source into destination. Maps are merged and all other values are replaced.
+func mergeInto(destination, source map[string]any) {
+	for key, value := range source {
+		sourceMap, sourceIsMap := value.(map[string]any)
+		destinationMap, destinationIsMap := destination[key].(map[string]any)
+
+		if sourceIsMap && destinationIsMap {
+			mergeInto(destinationMap, sourceMap)
+
+			continue
+		}
+
+		destination[key] = value
+	}
+}
+
+// pruneNulls recursively removes null values from object.
+func pruneNulls(object map[string]any) {
+	for key, value := range object {
+		if value == nil {
+			delete(object, key)
+
+			continue
+		}
+
+		if nested, ok := value.(map[string]any); ok {
+			pruneNulls(nested)
+		}
+	}
+}
+
+// decodeInto converts source into destination by round-tripping through JSON.
+func decodeInto(source, destination any) error {
+	data, err := json.Marshal(source)
+	if err != nil {
+		return err
+	}
+
+	if object, ok := destination.(runtimeClient.Object); ok {
+		reflectValue := reflect.ValueOf(object).Elem()
+		reflectValue.Set(reflect.Zero(reflectValue.Type()))
+	}
+
+	return json.Unmarshal(data, destination)
+}
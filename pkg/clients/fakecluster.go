@@ -0,0 +1,377 @@
+package clients
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/golang/glog"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/watch"
+	dynamicFake "k8s.io/client-go/dynamic/fake"
+	k8sFakeClient "k8s.io/client-go/kubernetes/fake"
+	k8sTesting "k8s.io/client-go/testing"
+
+	amdgpuv1alpha1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/amd/gpu-operator/api/v1alpha1"
+	argocdoperator "github.com/openshift-kni/eco-goinfra/pkg/schemes/argocd/argocdoperator"
+	argocdtypesv1alpha1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/argocd/argocdtypes/v1alpha1"
+	hiveextv1beta1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/assisted/api/hiveextension/v1beta1"
+	fectypes "github.com/openshift-kni/eco-goinfra/pkg/schemes/fec/fectypes"
+	ibguv1alpha1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/imagebasedgroupupgrades/v1alpha1"
+	ibiv1alpha1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/imagebasedinstall/api/hiveextensions/v1alpha1"
+	kmmhubv1beta1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/kmm-hub/v1beta1"
+	kmmv1beta1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/kmm/v1beta1"
+	kmmv1beta2 "github.com/openshift-kni/eco-goinfra/pkg/schemes/kmm/v1beta2"
+	frrtypes "github.com/openshift-kni/eco-goinfra/pkg/schemes/metallb/frrtypes"
+	mlboperator "github.com/openshift-kni/eco-goinfra/pkg/schemes/metallb/mlboperator"
+	mlbtypes "github.com/openshift-kni/eco-goinfra/pkg/schemes/metallb/mlbtypes"
+	mlbtypesv1beta2 "github.com/openshift-kni/eco-goinfra/pkg/schemes/metallb/mlbtypesv1beta2"
+	nfdfeaturev1alpha1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/nfd/feature/v1alpha1"
+	nfdv1alpha1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/nfd/v1alpha1"
+	nvidiagputypes "github.com/openshift-kni/eco-goinfra/pkg/schemes/nvidiagpu/nvidiagputypes"
+	oadpv1alpha1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/oadp/api/v1alpha1"
+	ocmclusterv1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/ocm/clusterv1"
+	ocsoperatorv1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/ocs/operatorv1"
+	olmv1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/olm/operators/v1"
+	olmv1alpha1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/olm/operators/v1alpha1"
+	olmv1alpha2 "github.com/openshift-kni/eco-goinfra/pkg/schemes/olm/operators/v1alpha2"
+	olmv2 "github.com/openshift-kni/eco-goinfra/pkg/schemes/olm/operators/v2"
+	packageserverv1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/olm/package-server/operators/v1"
+	ptpv1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/ptp/v1"
+	siteconfigv1alpha1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/siteconfig/v1alpha1"
+)
+
+// fakeClusterSchemeAttachers are the schemes under pkg/schemes registered on every test client. Copies of upstream
+// APIs that other packages register with their upstream types, such as hive, velero, nfd v1, objectbucket and
+// network-attachment-definition, and the schemes embedding them, such as ceph, are left out since a GVK can only be
+// registered with a single type.
+var fakeClusterSchemeAttachers = []SchemeAttacher{
+	amdgpuv1alpha1.AddToScheme,
+	argocdoperator.AddToScheme,
+	argocdtypesv1alpha1.AddToScheme,
+	hiveextv1beta1.AddToScheme,
+	fectypes.AddToScheme,
+	ibguv1alpha1.AddToScheme,
+	ibiv1alpha1.AddToScheme,
+	kmmhubv1beta1.AddToScheme,
+	kmmv1beta1.AddToScheme,
+	kmmv1beta2.AddToScheme,
+	frrtypes.AddToScheme,
+	mlboperator.AddToScheme,
+	mlbtypes.AddToScheme,
+	mlbtypesv1beta2.AddToScheme,
+	nfdfeaturev1alpha1.AddToScheme,
+	nfdv1alpha1.AddToScheme,
+	nvidiagputypes.AddToScheme,
+	oadpv1alpha1.AddToScheme,
+	ocmclusterv1.AddToScheme,
+	ocsoperatorv1.AddToScheme,
+	olmv1.AddToScheme,
+	olmv1alpha1.AddToScheme,
+	olmv1alpha2.AddToScheme,
+	olmv2.AddToScheme,
+	packageserverv1.AddToScheme,
+	ptpv1.AddToScheme,
+	siteconfigv1alpha1.AddToScheme,
+}
+
+// fakeClusterTracker is the object tracker shared by the typed, dynamic and runtime fake clients. It stores every
+// object in its typed form so that each client can read objects written by the others, and rejects writes carrying a
+// stale resource version with a Conflict as a real cluster does.
+type fakeClusterTracker struct {
+	k8sTesting.ObjectTracker
+	scheme     *runtime.Scheme
+	simulators *simulatorRunner
+}
+
+// newFakeClusterTracker returns an empty fakeClusterTracker for objects registered in scheme, invoking simulators on
+// the objects it stores.
+func newFakeClusterTracker(scheme *runtime.Scheme, simulators []ControllerSimulator) (*fakeClusterTracker, error) {
+	runner, err := newSimulatorRunner(scheme, simulators)
+	if err != nil {
+		return nil, err
+	}
+
+	return &fakeClusterTracker{
+		ObjectTracker: k8sTesting.NewObjectTracker(scheme, serializer.NewCodecFactory(scheme).UniversalDecoder()),
+		scheme:        scheme,
+		simulators:    runner,
+	}, nil
+}
+
+// Add seeds obj in the tracker. Lists are added item by item and objects without a resource version get the same
+// initial resource version as objects seeded in the controller-runtime fake client.
+func (tracker *fakeClusterTracker) Add(obj runtime.Object) error {
+	objects := []runtime.Object{obj}
+
+	if meta.IsListType(obj) {
+		var err error
+
+		objects, err = meta.ExtractList(obj)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, object := range objects {
+		object, err := tracker.typed(object)
+		if err != nil {
+			return err
+		}
+
+		accessor, err := meta.Accessor(object)
+		if err != nil {
+			return err
+		}
+
+		if accessor.GetResourceVersion() == "" {
+			accessor.SetResourceVersion("999")
+		}
+
+		if err := tracker.ObjectTracker.Add(object); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Create stores obj in the tracker, setting the initial resource version if it is missing.
+func (tracker *fakeClusterTracker) Create(
+	gvr schema.GroupVersionResource, obj runtime.Object, ns string, opts ...metav1.CreateOptions) error {
+	obj, err := tracker.typed(obj)
+	if err != nil {
+		return err
+	}
+
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return err
+	}
+
+	if accessor.GetResourceVersion() == "" {
+		accessor.SetResourceVersion("1")
+	}
+
+	if err = tracker.ObjectTracker.Create(gvr, obj, ns, opts...); err != nil {
+		return err
+	}
+
+	tracker.simulate(SimulatorTriggerCreate, gvr, accessor.GetNamespace(), accessor.GetName())
+
+	return nil
+}
+
+// Update replaces obj in the tracker after checking its resource version.
+func (tracker *fakeClusterTracker) Update(
+	gvr schema.GroupVersionResource, obj runtime.Object, ns string, opts ...metav1.UpdateOptions) error {
+	obj, err := tracker.typed(obj)
+	if err != nil {
+		return err
+	}
+
+	if err = tracker.checkResourceVersion(gvr, obj, ns); err != nil {
+		return err
+	}
+
+	if err = tracker.ObjectTracker.Update(gvr, obj, ns, opts...); err != nil {
+		return err
+	}
+
+	tracker.simulateUpdate(gvr, obj, ns)
+
+	return nil
+}
+
+// Patch replaces obj in the tracker with its patched version after checking its resource version.
+func (tracker *fakeClusterTracker) Patch(
+	gvr schema.GroupVersionResource, obj runtime.Object, ns string, opts ...metav1.PatchOptions) error {
+	obj, err := tracker.typed(obj)
+	if err != nil {
+		return err
+	}
+
+	if err = tracker.checkResourceVersion(gvr, obj, ns); err != nil {
+		return err
+	}
+
+	if err = tracker.ObjectTracker.Patch(gvr, obj, ns, opts...); err != nil {
+		return err
+	}
+
+	tracker.simulateUpdate(gvr, obj, ns)
+
+	return nil
+}
+
+// simulateUpdate invokes the update simulators on obj once it is stored.
+func (tracker *fakeClusterTracker) simulateUpdate(gvr schema.GroupVersionResource, obj runtime.Object, ns string) {
+	if accessor, err := meta.Accessor(obj); err == nil {
+		tracker.simulate(SimulatorTriggerUpdate, gvr, ns, accessor.GetName())
+	}
+}
+
+// checkResourceVersion emulates the resource version handling of the API server for writes coming from the typed and
+// dynamic clients. An empty resource version means an unconditional write and an older one is a Conflict. The current
+// resource version is incremented so that writes still carrying it conflict afterwards. Writes from the
+// controller-runtime client already carry the incremented resource version and are kept as is.
+func (tracker *fakeClusterTracker) checkResourceVersion(
+	gvr schema.GroupVersionResource, obj runtime.Object, ns string) error {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return err
+	}
+
+	existing, err := tracker.ObjectTracker.Get(gvr, ns, accessor.GetName())
+	if err != nil {
+		// Let the underlying tracker report missing objects.
+		return nil //nolint:nilerr
+	}
+
+	existingAccessor, err := meta.Accessor(existing)
+	if err != nil {
+		return err
+	}
+
+	if accessor.GetResourceVersion() == "" {
+		accessor.SetResourceVersion(existingAccessor.GetResourceVersion())
+	}
+
+	newVersion, err := strconv.ParseUint(accessor.GetResourceVersion(), 10, 64)
+	if err != nil {
+		return nil //nolint:nilerr
+	}
+
+	oldVersion, err := strconv.ParseUint(existingAccessor.GetResourceVersion(), 10, 64)
+	if err != nil {
+		return nil //nolint:nilerr
+	}
+
+	if newVersion < oldVersion {
+		return k8serrors.NewConflict(gvr.GroupResource(), accessor.GetName(),
+			fmt.Errorf("the object has been modified; please apply your changes to the latest version and try again"))
+	}
+
+	if newVersion == oldVersion {
+		accessor.SetResourceVersion(strconv.FormatUint(newVersion+1, 10))
+	}
+
+	return nil
+}
+
+// typed converts unstructured objects of kinds registered in the scheme to their typed form.
+func (tracker *fakeClusterTracker) typed(obj runtime.Object) (runtime.Object, error) {
+	unstructuredObj, ok := obj.(runtime.Unstructured)
+	if !ok {
+		return obj, nil
+	}
+
+	gvk := obj.GetObjectKind().GroupVersionKind()
+	if !tracker.scheme.Recognizes(gvk) {
+		return obj, nil
+	}
+
+	typedObj, err := tracker.scheme.New(gvk)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, isUnstructured := typedObj.(runtime.Unstructured); isUnstructured {
+		return obj, nil
+	}
+
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(unstructuredObj.UnstructuredContent(), typedObj)
+	if err != nil {
+		return nil, err
+	}
+
+	typedObj.GetObjectKind().SetGroupVersionKind(gvk)
+
+	return typedObj, nil
+}
+
+// reactor returns the reaction and watch reaction serving the fake typed and dynamic clients from the tracker. Read
+// simulators are invoked before get and list requests are served.
+func (tracker *fakeClusterTracker) reactor() (k8sTesting.ReactionFunc, k8sTesting.WatchReactionFunc) {
+	objectReaction := k8sTesting.ObjectReaction(tracker)
+	reaction := func(action k8sTesting.Action) (bool, runtime.Object, error) {
+		switch action := action.(type) {
+		case k8sTesting.GetActionImpl:
+			tracker.simulate(SimulatorTriggerRead, action.GetResource(), action.GetNamespace(), action.GetName())
+		case k8sTesting.ListActionImpl:
+			tracker.simulateList(action.GetResource(), action.GetKind(), action.GetNamespace())
+		}
+
+		return objectReaction(action)
+	}
+
+	watchReaction := func(action k8sTesting.Action) (bool, watch.Interface, error) {
+		watcher, err := tracker.Watch(action.GetResource(), action.GetNamespace())
+		if err != nil {
+			return true, nil, err
+		}
+
+		return true, watcher, nil
+	}
+
+	return reaction, watchReaction
+}
+
+// newFakeTypedClient returns a fake clientset serving all requests from tracker.
+func newFakeTypedClient(tracker *fakeClusterTracker) *k8sFakeClient.Clientset {
+	clientSet := k8sFakeClient.NewSimpleClientset()
+	reaction, watchReaction := tracker.reactor()
+
+	clientSet.PrependReactor("*", "*", reaction)
+	clientSet.PrependWatchReactor("*", watchReaction)
+
+	return clientSet
+}
+
+// newFakeDynamicClient returns a fake dynamic client serving all requests from tracker.
+func newFakeDynamicClient(tracker *fakeClusterTracker) *dynamicFake.FakeDynamicClient {
+	dynamicClient := dynamicFake.NewSimpleDynamicClientWithCustomListKinds(tracker.scheme, nil)
+	reaction, watchReaction := tracker.reactor()
+
+	dynamicClient.PrependReactor("*", "*", reaction)
+	dynamicClient.PrependWatchReactor("*", watchReaction)
+
+	return dynamicClient
+}
+
+// registerTestGVKs registers each of gvks with the mock object whose type name matches its kind, falling back to the
+// object at the same index, for mock objects whose types are not part of any scheme.
+func registerTestGVKs(scheme *runtime.Scheme, gvks []schema.GroupVersionKind, objects []runtime.Object) {
+	for index, gvk := range gvks {
+		if scheme.Recognizes(gvk) {
+			continue
+		}
+
+		var match runtime.Object
+
+		for _, object := range objects {
+			if reflect.Indirect(reflect.ValueOf(object)).Type().Name() == gvk.Kind {
+				match = object
+
+				break
+			}
+		}
+
+		if match == nil && index < len(objects) {
+			match = objects[index]
+		}
+
+		if match == nil {
+			glog.V(100).Infof("No mock object found for GVK %s", gvk)
+
+			continue
+		}
+
+		scheme.AddKnownTypeWithName(gvk, match)
+	}
+}
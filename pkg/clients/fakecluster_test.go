@@ -0,0 +1,173 @@
+package clients
+
+import (
+	"testing"
+
+	olmv1alpha1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/olm/operators/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	defaultFakeClusterName      = "test-name"
+	defaultFakeClusterNamespace = "test-namespace"
+)
+
+var (
+	subscriptionGVR = olmv1alpha1.SchemeGroupVersion.WithResource("subscriptions")
+	podGVR          = corev1.SchemeGroupVersion.WithResource("pods")
+)
+
+func TestFakeClusterSharedSubscription(t *testing.T) {
+	testSettings := GetTestClients(TestClientParams{K8sMockObjects: []runtime.Object{buildDummySubscription()}})
+
+	subscription := &olmv1alpha1.Subscription{}
+	err := testSettings.Get(t.Context(), runtimeClient.ObjectKey{
+		Name: defaultFakeClusterName, Namespace: defaultFakeClusterNamespace}, subscription)
+	assert.Nil(t, err)
+	assert.Equal(t, "test-package", subscription.Spec.Package)
+
+	unstructuredSubscription, err := testSettings.Resource(subscriptionGVR).Namespace(defaultFakeClusterNamespace).
+		Get(t.Context(), defaultFakeClusterName, metav1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, "Subscription", unstructuredSubscription.GetKind())
+
+	subscriptionList, err := testSettings.Resource(subscriptionGVR).Namespace(defaultFakeClusterNamespace).
+		List(t.Context(), metav1.ListOptions{})
+	assert.Nil(t, err)
+	assert.Len(t, subscriptionList.Items, 1)
+
+	unstructuredSubscription.SetLabels(map[string]string{"dynamic": "true"})
+	_, err = testSettings.Resource(subscriptionGVR).Namespace(defaultFakeClusterNamespace).
+		Update(t.Context(), unstructuredSubscription, metav1.UpdateOptions{})
+	assert.Nil(t, err)
+
+	err = testSettings.Get(t.Context(), runtimeClient.ObjectKeyFromObject(subscription), subscription)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"dynamic": "true"}, subscription.Labels)
+}
+
+func TestFakeClusterSharedPod(t *testing.T) {
+	testSettings := GetTestClients(TestClientParams{K8sMockObjects: []runtime.Object{buildDummyPod()}})
+
+	pod := &corev1.Pod{}
+	err := testSettings.Get(t.Context(), runtimeClient.ObjectKey{
+		Name: defaultFakeClusterName, Namespace: defaultFakeClusterNamespace}, pod)
+	assert.Nil(t, err)
+
+	pod.Labels = map[string]string{"runtime": "true"}
+	err = testSettings.Update(t.Context(), pod)
+	assert.Nil(t, err)
+
+	typedPod, err := testSettings.Pods(defaultFakeClusterNamespace).
+		Get(t.Context(), defaultFakeClusterName, metav1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"runtime": "true"}, typedPod.Labels)
+	assert.Equal(t, pod.ResourceVersion, typedPod.ResourceVersion)
+
+	unstructuredPod, err := testSettings.Resource(podGVR).Namespace(defaultFakeClusterNamespace).
+		Get(t.Context(), defaultFakeClusterName, metav1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"runtime": "true"}, unstructuredPod.GetLabels())
+
+	err = testSettings.Pods(defaultFakeClusterNamespace).
+		Delete(t.Context(), defaultFakeClusterName, metav1.DeleteOptions{})
+	assert.Nil(t, err)
+
+	err = testSettings.Get(t.Context(), runtimeClient.ObjectKeyFromObject(pod), pod)
+	assert.True(t, k8serrors.IsNotFound(err))
+}
+
+func TestFakeClusterConflict(t *testing.T) {
+	testCases := []struct {
+		resourceVersion  string
+		expectedConflict bool
+	}{
+		{
+			resourceVersion:  "",
+			expectedConflict: false,
+		},
+		{
+			resourceVersion:  "1000",
+			expectedConflict: false,
+		},
+		{
+			resourceVersion:  "1",
+			expectedConflict: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := GetTestClients(TestClientParams{K8sMockObjects: []runtime.Object{buildDummyPod()}})
+
+		pod := &corev1.Pod{}
+		err := testSettings.Get(t.Context(), runtimeClient.ObjectKey{
+			Name: defaultFakeClusterName, Namespace: defaultFakeClusterNamespace}, pod)
+		assert.Nil(t, err)
+
+		err = testSettings.Update(t.Context(), pod)
+		assert.Nil(t, err)
+
+		pod.ResourceVersion = testCase.resourceVersion
+		_, err = testSettings.Pods(defaultFakeClusterNamespace).Update(t.Context(), pod, metav1.UpdateOptions{})
+		assert.Equal(t, testCase.expectedConflict, k8serrors.IsConflict(err))
+	}
+}
+
+func TestFakeClusterMultipleGVK(t *testing.T) {
+	type FirstDummy struct{ corev1.ConfigMap }
+
+	type SecondDummy struct{ corev1.Secret }
+
+	firstGVK := schema.GroupVersionKind{Group: "test.io", Version: "v1", Kind: "FirstDummy"}
+	secondGVK := schema.GroupVersionKind{Group: "test.io", Version: "v1", Kind: "SecondDummy"}
+
+	testSettings, _ := GetModifiableTestClients(TestClientParams{
+		K8sMockObjects: []runtime.Object{&SecondDummy{}, &FirstDummy{}},
+		GVK:            []schema.GroupVersionKind{firstGVK, secondGVK},
+	})
+
+	assert.True(t, testSettings.scheme.Recognizes(firstGVK))
+	assert.True(t, testSettings.scheme.Recognizes(secondGVK))
+
+	kinds, _, err := testSettings.scheme.ObjectKinds(&FirstDummy{})
+	assert.Nil(t, err)
+	assert.Equal(t, []schema.GroupVersionKind{firstGVK}, kinds)
+}
+
+func TestFakeClusterUnsupportedObject(t *testing.T) {
+	type UnknownDummy struct{ corev1.ConfigMap }
+
+	testSettings := GetTestClients(TestClientParams{K8sMockObjects: []runtime.Object{&UnknownDummy{}, buildDummyPod()}})
+	assert.NotNil(t, testSettings)
+
+	err := testSettings.Get(t.Context(), runtimeClient.ObjectKey{
+		Name: defaultFakeClusterName, Namespace: defaultFakeClusterNamespace}, &corev1.Pod{})
+	assert.Nil(t, err)
+}
+
+func buildDummySubscription() *olmv1alpha1.Subscription {
+	return &olmv1alpha1.Subscription{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      defaultFakeClusterName,
+			Namespace: defaultFakeClusterNamespace,
+		},
+		Spec: &olmv1alpha1.SubscriptionSpec{
+			Package: "test-package",
+		},
+	}
+}
+
+func buildDummyPod() *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      defaultFakeClusterName,
+			Namespace: defaultFakeClusterNamespace,
+		},
+	}
+}
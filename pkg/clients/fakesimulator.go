@@ -0,0 +1,347 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+// SimulatorTrigger is a kind of request to the fake cluster that invokes a ControllerSimulator.
+type SimulatorTrigger string
+
+const (
+	// SimulatorTriggerCreate invokes the simulator right after an object is created.
+	SimulatorTriggerCreate SimulatorTrigger = "create"
+	// SimulatorTriggerUpdate invokes the simulator right after an object is updated or patched.
+	SimulatorTriggerUpdate SimulatorTrigger = "update"
+	// SimulatorTriggerRead invokes the simulator right before an object is read by a get or list request, that is on
+	// every poll of a wait helper.
+	SimulatorTriggerRead SimulatorTrigger = "read"
+	// SimulatorTriggerTimer invokes the simulator on every matching object each time its Interval elapses, regardless
+	// of the requests made to the fake cluster. It is only used when listed in Triggers and TestClientParams has a
+	// Context.
+	SimulatorTriggerTimer SimulatorTrigger = "timer"
+)
+
+// ControllerSimulator stands in for the controller of a kind in the fake cluster returned by GetTestClients. Whenever
+// one of its triggers fires for a matching object, Reconcile is called with a copy of the stored object and the number
+// of times it has been called for that object so far, starting at 1. If Reconcile modifies the object, the change is
+// stored with a new resource version as if a controller had written it, so that wait helpers relying on status
+// updates can be covered deterministically.
+type ControllerSimulator struct {
+	// Object is an instance of the kind reconciled by the simulator, for example &appsv1.Deployment{}.
+	Object runtime.Object
+	// Name and Namespace restrict the simulator to the objects with this name and namespace when set.
+	Name      string
+	Namespace string
+	// Triggers are the requests invoking the simulator. All triggers but the timer are used when empty.
+	Triggers []SimulatorTrigger
+	// Interval is the period of the timer trigger. It must be positive when Triggers contains SimulatorTriggerTimer.
+	Interval time.Duration
+	// Reconcile mutates object in place.
+	Reconcile func(object runtime.Object, call int)
+}
+
+// SimulateAfter returns a Reconcile function applying mutate from the call-th invocation of the simulator onward. For
+// example, SimulateAfter(3, markReady) with SimulatorTriggerRead marks an object ready on the third time it is read.
+func SimulateAfter(call int, mutate func(object runtime.Object)) func(object runtime.Object, call int) {
+	return func(object runtime.Object, current int) {
+		if current >= call {
+			mutate(object)
+		}
+	}
+}
+
+// SimulateSequence returns a Reconcile function applying the steps one at a time: the first invocation of the
+// simulator applies the first step, the second invocation the second step and so on. Later invocations leave the
+// object unchanged. It allows advancing an object through intermediate states, such as Updating then Updated.
+func SimulateSequence(steps ...func(object runtime.Object)) func(object runtime.Object, call int) {
+	return func(object runtime.Object, call int) {
+		if call <= len(steps) {
+			steps[call-1](object)
+		}
+	}
+}
+
+// simulatorRunner invokes the controller simulators registered on a fakeClusterTracker.
+type simulatorRunner struct {
+	simulators []ControllerSimulator
+	kinds      []schema.GroupVersionKind
+	calls      map[string]int
+	mutex      sync.Mutex
+}
+
+// newSimulatorRunner returns a simulatorRunner for simulators, resolving the kind of each of them from scheme.
+func newSimulatorRunner(scheme *runtime.Scheme, simulators []ControllerSimulator) (*simulatorRunner, error) {
+	runner := &simulatorRunner{simulators: simulators, calls: map[string]int{}}
+
+	for index, simulator := range simulators {
+		if simulator.Object == nil || simulator.Reconcile == nil {
+			return nil, fmt.Errorf("controller simulator %d must have an object and a reconcile function", index)
+		}
+
+		if simulator.hasTrigger(SimulatorTriggerTimer) && simulator.Interval <= 0 {
+			return nil, fmt.Errorf("controller simulator %d must have a positive interval to use the timer trigger", index)
+		}
+
+		gvk, err := apiutil.GVKForObject(simulator.Object, scheme)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get kind of controller simulator %d: %w", index, err)
+		}
+
+		runner.kinds = append(runner.kinds, gvk)
+	}
+
+	return runner, nil
+}
+
+// Watch watches the objects of gvr in namespace ns. Kinds reconciled by a simulator with the read trigger cannot be
+// watched, so that wait helpers fall back to polling and invoke the simulator on every poll as documented.
+func (tracker *fakeClusterTracker) Watch(
+	gvr schema.GroupVersionResource, ns string, opts ...metav1.ListOptions) (watch.Interface, error) {
+	if tracker.simulators != nil && tracker.simulators.readsKind(gvr) {
+		return nil, k8serrors.NewMethodNotSupported(gvr.GroupResource(), "watch")
+	}
+
+	return tracker.ObjectTracker.Watch(gvr, ns, opts...)
+}
+
+// readsKind returns true if a simulator with the read trigger reconciles the kind of gvr.
+func (runner *simulatorRunner) readsKind(gvr schema.GroupVersionResource) bool {
+	for index, simulator := range runner.simulators {
+		plural, _ := meta.UnsafeGuessKindToResource(runner.kinds[index])
+		if plural == gvr && simulator.matches(SimulatorTriggerRead, simulator.Namespace, simulator.Name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// simulate invokes the simulators matching trigger and the object with the given name and namespace, storing the
+// result in tracker if it changed.
+func (tracker *fakeClusterTracker) simulate(
+	trigger SimulatorTrigger, gvr schema.GroupVersionResource, namespace, name string) {
+	runner := tracker.simulators
+	if runner == nil || len(runner.simulators) == 0 {
+		return
+	}
+
+	runner.mutex.Lock()
+	defer runner.mutex.Unlock()
+
+	for index, simulator := range runner.simulators {
+		if simulator.matches(trigger, namespace, name) {
+			tracker.reconcile(index, gvr, namespace, name)
+		}
+	}
+}
+
+// reconcile invokes the simulator at index on the object with the given name and namespace, storing the result in
+// tracker if it changed. The caller must hold the mutex of the simulator runner.
+func (tracker *fakeClusterTracker) reconcile(index int, gvr schema.GroupVersionResource, namespace, name string) {
+	runner := tracker.simulators
+
+	stored, err := tracker.ObjectTracker.Get(gvr, namespace, name)
+	if err != nil {
+		return
+	}
+
+	gvks, _, err := tracker.scheme.ObjectKinds(stored)
+	if err != nil || len(gvks) == 0 || gvks[0] != runner.kinds[index] {
+		return
+	}
+
+	key := fmt.Sprintf("%d/%s/%s/%s", index, gvr.String(), namespace, name)
+	runner.calls[key]++
+
+	object := stored.DeepCopyObject()
+	runner.simulators[index].Reconcile(object, runner.calls[key])
+
+	if equality.Semantic.DeepEqual(stored, object) {
+		return
+	}
+
+	glog.V(100).Infof("Controller simulator %d updated %s %s in namespace %s", index, gvr.Resource, name, namespace)
+
+	if err = storeSimulatedObject(tracker, gvr, object, namespace); err != nil {
+		glog.V(100).Infof("Failed to store simulated %s %s in namespace %s: %v", gvr.Resource, name, namespace, err)
+	}
+}
+
+// startTimers starts a goroutine for each simulator with the timer trigger, invoking it on the matching objects every
+// time its interval elapses until ctx is done.
+func (tracker *fakeClusterTracker) startTimers(ctx context.Context) {
+	if tracker.simulators == nil {
+		return
+	}
+
+	for index, simulator := range tracker.simulators.simulators {
+		if !simulator.hasTrigger(SimulatorTriggerTimer) {
+			continue
+		}
+
+		go func() {
+			ticker := time.NewTicker(simulator.Interval)
+			defer ticker.Stop()
+
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					tracker.tick(index)
+				}
+			}
+		}()
+	}
+}
+
+// tick invokes the timer simulator at index on every object of its kind matching its name and namespace.
+func (tracker *fakeClusterTracker) tick(index int) {
+	runner := tracker.simulators
+	simulator := runner.simulators[index]
+	gvr, _ := meta.UnsafeGuessKindToResource(runner.kinds[index])
+
+	list, err := tracker.ObjectTracker.List(gvr, runner.kinds[index], simulator.Namespace)
+	if err != nil {
+		return
+	}
+
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return
+	}
+
+	runner.mutex.Lock()
+	defer runner.mutex.Unlock()
+
+	for _, item := range items {
+		accessor, err := meta.Accessor(item)
+		if err != nil {
+			continue
+		}
+
+		if simulator.matches(SimulatorTriggerTimer, accessor.GetNamespace(), accessor.GetName()) {
+			tracker.reconcile(index, gvr, accessor.GetNamespace(), accessor.GetName())
+		}
+	}
+}
+
+// simulateList invokes the read simulators on every object of the listed kind in namespace.
+func (tracker *fakeClusterTracker) simulateList(
+	gvr schema.GroupVersionResource, gvk schema.GroupVersionKind, namespace string) {
+	if tracker.simulators == nil || len(tracker.simulators.simulators) == 0 {
+		return
+	}
+
+	list, err := tracker.ObjectTracker.List(gvr, gvk, namespace)
+	if err != nil {
+		return
+	}
+
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return
+	}
+
+	for _, item := range items {
+		accessor, err := meta.Accessor(item)
+		if err != nil {
+			continue
+		}
+
+		tracker.simulate(SimulatorTriggerRead, gvr, accessor.GetNamespace(), accessor.GetName())
+	}
+}
+
+// matches returns true if the simulator is invoked by trigger for the object with the given name and namespace.
+func (simulator ControllerSimulator) matches(trigger SimulatorTrigger, namespace, name string) bool {
+	if simulator.Name != "" && simulator.Name != name {
+		return false
+	}
+
+	if simulator.Namespace != "" && simulator.Namespace != namespace {
+		return false
+	}
+
+	if len(simulator.Triggers) == 0 {
+		return trigger != SimulatorTriggerTimer
+	}
+
+	return simulator.hasTrigger(trigger)
+}
+
+// hasTrigger returns true if trigger is listed in the triggers of the simulator.
+func (simulator ControllerSimulator) hasTrigger(trigger SimulatorTrigger) bool {
+	for _, simulatorTrigger := range simulator.Triggers {
+		if simulatorTrigger == trigger {
+			return true
+		}
+	}
+
+	return false
+}
+
+// storeSimulatedObject writes object to tracker, incrementing its resource version as the API server would.
+func storeSimulatedObject(
+	tracker *fakeClusterTracker, gvr schema.GroupVersionResource, object runtime.Object, namespace string) error {
+	accessor, err := meta.Accessor(object)
+	if err != nil {
+		return err
+	}
+
+	if version, err := strconv.ParseUint(accessor.GetResourceVersion(), 10, 64); err == nil {
+		accessor.SetResourceVersion(strconv.FormatUint(version+1, 10))
+	}
+
+	return tracker.ObjectTracker.Update(gvr, object, namespace)
+}
+
+// simulateRuntimeGet is the Get interceptor of the runtime fake client invoking the read simulators.
+func (tracker *fakeClusterTracker) simulateRuntimeGet(
+	ctx context.Context,
+	client runtimeClient.WithWatch,
+	key runtimeClient.ObjectKey,
+	obj runtimeClient.Object,
+	opts ...runtimeClient.GetOption) error {
+	if gvk, err := apiutil.GVKForObject(obj, tracker.scheme); err == nil {
+		gvr, _ := meta.UnsafeGuessKindToResource(gvk)
+		tracker.simulate(SimulatorTriggerRead, gvr, key.Namespace, key.Name)
+	}
+
+	return client.Get(ctx, key, obj, opts...)
+}
+
+// simulateRuntimeList is the List interceptor of the runtime fake client invoking the read simulators.
+func (tracker *fakeClusterTracker) simulateRuntimeList(
+	ctx context.Context,
+	client runtimeClient.WithWatch,
+	list runtimeClient.ObjectList,
+	opts ...runtimeClient.ListOption) error {
+	if gvk, err := apiutil.GVKForObject(list, tracker.scheme); err == nil {
+		gvk.Kind = strings.TrimSuffix(gvk.Kind, "List")
+		gvr, _ := meta.UnsafeGuessKindToResource(gvk)
+
+		listOptions := &runtimeClient.ListOptions{}
+		listOptions.ApplyOptions(opts)
+
+		tracker.simulateList(gvr, gvk, listOptions.Namespace)
+	}
+
+	return client.List(ctx, list, opts...)
+}
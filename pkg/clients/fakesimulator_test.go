@@ -0,0 +1,182 @@
+package clients
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestControllerSimulatorTriggers(t *testing.T) {
+	testCases := []struct {
+		triggers      []SimulatorTrigger
+		name          string
+		expectedPhase corev1.PodPhase
+	}{
+		{
+			triggers:      nil,
+			expectedPhase: corev1.PodRunning,
+		},
+		{
+			triggers:      []SimulatorTrigger{SimulatorTriggerRead},
+			expectedPhase: corev1.PodRunning,
+		},
+		{
+			triggers:      []SimulatorTrigger{SimulatorTriggerCreate},
+			expectedPhase: corev1.PodPending,
+		},
+		{
+			name:          "other-name",
+			expectedPhase: corev1.PodPending,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := GetTestClients(TestClientParams{
+			K8sMockObjects: []runtime.Object{buildDummyPod()},
+			Simulators: []ControllerSimulator{{
+				Object:    &corev1.Pod{},
+				Name:      testCase.name,
+				Triggers:  testCase.triggers,
+				Reconcile: SimulateAfter(1, setPodPhase(corev1.PodRunning)),
+			}},
+		})
+
+		pod, err := testSettings.Pods(defaultFakeClusterNamespace).
+			Get(t.Context(), defaultFakeClusterName, metav1.GetOptions{})
+		assert.Nil(t, err)
+
+		if testCase.expectedPhase == corev1.PodRunning {
+			assert.Equal(t, corev1.PodRunning, pod.Status.Phase)
+			assert.Equal(t, "1000", pod.ResourceVersion)
+		} else {
+			assert.Empty(t, pod.Status.Phase)
+			assert.Equal(t, "999", pod.ResourceVersion)
+		}
+	}
+}
+
+func TestControllerSimulatorSequence(t *testing.T) {
+	testSettings := GetTestClients(TestClientParams{
+		Simulators: []ControllerSimulator{{
+			Object:   &corev1.Pod{},
+			Triggers: []SimulatorTrigger{SimulatorTriggerCreate, SimulatorTriggerRead},
+			Reconcile: SimulateSequence(
+				setPodPhase(corev1.PodPending), setPodPhase(corev1.PodRunning), setPodPhase(corev1.PodSucceeded)),
+		}},
+	})
+
+	err := testSettings.Create(t.Context(), buildDummyPod())
+	assert.Nil(t, err)
+
+	for _, expectedPhase := range []corev1.PodPhase{corev1.PodRunning, corev1.PodSucceeded, corev1.PodSucceeded} {
+		pod := &corev1.Pod{}
+		err = testSettings.Get(t.Context(), runtimeClient.ObjectKey{
+			Name: defaultFakeClusterName, Namespace: defaultFakeClusterNamespace}, pod)
+		assert.Nil(t, err)
+		assert.Equal(t, expectedPhase, pod.Status.Phase)
+	}
+
+	podList := &corev1.PodList{}
+	err = testSettings.List(t.Context(), podList, runtimeClient.InNamespace(defaultFakeClusterNamespace))
+	assert.Nil(t, err)
+	assert.Len(t, podList.Items, 1)
+	assert.Equal(t, corev1.PodSucceeded, podList.Items[0].Status.Phase)
+}
+
+func TestControllerSimulatorUpdate(t *testing.T) {
+	testSettings := GetTestClients(TestClientParams{
+		K8sMockObjects: []runtime.Object{buildDummyPod()},
+		Simulators: []ControllerSimulator{{
+			Object:    &corev1.Pod{},
+			Triggers:  []SimulatorTrigger{SimulatorTriggerUpdate},
+			Reconcile: SimulateAfter(1, setPodPhase(corev1.PodRunning)),
+		}},
+	})
+
+	pod, err := testSettings.Pods(defaultFakeClusterNamespace).
+		Get(t.Context(), defaultFakeClusterName, metav1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Empty(t, pod.Status.Phase)
+
+	pod.Labels = map[string]string{"updated": "true"}
+	_, err = testSettings.Pods(defaultFakeClusterNamespace).Update(t.Context(), pod, metav1.UpdateOptions{})
+	assert.Nil(t, err)
+
+	err = testSettings.Get(t.Context(), runtimeClient.ObjectKeyFromObject(pod), pod)
+	assert.Nil(t, err)
+	assert.Equal(t, corev1.PodRunning, pod.Status.Phase)
+
+	pod.Labels = map[string]string{"updated": "again"}
+	err = testSettings.Update(t.Context(), pod)
+	assert.Nil(t, err)
+}
+
+func TestControllerSimulatorTimer(t *testing.T) {
+	testSettings := GetTestClients(TestClientParams{
+		K8sMockObjects: []runtime.Object{buildDummyPod()},
+		Simulators: []ControllerSimulator{{
+			Object:    &corev1.Pod{},
+			Triggers:  []SimulatorTrigger{SimulatorTriggerTimer},
+			Interval:  10 * time.Millisecond,
+			Reconcile: SimulateSequence(setPodPhase(corev1.PodRunning), setPodPhase(corev1.PodSucceeded)),
+		}},
+		Context: t.Context(),
+	})
+
+	assert.Eventually(t, func() bool {
+		pod, err := testSettings.Pods(defaultFakeClusterNamespace).
+			Get(t.Context(), defaultFakeClusterName, metav1.GetOptions{})
+
+		return err == nil && pod.Status.Phase == corev1.PodSucceeded
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestControllerSimulatorTimerWithoutContext(t *testing.T) {
+	testSettings := GetTestClients(TestClientParams{
+		K8sMockObjects: []runtime.Object{buildDummyPod()},
+		Simulators: []ControllerSimulator{{
+			Object:    &corev1.Pod{},
+			Triggers:  []SimulatorTrigger{SimulatorTriggerTimer},
+			Interval:  time.Millisecond,
+			Reconcile: SimulateSequence(setPodPhase(corev1.PodRunning)),
+		}},
+	})
+
+	time.Sleep(50 * time.Millisecond)
+
+	pod, err := testSettings.Pods(defaultFakeClusterNamespace).
+		Get(t.Context(), defaultFakeClusterName, metav1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Empty(t, pod.Status.Phase)
+}
+
+func TestControllerSimulatorInvalid(t *testing.T) {
+	testCases := []ControllerSimulator{
+		{Object: &corev1.Pod{}},
+		{
+			Object:    &corev1.Pod{},
+			Triggers:  []SimulatorTrigger{SimulatorTriggerTimer},
+			Reconcile: SimulateAfter(1, setPodPhase(corev1.PodRunning)),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings, testBuilder := GetModifiableTestClients(TestClientParams{
+			Simulators: []ControllerSimulator{testCase},
+		})
+
+		assert.Nil(t, testSettings)
+		assert.Nil(t, testBuilder)
+	}
+}
+
+func setPodPhase(phase corev1.PodPhase) func(object runtime.Object) {
+	return func(object runtime.Object) {
+		object.(*corev1.Pod).Status.Phase = phase
+	}
+}
@@ -0,0 +1,551 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	configv1 "github.com/openshift/api/config/v1"
+	mcv1 "github.com/openshift/api/machineconfiguration/v1"
+	corev1 "k8s.io/api/core/v1"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// DefaultStabilityPollInterval is the interval between two evaluations of the cluster when none is provided.
+	DefaultStabilityPollInterval = 10 * time.Second
+	// clusterVersionName is the name of the singleton ClusterVersion.
+	clusterVersionName = "version"
+	// clusterVersionFailing is the condition the cluster-version-operator sets when it cannot reconcile the cluster.
+	clusterVersionFailing configv1.ClusterStatusConditionType = "Failing"
+	// maxErrorBlockers is the number of blockers included in the error returned when the cluster is not stable.
+	maxErrorBlockers = 5
+)
+
+// StabilityCheck is a part of the cluster evaluated by CheckStability.
+type StabilityCheck string
+
+const (
+	// CheckClusterOperators requires every ClusterOperator to be Available and neither Progressing nor Degraded.
+	CheckClusterOperators StabilityCheck = "ClusterOperators"
+	// CheckMachineConfigPools requires every MachineConfigPool to be updated with all its machines ready.
+	CheckMachineConfigPools StabilityCheck = "MachineConfigPools"
+	// CheckNodes requires every node to be Ready.
+	CheckNodes StabilityCheck = "Nodes"
+	// CheckPods requires every pod to be running and ready or to have succeeded. Failed pods that are never restarted
+	// are ignored.
+	CheckPods StabilityCheck = "Pods"
+	// CheckClusterVersion requires the ClusterVersion to be Available and neither Progressing nor Failing.
+	CheckClusterVersion StabilityCheck = "ClusterVersion"
+)
+
+// AllStabilityChecks are the checks evaluated when StabilityOptions.Checks is empty.
+var AllStabilityChecks = []StabilityCheck{
+	CheckClusterOperators, CheckMachineConfigPools, CheckNodes, CheckPods, CheckClusterVersion}
+
+// StabilityOptions configures CheckStability and WaitForStable.
+type StabilityOptions struct {
+	// Checks are the checks to evaluate, AllStabilityChecks when empty.
+	Checks []StabilityCheck
+	// PodNamespaces are the namespaces whose pods are checked, all namespaces when empty.
+	PodNamespaces []string
+	// StableFor is the duration the cluster must stay stable for WaitForStable to succeed. A single stable evaluation
+	// is enough when zero.
+	StableFor time.Duration
+	// Timeout bounds WaitForStable. The wait is only bounded by its context when zero.
+	Timeout time.Duration
+	// PollInterval is the interval between two evaluations, DefaultStabilityPollInterval when zero.
+	PollInterval time.Duration
+}
+
+// Blocker is an object preventing the cluster from being stable.
+type Blocker struct {
+	Check     StabilityCheck
+	Kind      string
+	Namespace string
+	Name      string
+	// Reason describes why the object is not stable, for example "Available=False (OAuthServerDown)".
+	Reason string
+}
+
+// String returns a short description of the blocker, for example "Pod openshift-dns/dns-default-x: not ready".
+func (blocker Blocker) String() string {
+	name := blocker.Name
+	if blocker.Namespace != "" {
+		name = blocker.Namespace + "/" + blocker.Name
+	}
+
+	return fmt.Sprintf("%s %s: %s", blocker.Kind, name, blocker.Reason)
+}
+
+// StabilityReport is the result of the evaluation of the stability of the cluster.
+type StabilityReport struct {
+	// Stable is true if no blocker was found, for the whole StableFor window when waiting.
+	Stable bool
+	Start  time.Time
+	// Duration is the time spent evaluating or waiting for the cluster.
+	Duration time.Duration
+	// StableSince is the time since which the cluster is continuously stable, zero if it is not stable.
+	StableSince time.Time
+	// Evaluations is the number of times the cluster was evaluated.
+	Evaluations int
+	// Blockers are the objects preventing the stability of the cluster at the last evaluation, sorted by check.
+	Blockers []Blocker
+}
+
+// BlockersFor returns the blockers found by check at the last evaluation.
+func (report *StabilityReport) BlockersFor(check StabilityCheck) []Blocker {
+	var blockers []Blocker
+
+	for _, blocker := range report.Blockers {
+		if blocker.Check == check {
+			blockers = append(blockers, blocker)
+		}
+	}
+
+	return blockers
+}
+
+// String returns a human readable summary of the report listing all the blockers.
+func (report *StabilityReport) String() string {
+	var builder strings.Builder
+
+	state := "stable"
+	if !report.Stable {
+		state = "not stable"
+	}
+
+	fmt.Fprintf(&builder, "cluster %s after %d evaluations in %v, %d blockers\n",
+		state, report.Evaluations, report.Duration.Round(time.Second), len(report.Blockers))
+
+	for _, blocker := range report.Blockers {
+		fmt.Fprintf(&builder, "  [%s] %s\n", blocker.Check, blocker)
+	}
+
+	return builder.String()
+}
+
+// CheckStability evaluates once whether the cluster is stable and returns the blockers found.
+func CheckStability(apiClient *clients.Settings, options StabilityOptions) (*StabilityReport, error) {
+	return CheckStabilityWithContext(context.Background(), apiClient, options)
+}
+
+// CheckStabilityWithContext evaluates once whether the cluster is stable using the provided context and returns the
+// blockers found.
+func CheckStabilityWithContext(
+	ctx context.Context, apiClient *clients.Settings, options StabilityOptions) (*StabilityReport, error) {
+	checks, err := validateStabilityOptions(apiClient, options)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &StabilityReport{Start: time.Now()}
+	report.update(evaluateStability(ctx, apiClient, checks, options), time.Now())
+	report.Duration = time.Since(report.Start)
+
+	return report, nil
+}
+
+// WaitForStable waits until the cluster is stable for options.StableFor, evaluating all the checks concurrently every
+// options.PollInterval. The report of the last evaluation is returned along with the error so that the objects
+// blocking the stability of the cluster can be reported.
+func WaitForStable(apiClient *clients.Settings, options StabilityOptions) (*StabilityReport, error) {
+	return WaitForStableWithContext(context.Background(), apiClient, options)
+}
+
+// WaitForStableWithContext waits until the cluster is stable for options.StableFor or until ctx is done. See
+// WaitForStable.
+func WaitForStableWithContext(
+	ctx context.Context, apiClient *clients.Settings, options StabilityOptions) (*StabilityReport, error) {
+	checks, err := validateStabilityOptions(apiClient, options)
+	if err != nil {
+		return nil, err
+	}
+
+	if options.PollInterval <= 0 {
+		options.PollInterval = DefaultStabilityPollInterval
+	}
+
+	if options.Timeout > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, options.Timeout)
+		defer cancel()
+	}
+
+	glog.V(100).Infof("Waiting up to %v for the cluster to be stable for %v", options.Timeout, options.StableFor)
+
+	report := &StabilityReport{Start: time.Now()}
+
+	ticker := time.NewTicker(options.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		now := time.Now()
+		blockers := evaluateStability(ctx, apiClient, checks, options)
+
+		// Checks interrupted by the end of the wait are not meaningful, the previous evaluation is reported instead.
+		if ctx.Err() == nil || report.Evaluations == 0 {
+			report.update(blockers, now)
+		}
+
+		report.Duration = time.Since(report.Start)
+
+		if !report.StableSince.IsZero() && now.Sub(report.StableSince) >= options.StableFor {
+			report.Stable = true
+
+			return report, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			report.Stable = false
+
+			return report, fmt.Errorf("timed out waiting for the cluster to be stable for %v: %w%s",
+				options.StableFor, ctx.Err(), describeBlockers(report.Blockers))
+		case <-ticker.C:
+		}
+	}
+}
+
+// update records the blockers of an evaluation made at now.
+func (report *StabilityReport) update(blockers []Blocker, now time.Time) {
+	report.Evaluations++
+	report.Blockers = blockers
+	report.Stable = len(blockers) == 0
+
+	switch {
+	case !report.Stable:
+		glog.V(100).Infof("Cluster is not stable, %d blockers found", len(blockers))
+
+		report.StableSince = time.Time{}
+	case report.StableSince.IsZero():
+		report.StableSince = now
+	}
+}
+
+// validateStabilityOptions checks apiClient and options and returns the checks to evaluate.
+func validateStabilityOptions(apiClient *clients.Settings, options StabilityOptions) ([]StabilityCheck, error) {
+	if apiClient == nil {
+		glog.V(100).Info("The apiClient of the cluster stability check is nil")
+
+		return nil, fmt.Errorf("cluster stability 'apiClient' cannot be nil")
+	}
+
+	for _, check := range options.Checks {
+		if !slices.Contains(AllStabilityChecks, check) {
+			return nil, fmt.Errorf("unknown cluster stability check %s, supported checks are %v",
+				check, AllStabilityChecks)
+		}
+	}
+
+	for _, attacher := range []clients.SchemeAttacher{configv1.Install, mcv1.Install} {
+		if err := apiClient.AttachScheme(attacher); err != nil {
+			glog.V(100).Info("Failed to add config and machineconfiguration v1 schemes to client schemes")
+
+			return nil, err
+		}
+	}
+
+	if len(options.Checks) == 0 {
+		return AllStabilityChecks, nil
+	}
+
+	return options.Checks, nil
+}
+
+// evaluateStability runs the checks concurrently and returns the blockers they found sorted by check.
+func evaluateStability(
+	ctx context.Context, apiClient *clients.Settings, checks []StabilityCheck, options StabilityOptions) []Blocker {
+	var (
+		waitGroup sync.WaitGroup
+		results   = make([][]Blocker, len(checks))
+	)
+
+	for index, check := range checks {
+		waitGroup.Add(1)
+
+		go func() {
+			defer waitGroup.Done()
+
+			blockers, err := runStabilityCheck(ctx, apiClient, check, options)
+			if err != nil {
+				glog.V(100).Infof("Failed to evaluate cluster stability check %s: %v", check, err)
+
+				blockers = []Blocker{{Check: check, Kind: string(check), Reason: fmt.Sprintf("check failed: %v", err)}}
+			}
+
+			results[index] = blockers
+		}()
+	}
+
+	waitGroup.Wait()
+
+	var blockers []Blocker
+
+	for _, result := range results {
+		blockers = append(blockers, result...)
+	}
+
+	return blockers
+}
+
+// runStabilityCheck returns the blockers found by check.
+func runStabilityCheck(ctx context.Context,
+	apiClient *clients.Settings, check StabilityCheck, options StabilityOptions) ([]Blocker, error) {
+	switch check {
+	case CheckClusterOperators:
+		return checkClusterOperators(ctx, apiClient)
+	case CheckMachineConfigPools:
+		return checkMachineConfigPools(ctx, apiClient)
+	case CheckNodes:
+		return checkNodes(ctx, apiClient)
+	case CheckPods:
+		return checkPods(ctx, apiClient, options.PodNamespaces)
+	case CheckClusterVersion:
+		return checkClusterVersion(ctx, apiClient)
+	default:
+		return nil, fmt.Errorf("unknown cluster stability check %s", check)
+	}
+}
+
+// checkClusterOperators returns the ClusterOperators that are not Available or are Progressing or Degraded.
+func checkClusterOperators(ctx context.Context, apiClient *clients.Settings) ([]Blocker, error) {
+	operatorList := &configv1.ClusterOperatorList{}
+	if err := apiClient.Client.List(ctx, operatorList); err != nil {
+		return nil, err
+	}
+
+	var blockers []Blocker
+
+	for _, operator := range operatorList.Items {
+		reasons := getUnstableConditions(operator.Status.Conditions, map[configv1.ClusterStatusConditionType]bool{
+			configv1.OperatorAvailable: true, configv1.OperatorProgressing: false, configv1.OperatorDegraded: false})
+
+		if len(reasons) > 0 {
+			blockers = append(blockers, Blocker{Check: CheckClusterOperators, Kind: "ClusterOperator",
+				Name: operator.Name, Reason: strings.Join(reasons, ", ")})
+		}
+	}
+
+	return sortBlockers(blockers), nil
+}
+
+// checkClusterVersion returns the ClusterVersion if it is not Available or is Progressing or Failing.
+func checkClusterVersion(ctx context.Context, apiClient *clients.Settings) ([]Blocker, error) {
+	clusterVersion := &configv1.ClusterVersion{}
+
+	err := apiClient.Client.Get(ctx, runtimeclient.ObjectKey{Name: clusterVersionName}, clusterVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	reasons := getUnstableConditions(clusterVersion.Status.Conditions, map[configv1.ClusterStatusConditionType]bool{
+		configv1.OperatorAvailable: true, configv1.OperatorProgressing: false, clusterVersionFailing: false})
+
+	if len(reasons) == 0 {
+		return nil, nil
+	}
+
+	return []Blocker{{Check: CheckClusterVersion, Kind: "ClusterVersion", Name: clusterVersion.Name,
+		Reason: strings.Join(reasons, ", ")}}, nil
+}
+
+// getUnstableConditions returns a description of the conditions whose status differs from the expected one. A missing
+// condition only matters if it is expected to be true.
+func getUnstableConditions(conditions []configv1.ClusterOperatorStatusCondition,
+	expected map[configv1.ClusterStatusConditionType]bool) []string {
+	var reasons []string
+
+	for _, conditionType := range []configv1.ClusterStatusConditionType{configv1.OperatorAvailable,
+		configv1.OperatorProgressing, configv1.OperatorDegraded, clusterVersionFailing} {
+		expectedTrue, checked := expected[conditionType]
+		if !checked {
+			continue
+		}
+
+		index := slices.IndexFunc(conditions, func(condition configv1.ClusterOperatorStatusCondition) bool {
+			return condition.Type == conditionType
+		})
+
+		if index < 0 {
+			if expectedTrue {
+				reasons = append(reasons, fmt.Sprintf("%s condition missing", conditionType))
+			}
+
+			continue
+		}
+
+		condition := conditions[index]
+		if (condition.Status == configv1.ConditionTrue) != expectedTrue {
+			reasons = append(reasons, fmt.Sprintf("%s=%s (%s)", condition.Type, condition.Status, condition.Reason))
+		}
+	}
+
+	return reasons
+}
+
+// checkMachineConfigPools returns the MachineConfigPools that are not updated or have machines not ready or degraded.
+func checkMachineConfigPools(ctx context.Context, apiClient *clients.Settings) ([]Blocker, error) {
+	poolList := &mcv1.MachineConfigPoolList{}
+	if err := apiClient.Client.List(ctx, poolList); err != nil {
+		return nil, err
+	}
+
+	var blockers []Blocker
+
+	for _, pool := range poolList.Items {
+		status := pool.Status
+		reason := ""
+
+		switch {
+		case status.DegradedMachineCount != 0:
+			reason = fmt.Sprintf("%d/%d machines degraded", status.DegradedMachineCount, status.MachineCount)
+		case status.UpdatedMachineCount != status.MachineCount:
+			reason = fmt.Sprintf("%d/%d machines updated", status.UpdatedMachineCount, status.MachineCount)
+		case status.ReadyMachineCount != status.MachineCount:
+			reason = fmt.Sprintf("%d/%d machines ready", status.ReadyMachineCount, status.MachineCount)
+		}
+
+		if reason != "" {
+			blockers = append(blockers, Blocker{Check: CheckMachineConfigPools, Kind: "MachineConfigPool",
+				Name: pool.Name, Reason: reason})
+		}
+	}
+
+	return sortBlockers(blockers), nil
+}
+
+// checkNodes returns the nodes that are not Ready.
+func checkNodes(ctx context.Context, apiClient *clients.Settings) ([]Blocker, error) {
+	nodeList := &corev1.NodeList{}
+	if err := apiClient.Client.List(ctx, nodeList); err != nil {
+		return nil, err
+	}
+
+	var blockers []Blocker
+
+	for _, node := range nodeList.Items {
+		reason := "Ready condition missing"
+
+		for _, condition := range node.Status.Conditions {
+			if condition.Type != corev1.NodeReady {
+				continue
+			}
+
+			reason = ""
+
+			if condition.Status != corev1.ConditionTrue {
+				reason = fmt.Sprintf("Ready=%s (%s)", condition.Status, condition.Reason)
+			}
+		}
+
+		if reason != "" {
+			blockers = append(blockers, Blocker{Check: CheckNodes, Kind: "Node", Name: node.Name, Reason: reason})
+		}
+	}
+
+	return sortBlockers(blockers), nil
+}
+
+// checkPods returns the pods of namespaces, or of all namespaces if empty, that are neither running and ready nor
+// succeeded. Failed pods that are never restarted are ignored.
+func checkPods(ctx context.Context, apiClient *clients.Settings, namespaces []string) ([]Blocker, error) {
+	var pods []corev1.Pod
+
+	if len(namespaces) == 0 {
+		namespaces = []string{""}
+	}
+
+	for _, namespace := range namespaces {
+		podList := &corev1.PodList{}
+		if err := apiClient.Client.List(ctx, podList, runtimeclient.InNamespace(namespace)); err != nil {
+			return nil, err
+		}
+
+		pods = append(pods, podList.Items...)
+	}
+
+	var blockers []Blocker
+
+	for _, pod := range pods {
+		if reason := getPodUnstableReason(&pod); reason != "" {
+			blockers = append(blockers, Blocker{Check: CheckPods, Kind: "Pod",
+				Namespace: pod.Namespace, Name: pod.Name, Reason: reason})
+		}
+	}
+
+	return sortBlockers(blockers), nil
+}
+
+// getPodUnstableReason returns why pod is not stable or an empty string if it is.
+func getPodUnstableReason(pod *corev1.Pod) string {
+	switch pod.Status.Phase {
+	case corev1.PodSucceeded:
+		return ""
+	case corev1.PodFailed:
+		if pod.Spec.RestartPolicy == corev1.RestartPolicyNever {
+			return ""
+		}
+
+		return fmt.Sprintf("phase %s (%s)", pod.Status.Phase, pod.Status.Reason)
+	case corev1.PodRunning:
+	default:
+		return fmt.Sprintf("phase %s", pod.Status.Phase)
+	}
+
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodReady && condition.Status == corev1.ConditionTrue {
+			return ""
+		}
+	}
+
+	for _, containerStatus := range pod.Status.ContainerStatuses {
+		if containerStatus.State.Waiting != nil {
+			return fmt.Sprintf("not ready, container %s waiting (%s)",
+				containerStatus.Name, containerStatus.State.Waiting.Reason)
+		}
+	}
+
+	return "not ready"
+}
+
+// sortBlockers sorts blockers by namespace and name so that reports are stable across evaluations.
+func sortBlockers(blockers []Blocker) []Blocker {
+	sort.Slice(blockers, func(i, j int) bool {
+		if blockers[i].Namespace != blockers[j].Namespace {
+			return blockers[i].Namespace < blockers[j].Namespace
+		}
+
+		return blockers[i].Name < blockers[j].Name
+	})
+
+	return blockers
+}
+
+// describeBlockers returns the first blockers formatted for an error message.
+func describeBlockers(blockers []Blocker) string {
+	if len(blockers) == 0 {
+		return ""
+	}
+
+	var descriptions []string
+
+	for index, blocker := range blockers {
+		if index == maxErrorBlockers {
+			descriptions = append(descriptions, fmt.Sprintf("and %d more", len(blockers)-maxErrorBlockers))
+
+			break
+		}
+
+		descriptions = append(descriptions, blocker.String())
+	}
+
+	return fmt.Sprintf(" (%d blockers: %s)", len(blockers), strings.Join(descriptions, "; "))
+}
@@ -0,0 +1,225 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	configv1 "github.com/openshift/api/config/v1"
+	mcv1 "github.com/openshift/api/machineconfiguration/v1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var testSchemes = []clients.SchemeAttacher{
+	configv1.Install,
+	mcv1.Install,
+}
+
+//nolint:funlen
+func TestCheckStability(t *testing.T) {
+	testCases := []struct {
+		mutate           func(objects []runtime.Object)
+		options          StabilityOptions
+		client           bool
+		expectedBlockers []string
+		expectedError    error
+	}{
+		{
+			client: true,
+		},
+		{
+			mutate: func(objects []runtime.Object) {
+				operator := objects[0].(*configv1.ClusterOperator)
+				operator.Status.Conditions[2].Status = configv1.ConditionTrue
+				operator.Status.Conditions[2].Reason = "OAuthServerDown"
+			},
+			client:           true,
+			expectedBlockers: []string{"ClusterOperator authentication: Degraded=True (OAuthServerDown)"},
+		},
+		{
+			mutate: func(objects []runtime.Object) {
+				objects[1].(*mcv1.MachineConfigPool).Status.UpdatedMachineCount = 1
+				objects[2].(*corev1.Node).Status.Conditions[0].Status = corev1.ConditionFalse
+				objects[2].(*corev1.Node).Status.Conditions[0].Reason = "KubeletNotReady"
+			},
+			client: true,
+			expectedBlockers: []string{
+				"MachineConfigPool worker: 1/2 machines updated", "Node worker-0: Ready=False (KubeletNotReady)"},
+		},
+		{
+			mutate: func(objects []runtime.Object) {
+				objects[3].(*corev1.Pod).Status.Conditions = nil
+				objects[3].(*corev1.Pod).Status.ContainerStatuses = []corev1.ContainerStatus{{
+					Name: "test", State: corev1.ContainerState{
+						Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}}}}
+				objects[4].(*configv1.ClusterVersion).Status.Conditions[1].Status = configv1.ConditionTrue
+				objects[4].(*configv1.ClusterVersion).Status.Conditions[1].Reason = "ClusterOperatorsUpdating"
+			},
+			client: true,
+			expectedBlockers: []string{
+				"Pod test-ns/test-pod: not ready, container test waiting (CrashLoopBackOff)",
+				"ClusterVersion version: Progressing=True (ClusterOperatorsUpdating)"},
+		},
+		{
+			mutate: func(objects []runtime.Object) {
+				objects[3].(*corev1.Pod).Status.Phase = corev1.PodPending
+			},
+			options: StabilityOptions{Checks: []StabilityCheck{CheckPods}, PodNamespaces: []string{"other-ns"}},
+			client:  true,
+		},
+		{
+			mutate: func(objects []runtime.Object) {
+				objects[3].(*corev1.Pod).Status.Phase = corev1.PodFailed
+				objects[3].(*corev1.Pod).Spec.RestartPolicy = corev1.RestartPolicyNever
+			},
+			client: true,
+		},
+		{
+			options: StabilityOptions{Checks: []StabilityCheck{"Storage"}},
+			client:  true,
+			expectedError: fmt.Errorf("unknown cluster stability check Storage, supported checks are %v",
+				AllStabilityChecks),
+		},
+		{
+			client:        false,
+			expectedError: fmt.Errorf("cluster stability 'apiClient' cannot be nil"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var testSettings *clients.Settings
+
+		if testCase.client {
+			objects := buildStableClusterObjects()
+			if testCase.mutate != nil {
+				testCase.mutate(objects)
+			}
+
+			testSettings = clients.GetTestClients(clients.TestClientParams{
+				K8sMockObjects:  objects,
+				SchemeAttachers: testSchemes,
+			})
+		}
+
+		report, err := CheckStability(testSettings, testCase.options)
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError != nil {
+			assert.Nil(t, report)
+
+			continue
+		}
+
+		var blockers []string
+		for _, blocker := range report.Blockers {
+			blockers = append(blockers, blocker.String())
+		}
+
+		assert.Equal(t, testCase.expectedBlockers, blockers)
+		assert.Equal(t, len(testCase.expectedBlockers) == 0, report.Stable)
+		assert.Equal(t, 1, report.Evaluations)
+	}
+}
+
+func TestWaitForStable(t *testing.T) {
+	objects := buildStableClusterObjects()
+	objects[0].(*configv1.ClusterOperator).Status.Conditions[0].Status = configv1.ConditionFalse
+
+	testSettings := clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects:  objects,
+		SchemeAttachers: testSchemes,
+		Simulators: []clients.ControllerSimulator{{
+			Object:   &configv1.ClusterOperator{},
+			Triggers: []clients.SimulatorTrigger{clients.SimulatorTriggerRead},
+			Reconcile: clients.SimulateAfter(3, func(object runtime.Object) {
+				object.(*configv1.ClusterOperator).Status.Conditions[0].Status = configv1.ConditionTrue
+			}),
+		}},
+	})
+
+	report, err := WaitForStable(testSettings, StabilityOptions{
+		StableFor: 30 * time.Millisecond, Timeout: 5 * time.Second, PollInterval: 10 * time.Millisecond})
+	assert.Nil(t, err)
+	assert.True(t, report.Stable)
+	assert.Empty(t, report.Blockers)
+	assert.GreaterOrEqual(t, report.Evaluations, 5)
+	assert.False(t, report.StableSince.IsZero())
+}
+
+func TestWaitForStableTimeout(t *testing.T) {
+	objects := buildStableClusterObjects()
+	objects[1].(*mcv1.MachineConfigPool).Status.DegradedMachineCount = 1
+
+	testSettings := clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects:  objects,
+		SchemeAttachers: testSchemes,
+	})
+
+	ctx, cancel := context.WithTimeout(t.Context(), 50*time.Millisecond)
+	defer cancel()
+
+	report, err := WaitForStableWithContext(ctx, testSettings, StabilityOptions{PollInterval: 10 * time.Millisecond})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.EqualError(t, err, "timed out waiting for the cluster to be stable for 0s: context deadline exceeded "+
+		"(1 blockers: MachineConfigPool worker: 1/2 machines degraded)")
+	assert.False(t, report.Stable)
+	assert.Len(t, report.BlockersFor(CheckMachineConfigPools), 1)
+	assert.Empty(t, report.BlockersFor(CheckNodes))
+	assert.Contains(t, report.String(), "[MachineConfigPools] MachineConfigPool worker: 1/2 machines degraded")
+}
+
+func TestDescribeBlockers(t *testing.T) {
+	var blockers []Blocker
+
+	assert.Equal(t, "", describeBlockers(blockers))
+
+	for index := 0; index < maxErrorBlockers+2; index++ {
+		blockers = append(blockers, Blocker{Kind: "Node", Name: fmt.Sprintf("node-%d", index), Reason: "Ready=False ()"})
+	}
+
+	assert.Equal(t, "(7 blockers: Node node-0: Ready=False (); Node node-1: Ready=False (); Node node-2: Ready=False (); "+
+		"Node node-3: Ready=False (); Node node-4: Ready=False (); and 2 more)", describeBlockers(blockers)[1:])
+}
+
+// buildStableClusterObjects returns the objects of a stable cluster: a ClusterOperator, a MachineConfigPool, a node,
+// a pod and the ClusterVersion, in this order.
+func buildStableClusterObjects() []runtime.Object {
+	availableConditions := func() []configv1.ClusterOperatorStatusCondition {
+		return []configv1.ClusterOperatorStatusCondition{
+			{Type: configv1.OperatorAvailable, Status: configv1.ConditionTrue},
+			{Type: configv1.OperatorProgressing, Status: configv1.ConditionFalse},
+			{Type: configv1.OperatorDegraded, Status: configv1.ConditionFalse},
+		}
+	}
+
+	return []runtime.Object{
+		&configv1.ClusterOperator{
+			ObjectMeta: metav1.ObjectMeta{Name: "authentication"},
+			Status:     configv1.ClusterOperatorStatus{Conditions: availableConditions()},
+		},
+		&mcv1.MachineConfigPool{
+			ObjectMeta: metav1.ObjectMeta{Name: "worker"},
+			Status: mcv1.MachineConfigPoolStatus{
+				MachineCount: 2, UpdatedMachineCount: 2, ReadyMachineCount: 2},
+		},
+		&corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: "worker-0"},
+			Status: corev1.NodeStatus{Conditions: []corev1.NodeCondition{
+				{Type: corev1.NodeReady, Status: corev1.ConditionTrue}}},
+		},
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-pod", Namespace: "test-ns"},
+			Status: corev1.PodStatus{Phase: corev1.PodRunning, Conditions: []corev1.PodCondition{
+				{Type: corev1.PodReady, Status: corev1.ConditionTrue}}},
+		},
+		&configv1.ClusterVersion{
+			ObjectMeta: metav1.ObjectMeta{Name: clusterVersionName},
+			Status:     configv1.ClusterVersionStatus{Conditions: availableConditions()},
+		},
+	}
+}
@@ -10,11 +10,10 @@ import (
 
 	"fmt"
 
-	"k8s.io/apimachinery/pkg/util/wait"
-
 	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/waiter"
 	configv1 "github.com/openshift/api/config/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 )
@@ -32,6 +31,8 @@ type Builder struct {
 	Object *configv1.ClusterOperator
 	// apiClient opens api connection to the cluster.
 	apiClient goclient.Client
+	// eventsClient lists the events about the clusterOperator while waiting for it.
+	eventsClient *clients.Settings
 	// Used in functions that define or mutate clusterOperator definition. errorMsg is processed before the
 	// ClusterOperator object is created.
 	errorMsg string
@@ -39,6 +40,11 @@ type Builder struct {
 
 // Pull loads an existing clusterOperator into Builder struct.
 func Pull(apiClient *clients.Settings, clusterOperatorName string) (*Builder, error) {
+	return PullWithContext(context.Background(), apiClient, clusterOperatorName)
+}
+
+// PullWithContext loads an existing clusterOperator like Pull using the provided context.
+func PullWithContext(ctx context.Context, apiClient *clients.Settings, clusterOperatorName string) (*Builder, error) {
 	glog.V(100).Infof("Pulling existing clusterOperator: %s", clusterOperatorName)
 
 	if apiClient == nil {
@@ -48,7 +54,8 @@ func Pull(apiClient *clients.Settings, clusterOperatorName string) (*Builder, er
 	}
 
 	builder := &Builder{
-		apiClient: apiClient.Client,
+		apiClient:    apiClient.Client,
+		eventsClient: apiClient,
 		Definition: &configv1.ClusterOperator{
 			ObjectMeta: metav1.ObjectMeta{
 				Name: clusterOperatorName,
@@ -62,7 +69,7 @@ func Pull(apiClient *clients.Settings, clusterOperatorName string) (*Builder, er
 		return nil, fmt.Errorf("clusterOperator 'clusterOperatorName' cannot be empty")
 	}
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		return nil, fmt.Errorf("clusterOperator object %s does not exist", clusterOperatorName)
 	}
 
@@ -73,6 +80,11 @@ func Pull(apiClient *clients.Settings, clusterOperatorName string) (*Builder, er
 
 // Get fetches existing clusterOperator from cluster.
 func (builder *Builder) Get() (*configv1.ClusterOperator, error) {
+	return builder.GetWithContext(context.Background())
+}
+
+// GetWithContext fetches existing clusterOperator from cluster using the provided context.
+func (builder *Builder) GetWithContext(ctx context.Context) (*configv1.ClusterOperator, error) {
 	if valid, err := builder.validate(); !valid {
 		return nil, err
 	}
@@ -80,7 +92,7 @@ func (builder *Builder) Get() (*configv1.ClusterOperator, error) {
 	glog.V(100).Infof("Getting existing clusterOperator with name %s from cluster", builder.Definition.Name)
 
 	clusterOperatorObj := &configv1.ClusterOperator{}
-	err := builder.apiClient.Get(context.TODO(), goclient.ObjectKey{
+	err := builder.apiClient.Get(ctx, goclient.ObjectKey{
 		Name: builder.Definition.Name,
 	}, clusterOperatorObj)
 
@@ -96,6 +108,11 @@ func (builder *Builder) Get() (*configv1.ClusterOperator, error) {
 
 // Exists checks whether the given clusterOperator exists.
 func (builder *Builder) Exists() bool {
+	return builder.ExistsWithContext(context.Background())
+}
+
+// ExistsWithContext checks whether the given clusterOperator exists using the provided context.
+func (builder *Builder) ExistsWithContext(ctx context.Context) bool {
 	if valid, _ := builder.validate(); !valid {
 		return false
 	}
@@ -103,7 +120,7 @@ func (builder *Builder) Exists() bool {
 	glog.V(100).Infof("Checking if clusterOperator %s exists", builder.Definition.Name)
 
 	var err error
-	builder.Object, err = builder.Get()
+	builder.Object, err = builder.GetWithContext(ctx)
 
 	return err == nil || !k8serrors.IsNotFound(err)
 }
@@ -185,42 +202,67 @@ func (builder *Builder) GetConditionReason(conditionType configv1.ClusterStatusC
 
 // WaitUntilAvailable waits for timeout duration or until clusterOperator is Available.
 func (builder *Builder) WaitUntilAvailable(timeout time.Duration) error {
-	return builder.WaitUntilConditionTrue("Available", timeout)
+	return builder.WaitUntilAvailableWithContext(context.Background(), timeout)
+}
+
+// WaitUntilAvailableWithContext waits for timeout duration, until ctx is done or until clusterOperator is Available.
+func (builder *Builder) WaitUntilAvailableWithContext(ctx context.Context, timeout time.Duration) error {
+	return builder.WaitUntilConditionTrueWithContext(ctx, "Available", timeout)
 }
 
 // WaitUntilProgressing waits for timeout duration or until clusterOperator is Progressing.
 func (builder *Builder) WaitUntilProgressing(timeout time.Duration) error {
-	return builder.WaitUntilConditionTrue("Progressing", timeout)
+	return builder.WaitUntilProgressingWithContext(context.Background(), timeout)
+}
+
+// WaitUntilProgressingWithContext waits for timeout duration, until ctx is done or until clusterOperator is
+// Progressing.
+func (builder *Builder) WaitUntilProgressingWithContext(ctx context.Context, timeout time.Duration) error {
+	return builder.WaitUntilConditionTrueWithContext(ctx, "Progressing", timeout)
 }
 
 // WaitUntilConditionTrue waits for timeout duration or until clusterOperator gets to a specific status.
 func (builder *Builder) WaitUntilConditionTrue(
 	conditionType configv1.ClusterStatusConditionType, timeout time.Duration) error {
+	return builder.WaitUntilConditionTrueWithContext(context.Background(), conditionType, timeout)
+}
+
+// WaitUntilConditionTrueWithContext waits until the clusterOperator has the condition conditionType with status true,
+// the timeout expires or ctx is done.
+func (builder *Builder) WaitUntilConditionTrueWithContext(
+	ctx context.Context, conditionType configv1.ClusterStatusConditionType, timeout time.Duration) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		return fmt.Errorf("%s clusterOperator not found", builder.Definition.Name)
 	}
 
-	return wait.PollUntilContextTimeout(
-		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
-			var err error
-			builder.Object, err = builder.Get()
-
-			if err != nil {
-				return false, nil
-			}
+	source := waiter.WithEvents(
+		waiter.NewRuntimeSource[configv1.ClusterOperator](builder.apiClient, "", builder.Definition.Name),
+		builder.eventsClient, "ClusterOperator", "")
 
-			for _, condition := range builder.Object.Status.Conditions {
+	clusterOperator, err := waiter.For(ctx, source,
+		waiter.Options{
+			Description: fmt.Sprintf("clusterOperator %s to have condition %s", builder.Definition.Name, conditionType),
+			Timeout:     timeout,
+		}, func(clusterOperator *configv1.ClusterOperator) (bool, error) {
+			for _, condition := range clusterOperator.Status.Conditions {
 				if condition.Type == conditionType {
 					return condition.Status == isTrue, nil
 				}
 			}
 
-			return false, err
+			return false, nil
 		})
+	if err != nil {
+		return err
+	}
+
+	builder.Object = clusterOperator
+
+	return nil
 }
 
 // HasDesiredVersion checks if an operator has a desiredVersion.
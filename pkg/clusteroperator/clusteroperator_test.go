@@ -1,8 +1,11 @@
 package clusteroperator
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
@@ -90,6 +93,7 @@ func TestClusterOperatorPull(t *testing.T) {
 			assert.Equal(t, testCase.expectedError, err)
 		} else {
 			assert.Equal(t, testClusterOperator.Name, builderResult.Object.Name)
+			assert.Equal(t, testSettings, builderResult.eventsClient)
 		}
 	}
 }
@@ -209,6 +213,59 @@ func TestClusterOperatorHasDesiredVersion(t *testing.T) {
 	}
 }
 
+func TestClusterOperatorWaitUntilConditionTrue(t *testing.T) {
+	testCases := []struct {
+		availableAfter int
+		exists         bool
+		expectedError  error
+	}{
+		{
+			availableAfter: 2,
+			exists:         true,
+			expectedError:  nil,
+		},
+		{
+			availableAfter: 10,
+			exists:         true,
+			expectedError:  context.DeadlineExceeded,
+		},
+		{
+			exists:        false,
+			expectedError: fmt.Errorf("%s clusterOperator not found", defaultClusterOperatorName),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.exists {
+			runtimeObjects = buildDummyClusterOperatorConfig()
+		}
+
+		testSettings := clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects: runtimeObjects,
+			Simulators: []clients.ControllerSimulator{{
+				Object:   &configV1.ClusterOperator{},
+				Triggers: []clients.SimulatorTrigger{clients.SimulatorTriggerRead},
+				Reconcile: clients.SimulateAfter(testCase.availableAfter, func(object runtime.Object) {
+					object.(*configV1.ClusterOperator).Status.Conditions = []configV1.ClusterOperatorStatusCondition{{
+						Type:   configV1.OperatorAvailable,
+						Status: configV1.ConditionTrue,
+					}}
+				}),
+			}},
+		})
+
+		err := buildValidClusterOperatorBuilder(testSettings).WaitUntilAvailable(2 * time.Second)
+
+		if errors.Is(testCase.expectedError, context.DeadlineExceeded) {
+			assert.ErrorIs(t, err, context.DeadlineExceeded)
+		} else {
+			assert.Equal(t, testCase.expectedError, err)
+		}
+	}
+}
+
 func buildValidClusterOperatorBuilder(apiClient *clients.Settings) *Builder {
 	return newBuilder(apiClient, defaultClusterOperatorName, configV1.ClusterOperatorStatus{})
 }
@@ -13,6 +13,12 @@ import (
 
 // List returns clusterOperators inventory.
 func List(apiClient *clients.Settings, options ...metav1.ListOptions) ([]*Builder, error) {
+	return ListWithContext(context.Background(), apiClient, options...)
+}
+
+// ListWithContext returns clusterOperators inventory using the provided context.
+func ListWithContext(
+	ctx context.Context, apiClient *clients.Settings, options ...metav1.ListOptions) ([]*Builder, error) {
 	logMessage := "Listing all clusterOperators"
 	passedOptions := metav1.ListOptions{}
 
@@ -29,7 +35,7 @@ func List(apiClient *clients.Settings, options ...metav1.ListOptions) ([]*Builde
 
 	glog.V(100).Infof(logMessage)
 
-	coList, err := apiClient.ClusterOperators().List(context.TODO(), passedOptions)
+	coList, err := apiClient.ClusterOperators().List(ctx, passedOptions)
 
 	if err != nil {
 		glog.V(100).Infof("Failed to list clusterOperators due to %s", err.Error())
@@ -42,9 +48,10 @@ func List(apiClient *clients.Settings, options ...metav1.ListOptions) ([]*Builde
 	for _, clusterOperator := range coList.Items {
 		copiedCo := clusterOperator
 		coBuilder := &Builder{
-			apiClient:  apiClient.Client,
-			Object:     &copiedCo,
-			Definition: &copiedCo,
+			apiClient:    apiClient.Client,
+			eventsClient: apiClient,
+			Object:       &copiedCo,
+			Definition:   &copiedCo,
 		}
 
 		coObjects = append(coObjects, coBuilder)
@@ -56,10 +63,17 @@ func List(apiClient *clients.Settings, options ...metav1.ListOptions) ([]*Builde
 // WaitForAllClusteroperatorsAvailable waits until all clusterOperators are in available state.
 func WaitForAllClusteroperatorsAvailable(
 	apiClient *clients.Settings, timeout time.Duration, options ...metav1.ListOptions) (bool, error) {
+	return WaitForAllClusteroperatorsAvailableWithContext(context.Background(), apiClient, timeout, options...)
+}
+
+// WaitForAllClusteroperatorsAvailableWithContext waits until all clusterOperators are in available state or ctx is
+// done.
+func WaitForAllClusteroperatorsAvailableWithContext(
+	ctx context.Context, apiClient *clients.Settings, timeout time.Duration, options ...metav1.ListOptions) (bool, error) {
 	glog.V(100).Info("Waiting for all clusterOperators to be in available state")
 
-	err := wait.PollUntilContextTimeout(context.TODO(), fiveScds, timeout, true, func(ctx context.Context) (bool, error) {
-		coList, err := List(apiClient, options...)
+	err := wait.PollUntilContextTimeout(ctx, fiveScds, timeout, true, func(ctx context.Context) (bool, error) {
+		coList, err := ListWithContext(ctx, apiClient, options...)
 
 		if err != nil {
 			glog.V(100).Infof("Failed to list all clusterOperators due to %s", err.Error())
@@ -96,16 +110,23 @@ func WaitForAllClusteroperatorsAvailable(
 // WaitForAllClusteroperatorsStopProgressing waits until all clusterOperators stopped progressing.
 func WaitForAllClusteroperatorsStopProgressing(
 	apiClient *clients.Settings, timeout time.Duration, options ...metav1.ListOptions) (bool, error) {
+	return WaitForAllClusteroperatorsStopProgressingWithContext(context.Background(), apiClient, timeout, options...)
+}
+
+// WaitForAllClusteroperatorsStopProgressingWithContext waits until all clusterOperators stopped progressing or ctx is
+// done.
+func WaitForAllClusteroperatorsStopProgressingWithContext(
+	ctx context.Context, apiClient *clients.Settings, timeout time.Duration, options ...metav1.ListOptions) (bool, error) {
 	glog.V(100).Infof("Waiting for all clusteroperators to stop progressing")
 
-	coList, err := List(apiClient, options...)
+	coList, err := ListWithContext(ctx, apiClient, options...)
 	if err != nil {
 		glog.V(100).Infof("Failed to list all clusterOperators due to %s", err.Error())
 
 		return false, err
 	}
 
-	err = wait.PollUntilContextTimeout(context.TODO(), fiveScds, timeout, true, func(ctx context.Context) (bool, error) {
+	err = wait.PollUntilContextTimeout(ctx, fiveScds, timeout, true, func(ctx context.Context) (bool, error) {
 		for _, clusteroperator := range coList {
 			if clusteroperator.IsProgressing() {
 				glog.V(100).Infof("The %s clusterOperator is still progressing",
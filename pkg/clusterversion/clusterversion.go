@@ -34,6 +34,11 @@ type Builder struct {
 
 // Pull loads an existing clusterversion into Builder struct.
 func Pull(apiClient *clients.Settings) (*Builder, error) {
+	return PullWithContext(context.Background(), apiClient)
+}
+
+// PullWithContext loads an existing clusterversion like Pull using the provided context.
+func PullWithContext(ctx context.Context, apiClient *clients.Settings) (*Builder, error) {
 	glog.V(100).Infof("Pulling existing clusterversion name: %s", clusterVersionName)
 
 	if apiClient == nil {
@@ -58,7 +63,7 @@ func Pull(apiClient *clients.Settings) (*Builder, error) {
 		},
 	}
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		return nil, fmt.Errorf("clusterversion object %s does not exist", clusterVersionName)
 	}
 
@@ -69,6 +74,11 @@ func Pull(apiClient *clients.Settings) (*Builder, error) {
 
 // Get returns the ClusterVersion object from the cluster if it exists.
 func (builder *Builder) Get() (*configv1.ClusterVersion, error) {
+	return builder.GetWithContext(context.Background())
+}
+
+// GetWithContext returns the ClusterVersion object from the cluster if it exists using the provided context.
+func (builder *Builder) GetWithContext(ctx context.Context) (*configv1.ClusterVersion, error) {
 	if valid, err := builder.validate(); !valid {
 		return nil, err
 	}
@@ -76,7 +86,7 @@ func (builder *Builder) Get() (*configv1.ClusterVersion, error) {
 	glog.V(100).Infof("Getting ClusterVersion object %s", builder.Definition.Name)
 
 	clusterVersion := &configv1.ClusterVersion{}
-	err := builder.apiClient.Get(context.TODO(), runtimeclient.ObjectKey{Name: builder.Definition.Name}, clusterVersion)
+	err := builder.apiClient.Get(ctx, runtimeclient.ObjectKey{Name: builder.Definition.Name}, clusterVersion)
 
 	if err != nil {
 		glog.V(100).Infof("Failed to get ClusterVersion %s: %s", builder.Definition.Name, err)
@@ -89,6 +99,11 @@ func (builder *Builder) Get() (*configv1.ClusterVersion, error) {
 
 // Exists checks whether the given clusterversion exists.
 func (builder *Builder) Exists() bool {
+	return builder.ExistsWithContext(context.Background())
+}
+
+// ExistsWithContext checks whether the given clusterversion exists using the provided context.
+func (builder *Builder) ExistsWithContext(ctx context.Context) bool {
 	if valid, _ := builder.validate(); !valid {
 		return false
 	}
@@ -96,7 +111,7 @@ func (builder *Builder) Exists() bool {
 	glog.V(100).Infof("Checking if ClusterVersion %s exists", builder.Definition.Name)
 
 	var err error
-	builder.Object, err = builder.Get()
+	builder.Object, err = builder.GetWithContext(ctx)
 
 	return err == nil || !k8serrors.IsNotFound(err)
 }
@@ -147,20 +162,26 @@ func (builder *Builder) WithDesiredUpdateChannel(updateChannel string) *Builder
 
 // Update renovates the existing clusterversion object with the clusterversion definition in builder.
 func (builder *Builder) Update() (*Builder, error) {
+	return builder.UpdateWithContext(context.Background())
+}
+
+// UpdateWithContext renovates the existing clusterversion object with the clusterversion definition in builder using
+// the provided context.
+func (builder *Builder) UpdateWithContext(ctx context.Context) (*Builder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
 
 	glog.V(100).Infof("Updating ClusterVersion %s", builder.Definition.Name)
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		return nil, fmt.Errorf("clusterversion object %s does not exist", builder.Definition.Name)
 	}
 
 	builder.Definition.ResourceVersion = builder.Object.ResourceVersion
 	builder.Definition.CreationTimestamp = metav1.Time{}
 
-	err := builder.apiClient.Update(context.TODO(), builder.Definition)
+	err := builder.apiClient.Update(ctx, builder.Definition)
 	if err != nil {
 		glog.V(100).Infof("Failed to update ClusterVersion %s: %s", builder.Definition.Name, err)
 
@@ -174,29 +195,48 @@ func (builder *Builder) Update() (*Builder, error) {
 
 // WaitUntilProgressing waits for timeout duration or until clusterversion is in Progressing state.
 func (builder *Builder) WaitUntilProgressing(timeout time.Duration) error {
-	return builder.WaitUntilConditionTrue(configv1.OperatorProgressing, timeout)
+	return builder.WaitUntilProgressingWithContext(context.Background(), timeout)
+}
+
+// WaitUntilProgressingWithContext waits for timeout duration, until ctx is done or until clusterversion is in
+// Progressing state.
+func (builder *Builder) WaitUntilProgressingWithContext(ctx context.Context, timeout time.Duration) error {
+	return builder.WaitUntilConditionTrueWithContext(ctx, configv1.OperatorProgressing, timeout)
 }
 
 // WaitUntilAvailable waits for timeout duration or until clusterversion is in Available state.
 func (builder *Builder) WaitUntilAvailable(timeout time.Duration) error {
-	return builder.WaitUntilConditionTrue(configv1.OperatorAvailable, timeout)
+	return builder.WaitUntilAvailableWithContext(context.Background(), timeout)
+}
+
+// WaitUntilAvailableWithContext waits for timeout duration, until ctx is done or until clusterversion is in Available
+// state.
+func (builder *Builder) WaitUntilAvailableWithContext(ctx context.Context, timeout time.Duration) error {
+	return builder.WaitUntilConditionTrueWithContext(ctx, configv1.OperatorAvailable, timeout)
 }
 
 // WaitUntilConditionTrue waits for timeout duration or until clusterversion gets to a specific status.
 func (builder *Builder) WaitUntilConditionTrue(
 	conditionType configv1.ClusterStatusConditionType, timeout time.Duration) error {
+	return builder.WaitUntilConditionTrueWithContext(context.Background(), conditionType, timeout)
+}
+
+// WaitUntilConditionTrueWithContext waits for timeout duration, until ctx is done or until clusterversion gets to a
+// specific status.
+func (builder *Builder) WaitUntilConditionTrueWithContext(
+	ctx context.Context, conditionType configv1.ClusterStatusConditionType, timeout time.Duration) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		return fmt.Errorf("clusterversion object %s does not exist", builder.Definition.Name)
 	}
 
 	return wait.PollUntilContextTimeout(
-		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+		ctx, time.Second, timeout, true, func(ctx context.Context) (bool, error) {
 			var err error
-			builder.Object, err = builder.Get()
+			builder.Object, err = builder.GetWithContext(ctx)
 
 			if err != nil {
 				glog.V(100).Infof("Failed to get the ClusterVersion with error %s", err)
@@ -216,29 +256,47 @@ func (builder *Builder) WaitUntilConditionTrue(
 
 // WaitUntilUpdateIsStarted waits until there is a history entry indicating the update start.
 func (builder *Builder) WaitUntilUpdateIsStarted(timeout time.Duration) error {
-	return builder.WaitUntilUpdateHistoryStateTrue(configv1.PartialUpdate, timeout)
+	return builder.WaitUntilUpdateIsStartedWithContext(context.Background(), timeout)
+}
+
+// WaitUntilUpdateIsStartedWithContext waits until there is a history entry indicating the update start or ctx is done.
+func (builder *Builder) WaitUntilUpdateIsStartedWithContext(ctx context.Context, timeout time.Duration) error {
+	return builder.WaitUntilUpdateHistoryStateTrueWithContext(ctx, configv1.PartialUpdate, timeout)
 }
 
 // WaitUntilUpdateIsCompleted waits until there is a history entry indicating the update completed.
 func (builder *Builder) WaitUntilUpdateIsCompleted(timeout time.Duration) error {
-	return builder.WaitUntilUpdateHistoryStateTrue(configv1.CompletedUpdate, timeout)
+	return builder.WaitUntilUpdateIsCompletedWithContext(context.Background(), timeout)
+}
+
+// WaitUntilUpdateIsCompletedWithContext waits until there is a history entry indicating the update completed or ctx is
+// done.
+func (builder *Builder) WaitUntilUpdateIsCompletedWithContext(ctx context.Context, timeout time.Duration) error {
+	return builder.WaitUntilUpdateHistoryStateTrueWithContext(ctx, configv1.CompletedUpdate, timeout)
 }
 
 // WaitUntilUpdateHistoryStateTrue waits until there is a history entry indicating an updateHistoryState.
 func (builder *Builder) WaitUntilUpdateHistoryStateTrue(
 	updateHistoryState configv1.UpdateState, timeout time.Duration) error {
+	return builder.WaitUntilUpdateHistoryStateTrueWithContext(context.Background(), updateHistoryState, timeout)
+}
+
+// WaitUntilUpdateHistoryStateTrueWithContext waits until there is a history entry indicating an updateHistoryState or
+// ctx is done.
+func (builder *Builder) WaitUntilUpdateHistoryStateTrueWithContext(
+	ctx context.Context, updateHistoryState configv1.UpdateState, timeout time.Duration) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		return fmt.Errorf("clusterversion object %s does not exist", builder.Definition.Name)
 	}
 
 	return wait.PollUntilContextTimeout(
-		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+		ctx, time.Second, timeout, true, func(ctx context.Context) (bool, error) {
 			var err error
-			builder.Object, err = builder.Get()
+			builder.Object, err = builder.GetWithContext(ctx)
 
 			if err != nil {
 				glog.V(100).Infof("Failed to get the ClusterVersion with error %s", err)
@@ -243,6 +243,15 @@ func TestClusterVersionWaitUntilUpdateHistoryStateTrue(t *testing.T) {
 	})
 }
 
+func TestClusterVersionWaitUntilConditionTrueWithContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	err := newClusterVersionBuilder(buildTestClientWithDummyClusterVersion()).
+		WaitUntilConditionTrueWithContext(ctx, configv1.OperatorAvailable, time.Minute)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
 func TestClusterVersionGetNextUpdateVersionImage(t *testing.T) {
 	testCases := []struct {
 		stream            string
@@ -0,0 +1,692 @@
+package clusterversion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/clusteroperator"
+	"github.com/openshift-kni/eco-goinfra/pkg/configmap"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/common"
+	configv1 "github.com/openshift/api/config/v1"
+	mcv1 "github.com/openshift/api/machineconfiguration/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/retry"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// DefaultUpgradeHopTimeout is the time allowed for the control plane to reach each target of an upgrade.
+	DefaultUpgradeHopTimeout = 3 * time.Hour
+	// DefaultUpgradePoolTimeout is the time allowed for the paused MachineConfigPools to update once unpaused.
+	DefaultUpgradePoolTimeout = 3 * time.Hour
+	// DefaultUpgradePollInterval is the interval between two observations of the cluster during an upgrade.
+	DefaultUpgradePollInterval = 30 * time.Second
+
+	adminGatesConfigMap = "admin-gates"
+	adminGatesNamespace = "openshift-config-managed"
+	adminAcksConfigMap  = "admin-acks"
+	adminAcksNamespace  = "openshift-config"
+	masterPoolName      = "master"
+	operatorVersionName = "operator"
+)
+
+// UpgradeEventType is the kind of progress reported by an UpgradeWorkflow.
+type UpgradeEventType string
+
+const (
+	// UpgradeEventChannelSet is emitted once the update channel of the cluster is set.
+	UpgradeEventChannelSet UpgradeEventType = "ChannelSet"
+	// UpgradeEventPoolPaused is emitted for each worker MachineConfigPool paused before the upgrade.
+	UpgradeEventPoolPaused UpgradeEventType = "PoolPaused"
+	// UpgradeEventAdminGateAcknowledged is emitted for each admin-gate acknowledged before a hop.
+	UpgradeEventAdminGateAcknowledged UpgradeEventType = "AdminGateAcknowledged"
+	// UpgradeEventStarted is emitted when the desired update of a hop is requested.
+	UpgradeEventStarted UpgradeEventType = "UpgradeStarted"
+	// UpgradeEventOperatorUpdated is emitted when a ClusterOperator reaches the version of the current hop.
+	UpgradeEventOperatorUpdated UpgradeEventType = "OperatorUpdated"
+	// UpgradeEventPoolProgress is emitted when the number of updated machines of a MachineConfigPool changes.
+	UpgradeEventPoolProgress UpgradeEventType = "PoolProgress"
+	// UpgradeEventConditionChanged is emitted when the Failing or Progressing condition of the ClusterVersion changes.
+	UpgradeEventConditionChanged UpgradeEventType = "ConditionChanged"
+	// UpgradeEventCompleted is emitted when the control plane completed the update of a hop.
+	UpgradeEventCompleted UpgradeEventType = "UpgradeCompleted"
+	// UpgradeEventPoolUnpaused is emitted for each MachineConfigPool unpaused after the last hop.
+	UpgradeEventPoolUnpaused UpgradeEventType = "PoolUnpaused"
+)
+
+// UpgradeEvent is a progress report of an UpgradeWorkflow.
+type UpgradeEvent struct {
+	Time time.Time
+	Type UpgradeEventType
+	// Version is the target version of the hop in progress.
+	Version string
+	// Object is the name of the ClusterOperator, MachineConfigPool or ConfigMap key the event is about, if any.
+	Object  string
+	Message string
+}
+
+// String returns a short description of the event.
+func (event UpgradeEvent) String() string {
+	description := string(event.Type)
+
+	if event.Object != "" {
+		description += " " + event.Object
+	}
+
+	if event.Message != "" {
+		description += ": " + event.Message
+	}
+
+	return description
+}
+
+// UpgradeTarget is a release the cluster is upgraded to. The release is defined by Image, by Version if it is an
+// available update of the cluster, or by Stream to pick the first available update in the X, Y or Z stream.
+type UpgradeTarget struct {
+	Version string
+	Image   string
+	Stream  string
+}
+
+// UpgradeOptions configures an UpgradeWorkflow.
+type UpgradeOptions struct {
+	// Targets are the releases the cluster is upgraded to in order: a single target for a z-stream or y-stream
+	// upgrade, the intermediate and final releases for an EUS-to-EUS upgrade.
+	Targets []UpgradeTarget
+	// Channel is set as the update channel of the cluster before the upgrade if not empty, for example eus-4.16.
+	Channel string
+	// Force allows release images whose signature cannot be verified.
+	Force bool
+	// AcceptConditionalUpdates allows targets only found in the conditional updates of the cluster.
+	AcceptConditionalUpdates bool
+	// AcknowledgeAdminGates acknowledges the admin-gates of the current version before each hop.
+	AcknowledgeAdminGates bool
+	// PauseWorkerPools pauses all the MachineConfigPools but master during the hops and unpauses them at the end,
+	// so that the workers are only rebooted once, as required for EUS-to-EUS upgrades.
+	PauseWorkerPools bool
+	// HopTimeout bounds each hop, DefaultUpgradeHopTimeout when zero.
+	HopTimeout time.Duration
+	// PoolTimeout bounds the update of the unpaused pools, DefaultUpgradePoolTimeout when zero.
+	PoolTimeout time.Duration
+	// PollInterval is the interval between two observations of the cluster, DefaultUpgradePollInterval when zero.
+	PollInterval time.Duration
+	// OnEvent is called synchronously with every progress event if not nil.
+	OnEvent func(event UpgradeEvent)
+}
+
+// UpgradeWorkflow upgrades an OpenShift cluster through one or more releases, reporting operator-by-operator and
+// pool-by-pool progress.
+type UpgradeWorkflow struct {
+	// Events are the progress events emitted so far.
+	Events []UpgradeEvent
+	// PausedPools are the MachineConfigPools paused by the workflow and not unpaused yet.
+	PausedPools []string
+
+	apiClient        *clients.Settings
+	options          UpgradeOptions
+	version          string
+	operatorVersions map[string]string
+	poolProgress     map[string]string
+	conditions       map[configv1.ClusterStatusConditionType]configv1.ConditionStatus
+}
+
+// NewUpgradeWorkflow returns an UpgradeWorkflow for the cluster of apiClient after checking options.
+func NewUpgradeWorkflow(apiClient *clients.Settings, options UpgradeOptions) (*UpgradeWorkflow, error) {
+	glog.V(100).Infof("Initializing new cluster upgrade workflow to %v", options.Targets)
+
+	if apiClient == nil {
+		glog.V(100).Info("The apiClient of the upgrade workflow is nil")
+
+		return nil, fmt.Errorf("clusterversion upgrade 'apiClient' cannot be nil")
+	}
+
+	if len(options.Targets) == 0 {
+		return nil, fmt.Errorf("clusterversion upgrade 'targets' cannot be empty")
+	}
+
+	for index, target := range options.Targets {
+		if target.Image == "" && target.Version == "" && target.Stream == "" {
+			return nil, fmt.Errorf("clusterversion upgrade target %d must have an image, a version or a stream", index)
+		}
+
+		if target.Stream != "" && !slices.Contains([]string{X, Y, Z}, target.Stream) {
+			return nil, fmt.Errorf("clusterversion upgrade target %d has invalid stream %s", index, target.Stream)
+		}
+	}
+
+	for _, attacher := range []clients.SchemeAttacher{configv1.Install, mcv1.Install} {
+		if err := apiClient.AttachScheme(attacher); err != nil {
+			glog.V(100).Info("Failed to add config and machineconfiguration v1 schemes to client schemes")
+
+			return nil, err
+		}
+	}
+
+	if options.HopTimeout <= 0 {
+		options.HopTimeout = DefaultUpgradeHopTimeout
+	}
+
+	if options.PoolTimeout <= 0 {
+		options.PoolTimeout = DefaultUpgradePoolTimeout
+	}
+
+	if options.PollInterval <= 0 {
+		options.PollInterval = DefaultUpgradePollInterval
+	}
+
+	return &UpgradeWorkflow{apiClient: apiClient, options: options}, nil
+}
+
+// Run upgrades the cluster through all the targets. See RunWithContext.
+func (workflow *UpgradeWorkflow) Run() error {
+	return workflow.RunWithContext(context.Background())
+}
+
+// RunWithContext upgrades the cluster through all the targets or until ctx is done. The channel is set first and the
+// worker pools are paused if requested. For each target, the admin-gates are acknowledged if requested, the desired
+// update is set and the workflow waits for the update to complete and for all the ClusterOperators to report the
+// target version. The paused pools are finally unpaused and the workflow waits for them to be updated. If the
+// upgrade fails, the pools paused by the workflow are left paused and listed in PausedPools.
+func (workflow *UpgradeWorkflow) RunWithContext(ctx context.Context) error {
+	if workflow == nil || workflow.apiClient == nil {
+		return fmt.Errorf("cannot run an uninitialized upgrade workflow")
+	}
+
+	clusterVersion, err := PullWithContext(ctx, workflow.apiClient)
+	if err != nil {
+		return err
+	}
+
+	if workflow.options.Channel != "" && clusterVersion.Object.Spec.Channel != workflow.options.Channel {
+		if _, err = clusterVersion.WithDesiredUpdateChannel(workflow.options.Channel).UpdateWithContext(ctx); err != nil {
+			return fmt.Errorf("failed to set the update channel to %s: %w", workflow.options.Channel, err)
+		}
+
+		workflow.emit(UpgradeEventChannelSet, "", "", workflow.options.Channel)
+	}
+
+	if workflow.options.PauseWorkerPools {
+		if err = workflow.pauseWorkerPools(ctx); err != nil {
+			return err
+		}
+	}
+
+	for index, target := range workflow.options.Targets {
+		if err = workflow.upgradeTo(ctx, clusterVersion, target, index == 0); err != nil {
+			return err
+		}
+	}
+
+	if len(workflow.PausedPools) > 0 {
+		return workflow.unpausePools(ctx)
+	}
+
+	return nil
+}
+
+// upgradeTo runs a single hop of the upgrade. firstHop tells whether no hop of the workflow completed before.
+func (workflow *UpgradeWorkflow) upgradeTo(
+	ctx context.Context, clusterVersion *Builder, target UpgradeTarget, firstHop bool) error {
+	ctx, cancel := context.WithTimeout(ctx, workflow.options.HopTimeout)
+	defer cancel()
+
+	var (
+		resolved    UpgradeTarget
+		resolveErr  error
+		fromVersion string
+	)
+
+	// The available updates are only refreshed by the cluster-version-operator some time after the channel changed or
+	// the previous hop completed. A version missing from updates retrieved for the current spec is never resolved.
+	// After a previous hop, the RetrievedUpdates condition may stay True without its transition time changing, so the
+	// updates retrieved since the hop completed cannot be told apart and the target is waited for until the timeout.
+	err := wait.PollUntilContextCancel(ctx, workflow.options.PollInterval, true, func(ctx context.Context) (bool, error) {
+		if !clusterVersion.ExistsWithContext(ctx) || clusterVersion.Object == nil {
+			return false, nil
+		}
+
+		fromVersion = clusterVersion.Object.Status.Desired.Version
+		resolved, resolveErr = workflow.resolveTarget(clusterVersion, target)
+
+		if resolveErr != nil && firstHop && target.Image == "" && target.Stream == "" &&
+			updatesRetrieved(clusterVersion.Object) {
+			return false, resolveErr
+		}
+
+		return resolveErr == nil, nil
+	})
+	if err != nil {
+		if resolveErr != nil && !errors.Is(err, resolveErr) {
+			return fmt.Errorf("failed to resolve upgrade target %+v: %w: %w", target, err, resolveErr)
+		}
+
+		return fmt.Errorf("failed to resolve upgrade target %+v: %w", target, err)
+	}
+
+	if workflow.options.AcknowledgeAdminGates {
+		if err = workflow.acknowledgeAdminGates(ctx, fromVersion, resolved.Version); err != nil {
+			return err
+		}
+	}
+
+	glog.V(100).Infof("Upgrading cluster from %s to %s (%s)", fromVersion, resolved.Version, resolved.Image)
+
+	clusterVersion.Definition = clusterVersion.Object
+	clusterVersion.Definition.Spec.DesiredUpdate = &configv1.Update{
+		Version: resolved.Version, Image: resolved.Image, Force: workflow.options.Force}
+
+	if _, err = clusterVersion.UpdateWithContext(ctx); err != nil {
+		return fmt.Errorf("failed to set the desired update to %s: %w", resolved.Version, err)
+	}
+
+	workflow.version = resolved.Version
+	workflow.emit(UpgradeEventStarted, resolved.Version, "", fmt.Sprintf("from %s (%s)", fromVersion, resolved.Image))
+
+	workflow.operatorVersions = map[string]string{}
+	workflow.conditions = map[configv1.ClusterStatusConditionType]configv1.ConditionStatus{}
+
+	err = wait.PollUntilContextCancel(ctx, workflow.options.PollInterval, true, func(ctx context.Context) (bool, error) {
+		return workflow.observeHop(ctx, clusterVersion, resolved), nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to upgrade the cluster from %s to %s: %w%s",
+			fromVersion, resolved.Version, err, workflow.describeHop(clusterVersion))
+	}
+
+	// Targets given by an image outside of the update graph only get their version from the update history.
+	if resolved.Version == "" {
+		resolved.Version = clusterVersion.Object.Status.History[0].Version
+		workflow.version = resolved.Version
+	}
+
+	operators, err := workflow.pullClusterOperators(ctx)
+	if err != nil {
+		return err
+	}
+
+	if _, err = clusteroperator.VerifyClusterOperatorsVersion(resolved.Version, operators); err != nil {
+		return err
+	}
+
+	workflow.emit(UpgradeEventCompleted, resolved.Version, "", fmt.Sprintf("from %s", fromVersion))
+
+	return nil
+}
+
+// resolveTarget returns target with both its version and image, looking them up in the updates of the cluster.
+func (workflow *UpgradeWorkflow) resolveTarget(clusterVersion *Builder, target UpgradeTarget) (UpgradeTarget, error) {
+	releases := clusterVersion.Object.Status.AvailableUpdates
+
+	if workflow.options.AcceptConditionalUpdates {
+		for _, conditionalUpdate := range clusterVersion.Object.Status.ConditionalUpdates {
+			releases = append(releases, conditionalUpdate.Release)
+		}
+	}
+
+	if target.Stream != "" && target.Image == "" && target.Version == "" {
+		image, err := clusterVersion.GetNextUpdateVersionImage(target.Stream, workflow.options.AcceptConditionalUpdates)
+		if err != nil {
+			return target, err
+		}
+
+		target.Image = image
+	}
+
+	for _, release := range releases {
+		if (target.Version == "" || release.Version == target.Version) &&
+			(target.Image == "" || release.Image == target.Image) {
+			return UpgradeTarget{Version: release.Version, Image: release.Image}, nil
+		}
+	}
+
+	// A release outside of the update graph, typically a signature-less image used with Force, can still be used.
+	if target.Image != "" {
+		return UpgradeTarget{Version: target.Version, Image: target.Image}, nil
+	}
+
+	return target, fmt.Errorf("version %s is not an available update of cluster version %s",
+		target.Version, clusterVersion.Object.Status.Desired.Version)
+}
+
+// updatesRetrieved returns true if the available updates of clusterVersion are populated and were retrieved by the
+// cluster-version-operator after it observed the latest spec, such as a new channel or desired update.
+func updatesRetrieved(clusterVersion *configv1.ClusterVersion) bool {
+	if len(clusterVersion.Status.AvailableUpdates) == 0 ||
+		clusterVersion.Status.ObservedGeneration != clusterVersion.Generation {
+		return false
+	}
+
+	for _, condition := range clusterVersion.Status.Conditions {
+		if condition.Type == configv1.RetrievedUpdates {
+			return condition.Status == configv1.ConditionTrue
+		}
+	}
+
+	return false
+}
+
+// acknowledgeAdminGates acknowledges the admin-gates applying to the upgrade from fromVersion to toVersion.
+func (workflow *UpgradeWorkflow) acknowledgeAdminGates(ctx context.Context, fromVersion, toVersion string) error {
+	adminGates, err := workflow.apiClient.ConfigMaps(adminGatesNamespace).Get(
+		ctx, adminGatesConfigMap, metav1.GetOptions{})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			glog.V(100).Infof("No admin-gates to acknowledge: %v", err)
+
+			return nil
+		}
+
+		return fmt.Errorf("failed to get the %s configmap: %w", adminGatesConfigMap, err)
+	}
+
+	version, err := semver.NewVersion(fromVersion)
+	if err != nil {
+		return fmt.Errorf("failed to parse the cluster version %s: %w", fromVersion, err)
+	}
+
+	prefix := fmt.Sprintf("ack-%d.%d-", version.Major(), version.Minor())
+
+	adminAcks, err := configmap.PullWithContext(ctx, workflow.apiClient, adminAcksConfigMap, adminAcksNamespace)
+	if err != nil {
+		return fmt.Errorf("failed to get the %s configmap: %w", adminAcksConfigMap, err)
+	}
+
+	acks := map[string]string{}
+	for key, value := range adminAcks.Object.Data {
+		acks[key] = value
+	}
+
+	var acknowledged []string
+
+	for gate := range adminGates.Data {
+		if strings.HasPrefix(gate, prefix) && acks[gate] != "true" {
+			acks[gate] = "true"
+			acknowledged = append(acknowledged, gate)
+		}
+	}
+
+	if len(acknowledged) == 0 {
+		return nil
+	}
+
+	slices.Sort(acknowledged)
+
+	if _, err = adminAcks.WithData(acks).UpdateWithContext(ctx); err != nil {
+		return fmt.Errorf("failed to acknowledge the admin-gates %v: %w", acknowledged, err)
+	}
+
+	for _, gate := range acknowledged {
+		workflow.emit(UpgradeEventAdminGateAcknowledged, toVersion, gate, adminGates.Data[gate])
+	}
+
+	return nil
+}
+
+// observeHop reports the progress of the hop to target and returns true once the control plane completed it.
+func (workflow *UpgradeWorkflow) observeHop(ctx context.Context, clusterVersion *Builder, target UpgradeTarget) bool {
+	if !clusterVersion.ExistsWithContext(ctx) || clusterVersion.Object == nil {
+		return false
+	}
+
+	for _, condition := range clusterVersion.Object.Status.Conditions {
+		if condition.Type != configv1.OperatorProgressing && condition.Type != "Failing" {
+			continue
+		}
+
+		if previous, found := workflow.conditions[condition.Type]; !found || previous != condition.Status {
+			workflow.conditions[condition.Type] = condition.Status
+			workflow.emit(UpgradeEventConditionChanged, target.Version, string(condition.Type),
+				fmt.Sprintf("%s=%s (%s) %s", condition.Type, condition.Status, condition.Reason, condition.Message))
+		}
+	}
+
+	workflow.observeOperators(ctx, target.Version)
+	workflow.observePools(ctx, target.Version)
+
+	history := clusterVersion.Object.Status.History
+
+	return len(history) > 0 && history[0].State == configv1.CompletedUpdate &&
+		(target.Version == "" || history[0].Version == target.Version) &&
+		(target.Image == "" || history[0].Image == target.Image)
+}
+
+// observeOperators emits an event for each ClusterOperator newly reporting version.
+func (workflow *UpgradeWorkflow) observeOperators(ctx context.Context, version string) {
+	operatorList := &configv1.ClusterOperatorList{}
+
+	if err := workflow.apiClient.Client.List(ctx, operatorList); err != nil {
+		glog.V(100).Infof("Failed to list ClusterOperators: %v", err)
+
+		return
+	}
+
+	for _, operator := range operatorList.Items {
+		for _, operatorVersion := range operator.Status.Versions {
+			if operatorVersion.Name != operatorVersionName ||
+				workflow.operatorVersions[operator.Name] == operatorVersion.Version {
+				continue
+			}
+
+			workflow.operatorVersions[operator.Name] = operatorVersion.Version
+
+			if operatorVersion.Version == version {
+				workflow.emit(UpgradeEventOperatorUpdated, version, operator.Name,
+					fmt.Sprintf("%d/%d operators updated", workflow.countOperatorsAt(version), len(operatorList.Items)))
+			}
+		}
+	}
+}
+
+// countOperatorsAt returns the number of ClusterOperators observed at version.
+func (workflow *UpgradeWorkflow) countOperatorsAt(version string) int {
+	count := 0
+
+	for _, operatorVersion := range workflow.operatorVersions {
+		if operatorVersion == version {
+			count++
+		}
+	}
+
+	return count
+}
+
+// observePools emits an event for each MachineConfigPool whose update progress changed and returns true if all the
+// pools are updated.
+func (workflow *UpgradeWorkflow) observePools(ctx context.Context, version string) bool {
+	poolList := &mcv1.MachineConfigPoolList{}
+
+	if err := workflow.apiClient.Client.List(ctx, poolList); err != nil {
+		glog.V(100).Infof("Failed to list MachineConfigPools: %v", err)
+
+		return false
+	}
+
+	if workflow.poolProgress == nil {
+		workflow.poolProgress = map[string]string{}
+	}
+
+	updated := true
+
+	for _, pool := range poolList.Items {
+		poolUpdated := isPoolUpdated(&pool)
+		updated = updated && poolUpdated
+
+		progress := fmt.Sprintf("%d/%d machines updated", pool.Status.UpdatedMachineCount, pool.Status.MachineCount)
+		if pool.Spec.Paused {
+			progress += ", paused"
+		} else if poolUpdated {
+			progress += ", updated"
+		}
+
+		if workflow.poolProgress[pool.Name] != progress {
+			workflow.poolProgress[pool.Name] = progress
+			workflow.emit(UpgradeEventPoolProgress, version, pool.Name, progress)
+		}
+	}
+
+	return updated
+}
+
+// isPoolUpdated returns true if all the machines of pool are updated and ready and pool reports being updated.
+func isPoolUpdated(pool *mcv1.MachineConfigPool) bool {
+	if pool.Status.UpdatedMachineCount != pool.Status.MachineCount ||
+		pool.Status.ReadyMachineCount != pool.Status.MachineCount || pool.Status.DegradedMachineCount != 0 {
+		return false
+	}
+
+	for _, condition := range pool.Status.Conditions {
+		if condition.Type == mcv1.MachineConfigPoolUpdated {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+
+	return false
+}
+
+// pauseWorkerPools pauses all the MachineConfigPools but master.
+func (workflow *UpgradeWorkflow) pauseWorkerPools(ctx context.Context) error {
+	poolList := &mcv1.MachineConfigPoolList{}
+
+	if err := workflow.apiClient.Client.List(ctx, poolList); err != nil {
+		return fmt.Errorf("failed to list MachineConfigPools: %w", err)
+	}
+
+	for _, pool := range poolList.Items {
+		if pool.Name == masterPoolName || pool.Spec.Paused {
+			continue
+		}
+
+		if err := workflow.setPoolPaused(ctx, pool.Name, true); err != nil {
+			return err
+		}
+
+		workflow.PausedPools = append(workflow.PausedPools, pool.Name)
+		workflow.emit(UpgradeEventPoolPaused, "", pool.Name, "")
+	}
+
+	return nil
+}
+
+// unpausePools unpauses the pools paused by the workflow and waits for all the pools to be updated.
+func (workflow *UpgradeWorkflow) unpausePools(ctx context.Context) error {
+	version := workflow.version
+
+	for len(workflow.PausedPools) > 0 {
+		pool := workflow.PausedPools[0]
+
+		if err := workflow.setPoolPaused(ctx, pool, false); err != nil {
+			return err
+		}
+
+		workflow.PausedPools = workflow.PausedPools[1:]
+		workflow.emit(UpgradeEventPoolUnpaused, version, pool, "")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, workflow.options.PoolTimeout)
+	defer cancel()
+
+	err := wait.PollUntilContextCancel(ctx, workflow.options.PollInterval, true, func(ctx context.Context) (bool, error) {
+		return workflow.observePools(ctx, version), nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to wait for the MachineConfigPools to be updated: %w", err)
+	}
+
+	return nil
+}
+
+// setPoolPaused sets whether the MachineConfigPool with the given name is paused, retrying on conflicts.
+func (workflow *UpgradeWorkflow) setPoolPaused(ctx context.Context, name string, paused bool) error {
+	glog.V(100).Infof("Setting MachineConfigPool %s paused to %v", name, paused)
+
+	pool := &mcv1.MachineConfigPool{}
+
+	err := workflow.apiClient.Client.Get(ctx, runtimeclient.ObjectKey{Name: name}, pool)
+	if err == nil {
+		desiredPool := pool.DeepCopy()
+		desiredPool.Spec.Paused = paused
+
+		_, err = common.UpdateObjectWithRetry(ctx, workflow.apiClient.Client, retry.DefaultRetry, pool, desiredPool)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to set MachineConfigPool %s paused to %v: %w", name, paused, err)
+	}
+
+	return nil
+}
+
+// pullClusterOperators returns a builder for every ClusterOperator of the cluster.
+func (workflow *UpgradeWorkflow) pullClusterOperators(ctx context.Context) ([]*clusteroperator.Builder, error) {
+	operatorList := &configv1.ClusterOperatorList{}
+
+	if err := workflow.apiClient.Client.List(ctx, operatorList); err != nil {
+		return nil, fmt.Errorf("failed to list ClusterOperators: %w", err)
+	}
+
+	var operators []*clusteroperator.Builder
+
+	for _, operator := range operatorList.Items {
+		builder, err := clusteroperator.PullWithContext(ctx, workflow.apiClient, operator.Name)
+		if err != nil {
+			if k8serrors.IsNotFound(err) {
+				continue
+			}
+
+			return nil, err
+		}
+
+		operators = append(operators, builder)
+	}
+
+	return operators, nil
+}
+
+// describeHop returns the ClusterOperators not at the target version and the last ClusterVersion conditions for the
+// error of a failed hop.
+func (workflow *UpgradeWorkflow) describeHop(clusterVersion *Builder) string {
+	if clusterVersion.Object == nil {
+		return ""
+	}
+
+	var details []string
+
+	for _, condition := range clusterVersion.Object.Status.Conditions {
+		if condition.Type == "Failing" && condition.Status == configv1.ConditionTrue {
+			details = append(details, fmt.Sprintf("Failing: %s: %s", condition.Reason, condition.Message))
+		}
+	}
+
+	if history := clusterVersion.Object.Status.History; len(history) > 0 {
+		details = append(details, fmt.Sprintf("last update %s is %s", history[0].Version, history[0].State))
+	}
+
+	if len(details) == 0 {
+		return ""
+	}
+
+	return " (" + strings.Join(details, "; ") + ")"
+}
+
+// emit records the event and passes it to the OnEvent callback.
+func (workflow *UpgradeWorkflow) emit(eventType UpgradeEventType, version, object, message string) {
+	event := UpgradeEvent{Time: time.Now(), Type: eventType, Version: version, Object: object, Message: message}
+
+	glog.V(100).Infof("Cluster upgrade: %s", event)
+
+	workflow.Events = append(workflow.Events, event)
+
+	if workflow.options.OnEvent != nil {
+		workflow.options.OnEvent(event)
+	}
+}
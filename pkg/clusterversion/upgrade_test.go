@@ -0,0 +1,445 @@
+package clusterversion
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	configv1 "github.com/openshift/api/config/v1"
+	mcv1 "github.com/openshift/api/machineconfiguration/v1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	defaultUpgradeFromVersion = "4.14.10"
+	defaultUpgradeZVersion    = "4.14.12"
+	defaultUpgradeYVersion    = "4.15.3"
+	defaultUpgradeEUSVersion  = "4.16.1"
+)
+
+var upgradeTestSchemes = []clients.SchemeAttacher{
+	configv1.Install,
+	mcv1.Install,
+}
+
+//nolint:funlen
+func TestUpgradeWorkflowRun(t *testing.T) {
+	testCases := []struct {
+		options         UpgradeOptions
+		adminGates      map[string]string
+		expectedVersion string
+		expectedEvents  []string
+		expectedAcks    map[string]string
+		expectedChannel string
+		expectedDesired configv1.Update
+	}{
+		{
+			options:         UpgradeOptions{Targets: []UpgradeTarget{{Stream: Z}}},
+			expectedVersion: defaultUpgradeZVersion,
+			expectedEvents: []string{
+				"UpgradeStarted: from 4.14.10 (image-4.14.12)",
+				"PoolProgress master: 1/1 machines updated, updated",
+				"PoolProgress worker: 2/2 machines updated, updated",
+				"OperatorUpdated authentication: 1/2 operators updated",
+				"OperatorUpdated etcd: 2/2 operators updated",
+				"UpgradeCompleted: from 4.14.10",
+			},
+			expectedDesired: configv1.Update{Version: defaultUpgradeZVersion, Image: "image-4.14.12"},
+		},
+		{
+			options: UpgradeOptions{
+				Targets:               []UpgradeTarget{{Version: defaultUpgradeYVersion}},
+				Channel:               "stable-4.15",
+				AcknowledgeAdminGates: true,
+			},
+			adminGates: map[string]string{
+				"ack-4.14-kube-1.28-api-removals-in-4.15": "Kubernetes 1.28 removed APIs",
+				"ack-4.13-kube-1.27-api-removals-in-4.14": "Kubernetes 1.27 removed APIs",
+			},
+			expectedVersion: defaultUpgradeYVersion,
+			expectedEvents: []string{
+				"ChannelSet: stable-4.15",
+				"AdminGateAcknowledged ack-4.14-kube-1.28-api-removals-in-4.15: Kubernetes 1.28 removed APIs",
+				"UpgradeStarted: from 4.14.10 (image-4.15.3)",
+				"PoolProgress master: 1/1 machines updated, updated",
+				"PoolProgress worker: 2/2 machines updated, updated",
+				"OperatorUpdated authentication: 1/2 operators updated",
+				"OperatorUpdated etcd: 2/2 operators updated",
+				"UpgradeCompleted: from 4.14.10",
+			},
+			expectedAcks: map[string]string{
+				"ack-4.14-kube-1.28-api-removals-in-4.15": "true",
+				"ack-4.12-kube-1.26-api-removals-in-4.13": "true",
+			},
+			expectedChannel: "stable-4.15",
+			expectedDesired: configv1.Update{Version: defaultUpgradeYVersion, Image: "image-4.15.3"},
+		},
+		{
+			options: UpgradeOptions{
+				Targets: []UpgradeTarget{{Stream: Y}, {Version: defaultUpgradeEUSVersion}},
+				Channel: "eus-4.16", PauseWorkerPools: true, Force: true,
+			},
+			expectedVersion: defaultUpgradeEUSVersion,
+			expectedEvents: []string{
+				"ChannelSet: eus-4.16",
+				"PoolPaused worker",
+				"UpgradeStarted: from 4.14.10 (image-4.15.3)",
+				"PoolProgress master: 1/1 machines updated, updated",
+				"PoolProgress worker: 0/2 machines updated, paused",
+				"OperatorUpdated authentication: 1/2 operators updated",
+				"OperatorUpdated etcd: 2/2 operators updated",
+				"UpgradeCompleted: from 4.14.10",
+				"UpgradeStarted: from 4.15.3 (image-4.16.1)",
+				"OperatorUpdated authentication: 1/2 operators updated",
+				"OperatorUpdated etcd: 2/2 operators updated",
+				"UpgradeCompleted: from 4.15.3",
+				"PoolUnpaused worker",
+				"PoolProgress worker: 1/2 machines updated",
+				"PoolProgress worker: 2/2 machines updated, updated",
+			},
+			expectedChannel: "eus-4.16",
+			expectedDesired: configv1.Update{Version: defaultUpgradeEUSVersion, Image: "image-4.16.1", Force: true},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := buildUpgradeTestClient(testCase.adminGates)
+
+		var callbackEvents []string
+
+		testCase.options.PollInterval = 10 * time.Millisecond
+		testCase.options.HopTimeout = 5 * time.Second
+		testCase.options.PoolTimeout = 5 * time.Second
+		testCase.options.OnEvent = func(event UpgradeEvent) {
+			callbackEvents = append(callbackEvents, event.String())
+		}
+
+		workflow, err := NewUpgradeWorkflow(testSettings, testCase.options)
+		assert.Nil(t, err)
+
+		err = workflow.Run()
+		assert.Nil(t, err)
+
+		var events []string
+
+		for _, event := range workflow.Events {
+			if event.Type != UpgradeEventConditionChanged {
+				events = append(events, event.String())
+			}
+		}
+
+		assert.Equal(t, testCase.expectedEvents, events)
+		assert.Len(t, callbackEvents, len(workflow.Events))
+		assert.Empty(t, workflow.PausedPools)
+
+		clusterVersion, err := Pull(testSettings)
+		assert.Nil(t, err)
+		assert.Equal(t, testCase.expectedVersion, clusterVersion.Object.Status.History[0].Version)
+		assert.Equal(t, testCase.expectedChannel, clusterVersion.Object.Spec.Channel)
+		assert.Equal(t, testCase.expectedDesired, *clusterVersion.Object.Spec.DesiredUpdate)
+
+		adminAcks := &corev1.ConfigMap{}
+		err = testSettings.Client.Get(t.Context(),
+			runtimeclient.ObjectKey{Name: adminAcksConfigMap, Namespace: adminAcksNamespace}, adminAcks)
+		assert.Nil(t, err)
+
+		if testCase.expectedAcks == nil {
+			testCase.expectedAcks = map[string]string{"ack-4.12-kube-1.26-api-removals-in-4.13": "true"}
+		}
+
+		assert.Equal(t, testCase.expectedAcks, adminAcks.Data)
+
+		workerPool := &mcv1.MachineConfigPool{}
+		err = testSettings.Client.Get(t.Context(), runtimeclient.ObjectKey{Name: "worker"}, workerPool)
+		assert.Nil(t, err)
+		assert.False(t, workerPool.Spec.Paused)
+	}
+}
+
+func TestUpgradeWorkflowRunFailure(t *testing.T) {
+	testSettings := buildUpgradeTestClient(nil)
+
+	workflow, err := NewUpgradeWorkflow(testSettings, UpgradeOptions{
+		Targets:          []UpgradeTarget{{Version: "4.18.0"}},
+		PauseWorkerPools: true,
+		HopTimeout:       50 * time.Millisecond,
+		PollInterval:     10 * time.Millisecond,
+	})
+	assert.Nil(t, err)
+
+	err = workflow.RunWithContext(t.Context())
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.EqualError(t, err, "failed to resolve upgrade target {Version:4.18.0 Image: Stream:}: "+
+		"context deadline exceeded: version 4.18.0 is not an available update of cluster version 4.14.10")
+	assert.Equal(t, []string{"worker"}, workflow.PausedPools)
+
+	workerPool := &mcv1.MachineConfigPool{}
+	err = testSettings.Client.Get(t.Context(), runtimeclient.ObjectKey{Name: "worker"}, workerPool)
+	assert.Nil(t, err)
+	assert.True(t, workerPool.Spec.Paused)
+
+	// Once the updates were retrieved for the current spec, a missing version fails without waiting for the timeout.
+	clusterVersion := &configv1.ClusterVersion{}
+	err = testSettings.Client.Get(t.Context(), runtimeclient.ObjectKey{Name: clusterVersionName}, clusterVersion)
+	assert.Nil(t, err)
+
+	clusterVersion.Status.Conditions = append(clusterVersion.Status.Conditions,
+		configv1.ClusterOperatorStatusCondition{Type: configv1.RetrievedUpdates, Status: configv1.ConditionTrue})
+	err = testSettings.Client.Update(t.Context(), clusterVersion)
+	assert.Nil(t, err)
+
+	workflow, err = NewUpgradeWorkflow(testSettings, UpgradeOptions{
+		Targets:    []UpgradeTarget{{Version: "4.18.0"}},
+		HopTimeout: time.Minute,
+	})
+	assert.Nil(t, err)
+
+	err = workflow.RunWithContext(t.Context())
+	assert.NotErrorIs(t, err, context.DeadlineExceeded)
+	assert.EqualError(t, err, "failed to resolve upgrade target {Version:4.18.0 Image: Stream:}: "+
+		"version 4.18.0 is not an available update of cluster version 4.14.10")
+}
+
+func TestUpgradeWorkflowAcknowledgeAdminGatesError(t *testing.T) {
+	testSettings := buildUpgradeTestClient(nil)
+
+	// Only a missing admin-gates configmap means there is nothing to acknowledge.
+	testSettings.K8sClient.(*k8sfake.Clientset).PrependReactor("get", "configmaps",
+		func(k8stesting.Action) (bool, runtime.Object, error) {
+			return true, nil, fmt.Errorf("connection refused")
+		})
+
+	workflow, err := NewUpgradeWorkflow(testSettings, UpgradeOptions{
+		Targets:               []UpgradeTarget{{Version: defaultUpgradeZVersion}},
+		AcknowledgeAdminGates: true,
+		PollInterval:          10 * time.Millisecond,
+	})
+	assert.Nil(t, err)
+
+	err = workflow.RunWithContext(t.Context())
+	assert.EqualError(t, err, "failed to get the admin-gates configmap: connection refused")
+}
+
+func TestNewUpgradeWorkflow(t *testing.T) {
+	testCases := []struct {
+		targets       []UpgradeTarget
+		client        bool
+		expectedError error
+	}{
+		{
+			targets: []UpgradeTarget{{Stream: Y}, {Image: "quay.io/test/release:4.16.1"}},
+			client:  true,
+		},
+		{
+			targets:       []UpgradeTarget{{Version: defaultUpgradeZVersion}},
+			client:        false,
+			expectedError: fmt.Errorf("clusterversion upgrade 'apiClient' cannot be nil"),
+		},
+		{
+			targets:       nil,
+			client:        true,
+			expectedError: fmt.Errorf("clusterversion upgrade 'targets' cannot be empty"),
+		},
+		{
+			targets:       []UpgradeTarget{{Version: defaultUpgradeZVersion}, {}},
+			client:        true,
+			expectedError: fmt.Errorf("clusterversion upgrade target 1 must have an image, a version or a stream"),
+		},
+		{
+			targets:       []UpgradeTarget{{Stream: "W"}},
+			client:        true,
+			expectedError: fmt.Errorf("clusterversion upgrade target 0 has invalid stream W"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var testSettings *clients.Settings
+
+		if testCase.client {
+			testSettings = clients.GetTestClients(clients.TestClientParams{SchemeAttachers: upgradeTestSchemes})
+		}
+
+		workflow, err := NewUpgradeWorkflow(testSettings, UpgradeOptions{Targets: testCase.targets})
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError != nil {
+			assert.Nil(t, workflow)
+
+			continue
+		}
+
+		assert.Equal(t, DefaultUpgradeHopTimeout, workflow.options.HopTimeout)
+		assert.Equal(t, DefaultUpgradePoolTimeout, workflow.options.PoolTimeout)
+		assert.Equal(t, DefaultUpgradePollInterval, workflow.options.PollInterval)
+	}
+}
+
+func TestUpgradeEventString(t *testing.T) {
+	assert.Equal(t, "PoolPaused worker", UpgradeEvent{Type: UpgradeEventPoolPaused, Object: "worker"}.String())
+	assert.Equal(t, "UpgradeCompleted: from 4.14.10",
+		UpgradeEvent{Type: UpgradeEventCompleted, Message: "from 4.14.10"}.String())
+}
+
+// buildUpgradeTestClient returns a client with a cluster at defaultUpgradeFromVersion, two ClusterOperators and the
+// master and worker MachineConfigPools. The ClusterVersion completes its desired update on the third read after it
+// is set, then the ClusterOperators report the new version when read. Once at defaultUpgradeYVersion, the cluster
+// keeps reporting stale updates retrieved for three reads before offering defaultUpgradeEUSVersion. The worker pool
+// has no updated machine while paused and updates one machine per read once unpaused.
+//
+//nolint:funlen
+func buildUpgradeTestClient(adminGates map[string]string) *clients.Settings {
+	var (
+		targetVersion string
+		hopReads      int
+		staleReads    int
+	)
+
+	clusterVersion := buildDummyClusterVersion()
+	clusterVersion.Status = configv1.ClusterVersionStatus{
+		Desired: configv1.Release{Version: defaultUpgradeFromVersion, Image: "image-4.14.10"},
+		History: []configv1.UpdateHistory{{
+			Version: defaultUpgradeFromVersion, Image: "image-4.14.10", State: configv1.CompletedUpdate}},
+		AvailableUpdates: buildUpgradeReleases(defaultUpgradeZVersion, defaultUpgradeYVersion),
+		Conditions: []configv1.ClusterOperatorStatusCondition{
+			{Type: configv1.OperatorProgressing, Status: configv1.ConditionFalse}},
+	}
+
+	runtimeObjects := []runtime.Object{
+		clusterVersion,
+		buildUpgradeClusterOperator("authentication"),
+		buildUpgradeClusterOperator("etcd"),
+		buildUpgradeMCP("master", 1),
+		buildUpgradeMCP("worker", 2),
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: adminAcksConfigMap, Namespace: adminAcksNamespace},
+			Data:       map[string]string{"ack-4.12-kube-1.26-api-removals-in-4.13": "true"},
+		},
+	}
+
+	if adminGates != nil {
+		runtimeObjects = append(runtimeObjects, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: adminGatesConfigMap, Namespace: adminGatesNamespace},
+			Data:       adminGates,
+		})
+	}
+
+	simulators := []clients.ControllerSimulator{
+		{
+			Object:   &configv1.ClusterVersion{},
+			Triggers: []clients.SimulatorTrigger{clients.SimulatorTriggerRead},
+			Reconcile: func(object runtime.Object, _ int) {
+				clusterVersion := object.(*configv1.ClusterVersion)
+				desiredUpdate := clusterVersion.Spec.DesiredUpdate
+
+				if desiredUpdate == nil || clusterVersion.Status.History[0].Version == desiredUpdate.Version &&
+					clusterVersion.Status.History[0].State == configv1.CompletedUpdate {
+					if targetVersion == defaultUpgradeYVersion {
+						if staleReads++; staleReads > 3 {
+							clusterVersion.Status.AvailableUpdates = buildUpgradeReleases(defaultUpgradeEUSVersion)
+						}
+					}
+
+					return
+				}
+
+				if clusterVersion.Status.History[0].Version != desiredUpdate.Version {
+					hopReads = 0
+					clusterVersion.Status.Desired = configv1.Release{
+						Version: desiredUpdate.Version, Image: desiredUpdate.Image}
+					clusterVersion.Status.History = append([]configv1.UpdateHistory{{
+						Version: desiredUpdate.Version, Image: desiredUpdate.Image, State: configv1.PartialUpdate}},
+						clusterVersion.Status.History...)
+					clusterVersion.Status.Conditions[0].Status = configv1.ConditionTrue
+				}
+
+				if hopReads++; hopReads < 3 {
+					return
+				}
+
+				targetVersion = desiredUpdate.Version
+				clusterVersion.Status.History[0].State = configv1.CompletedUpdate
+				clusterVersion.Status.Conditions[0].Status = configv1.ConditionFalse
+				clusterVersion.Status.AvailableUpdates = nil
+
+				if targetVersion == defaultUpgradeYVersion {
+					clusterVersion.Status.AvailableUpdates = buildUpgradeReleases("4.15.5")
+					clusterVersion.Status.Conditions = append(clusterVersion.Status.Conditions,
+						configv1.ClusterOperatorStatusCondition{
+							Type: configv1.RetrievedUpdates, Status: configv1.ConditionTrue})
+				}
+			},
+		},
+		{
+			Object:   &configv1.ClusterOperator{},
+			Triggers: []clients.SimulatorTrigger{clients.SimulatorTriggerRead},
+			Reconcile: func(object runtime.Object, _ int) {
+				if targetVersion != "" {
+					object.(*configv1.ClusterOperator).Status.Versions[0].Version = targetVersion
+				}
+			},
+		},
+		{
+			Object:   &mcv1.MachineConfigPool{},
+			Name:     "worker",
+			Triggers: []clients.SimulatorTrigger{clients.SimulatorTriggerRead},
+			Reconcile: func(object runtime.Object, _ int) {
+				pool := object.(*mcv1.MachineConfigPool)
+
+				switch {
+				case pool.Spec.Paused:
+					pool.Status.UpdatedMachineCount = 0
+				case pool.Status.UpdatedMachineCount < pool.Status.MachineCount:
+					pool.Status.UpdatedMachineCount++
+				}
+
+				pool.Status.Conditions[0].Status = corev1.ConditionFalse
+				if pool.Status.UpdatedMachineCount == pool.Status.MachineCount {
+					pool.Status.Conditions[0].Status = corev1.ConditionTrue
+				}
+			},
+		},
+	}
+
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects:  runtimeObjects,
+		SchemeAttachers: upgradeTestSchemes,
+		Simulators:      simulators,
+	})
+}
+
+func buildUpgradeReleases(versions ...string) []configv1.Release {
+	var releases []configv1.Release
+
+	for _, version := range versions {
+		releases = append(releases, configv1.Release{Version: version, Image: "image-" + version})
+	}
+
+	return releases
+}
+
+func buildUpgradeClusterOperator(name string) *configv1.ClusterOperator {
+	return &configv1.ClusterOperator{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: configv1.ClusterOperatorStatus{Versions: []configv1.OperandVersion{
+			{Name: operatorVersionName, Version: defaultUpgradeFromVersion}}},
+	}
+}
+
+func buildUpgradeMCP(name string, machineCount int32) *mcv1.MachineConfigPool {
+	return &mcv1.MachineConfigPool{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: mcv1.MachineConfigPoolStatus{
+			MachineCount: machineCount, UpdatedMachineCount: machineCount, ReadyMachineCount: machineCount,
+			Conditions: []mcv1.MachineConfigPoolCondition{
+				{Type: mcv1.MachineConfigPoolUpdated, Status: corev1.ConditionTrue}},
+		},
+	}
+}
@@ -31,6 +31,11 @@ type AdditionalOptions func(builder *Builder) (*Builder, error)
 
 // Pull retrieves an existing configmap object from the cluster.
 func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
+	return PullWithContext(context.Background(), apiClient, name, nsname)
+}
+
+// PullWithContext loads an existing configmap like Pull using the provided context.
+func PullWithContext(ctx context.Context, apiClient *clients.Settings, name, nsname string) (*Builder, error) {
 	builder := Builder{
 		apiClient: apiClient.CoreV1Interface,
 		Definition: &corev1.ConfigMap{
@@ -56,7 +61,7 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
 	glog.V(100).Infof(
 		"Pulling configmap object name:%s in namespace: %s", name, nsname)
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		return nil, fmt.Errorf("configmap object %s does not exist in namespace %s", name, nsname)
 	}
 
@@ -101,6 +106,11 @@ func NewBuilder(apiClient *clients.Settings, name, nsname string) *Builder {
 
 // Create makes a configmap in cluster and stores the created object in struct.
 func (builder *Builder) Create() (*Builder, error) {
+	return builder.CreateWithContext(context.Background())
+}
+
+// CreateWithContext makes a configmap in cluster using the provided context and stores the created object in struct.
+func (builder *Builder) CreateWithContext(ctx context.Context) (*Builder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
@@ -108,9 +118,9 @@ func (builder *Builder) Create() (*Builder, error) {
 	glog.V(100).Infof("Creating the configmap %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		builder.Object, err = builder.apiClient.ConfigMaps(builder.Definition.Namespace).Create(
-			context.TODO(), builder.Definition, metav1.CreateOptions{})
+			ctx, builder.Definition, metav1.CreateOptions{})
 	}
 
 	return builder, err
@@ -118,6 +128,11 @@ func (builder *Builder) Create() (*Builder, error) {
 
 // Delete removes a configmap.
 func (builder *Builder) Delete() error {
+	return builder.DeleteWithContext(context.Background())
+}
+
+// DeleteWithContext removes a configmap using the provided context.
+func (builder *Builder) DeleteWithContext(ctx context.Context) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
@@ -125,7 +140,7 @@ func (builder *Builder) Delete() error {
 	glog.V(100).Infof("Deleting the configmap %s from namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		glog.V(100).Infof("configmap %s in namespace %s does not exist",
 			builder.Definition.Name, builder.Definition.Namespace)
 
@@ -135,7 +150,7 @@ func (builder *Builder) Delete() error {
 	}
 
 	err := builder.apiClient.ConfigMaps(builder.Definition.Namespace).Delete(
-		context.TODO(), builder.Object.Name, metav1.DeleteOptions{})
+		ctx, builder.Object.Name, metav1.DeleteOptions{})
 
 	if err != nil {
 		return err
@@ -148,6 +163,11 @@ func (builder *Builder) Delete() error {
 
 // Exists checks whether the given configmap exists.
 func (builder *Builder) Exists() bool {
+	return builder.ExistsWithContext(context.Background())
+}
+
+// ExistsWithContext checks whether the given configmap exists using the provided context.
+func (builder *Builder) ExistsWithContext(ctx context.Context) bool {
 	if valid, _ := builder.validate(); !valid {
 		return false
 	}
@@ -158,13 +178,19 @@ func (builder *Builder) Exists() bool {
 
 	var err error
 	builder.Object, err = builder.apiClient.ConfigMaps(builder.Definition.Namespace).Get(
-		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+		ctx, builder.Definition.Name, metav1.GetOptions{})
 
 	return err == nil || !k8serrors.IsNotFound(err)
 }
 
 // Update renovates the existing configmap object with configmap definition in builder.
 func (builder *Builder) Update() (*Builder, error) {
+	return builder.UpdateWithContext(context.Background())
+}
+
+// UpdateWithContext renovates the existing configmap object with configmap definition in builder using the provided
+// context.
+func (builder *Builder) UpdateWithContext(ctx context.Context) (*Builder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
@@ -175,7 +201,7 @@ func (builder *Builder) Update() (*Builder, error) {
 	var err error
 
 	builder.Object, err = builder.apiClient.ConfigMaps(builder.Definition.Namespace).
-		Update(context.TODO(), builder.Definition, metav1.UpdateOptions{})
+		Update(ctx, builder.Definition, metav1.UpdateOptions{})
 
 	if err != nil {
 		glog.V(100).Infof(
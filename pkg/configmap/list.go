@@ -12,6 +12,12 @@ import (
 
 // List returns configmap inventory in the given namespace.
 func List(apiClient *clients.Settings, nsname string, options ...metav1.ListOptions) ([]*Builder, error) {
+	return ListWithContext(context.Background(), apiClient, nsname, options...)
+}
+
+// ListWithContext returns configmap inventory in the given namespace using the provided context.
+func ListWithContext(
+	ctx context.Context, apiClient *clients.Settings, nsname string, options ...metav1.ListOptions) ([]*Builder, error) {
 	if apiClient == nil {
 		glog.V(100).Infof("The apiClient cannot be nil")
 
@@ -40,7 +46,7 @@ func List(apiClient *clients.Settings, nsname string, options ...metav1.ListOpti
 
 	glog.V(100).Infof(logMessage)
 
-	configmapList, err := apiClient.ConfigMaps(nsname).List(context.TODO(), passedOptions)
+	configmapList, err := apiClient.ConfigMaps(nsname).List(ctx, passedOptions)
 
 	if err != nil {
 		glog.V(100).Infof("Failed to list configmaps in the namespace %s due to %s", nsname, err.Error())
@@ -66,6 +72,12 @@ func List(apiClient *clients.Settings, nsname string, options ...metav1.ListOpti
 
 // ListInAllNamespaces returns configmap inventory in the all the namespaces.
 func ListInAllNamespaces(apiClient *clients.Settings, options ...metav1.ListOptions) ([]*Builder, error) {
+	return ListInAllNamespacesWithContext(context.Background(), apiClient, options...)
+}
+
+// ListInAllNamespacesWithContext returns configmap inventory in the all the namespaces using the provided context.
+func ListInAllNamespacesWithContext(
+	ctx context.Context, apiClient *clients.Settings, options ...metav1.ListOptions) ([]*Builder, error) {
 	if apiClient == nil {
 		glog.V(100).Infof("The apiClient cannot be nil")
 
@@ -88,7 +100,7 @@ func ListInAllNamespaces(apiClient *clients.Settings, options ...metav1.ListOpti
 
 	glog.V(100).Infof(logMessage)
 
-	configmapList, err := apiClient.ConfigMaps("").List(context.TODO(), passedOptions)
+	configmapList, err := apiClient.ConfigMaps("").List(ctx, passedOptions)
 
 	if err != nil {
 		glog.V(100).Infof("Failed to list configmaps in all namespaces due to %s", err.Error())
@@ -273,6 +273,11 @@ func (builder *Builder) WithOptions(options ...AdditionalOptions) *Builder {
 
 // Create builds daemonset in the cluster and stores the created object in struct.
 func (builder *Builder) Create() (*Builder, error) {
+	return builder.CreateWithContext(context.Background())
+}
+
+// CreateWithContext builds daemonset in the cluster using the provided context and stores the created object in struct.
+func (builder *Builder) CreateWithContext(ctx context.Context) (*Builder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
@@ -280,9 +285,9 @@ func (builder *Builder) Create() (*Builder, error) {
 	glog.V(100).Infof("Creating daemonset %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		builder.Object, err = builder.apiClient.Create(
-			context.TODO(), builder.Definition, metav1.CreateOptions{})
+			ctx, builder.Definition, metav1.CreateOptions{})
 	}
 
 	return builder, err
@@ -290,6 +295,12 @@ func (builder *Builder) Create() (*Builder, error) {
 
 // Update renovates the existing daemonset object with daemonset definition in builder.
 func (builder *Builder) Update() (*Builder, error) {
+	return builder.UpdateWithContext(context.Background())
+}
+
+// UpdateWithContext renovates the existing daemonset object with daemonset definition in builder using the provided
+// context.
+func (builder *Builder) UpdateWithContext(ctx context.Context) (*Builder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
@@ -298,13 +309,18 @@ func (builder *Builder) Update() (*Builder, error) {
 
 	var err error
 	builder.Object, err = builder.apiClient.Update(
-		context.TODO(), builder.Definition, metav1.UpdateOptions{})
+		ctx, builder.Definition, metav1.UpdateOptions{})
 
 	return builder, err
 }
 
 // Delete removes the daemonset.
 func (builder *Builder) Delete() error {
+	return builder.DeleteWithContext(context.Background())
+}
+
+// DeleteWithContext removes the daemonset using the provided context.
+func (builder *Builder) DeleteWithContext(ctx context.Context) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
@@ -312,14 +328,14 @@ func (builder *Builder) Delete() error {
 	glog.V(100).Infof("Deleting daemonset %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		builder.Object = nil
 
 		return nil
 	}
 
 	err := builder.apiClient.Delete(
-		context.TODO(), builder.Definition.Name, metav1.DeleteOptions{})
+		ctx, builder.Definition.Name, metav1.DeleteOptions{})
 
 	if err != nil && !k8serrors.IsNotFound(err) {
 		return err
@@ -332,6 +348,13 @@ func (builder *Builder) Delete() error {
 
 // CreateAndWaitUntilReady creates a daemonset in the cluster and waits until the daemonset is available.
 func (builder *Builder) CreateAndWaitUntilReady(timeout time.Duration) (*Builder, error) {
+	return builder.CreateAndWaitUntilReadyWithContext(context.Background(), timeout)
+}
+
+// CreateAndWaitUntilReadyWithContext creates a daemonset in the cluster and waits until the daemonset is available or
+// ctx is done.
+func (builder *Builder) CreateAndWaitUntilReadyWithContext(
+	ctx context.Context, timeout time.Duration) (*Builder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
@@ -339,7 +362,7 @@ func (builder *Builder) CreateAndWaitUntilReady(timeout time.Duration) (*Builder
 	glog.V(100).Infof("Creating daemonset %s in namespace %s and waiting for the defined period until it is ready",
 		builder.Definition.Name, builder.Definition.Namespace)
 
-	_, err := builder.Create()
+	_, err := builder.CreateWithContext(ctx)
 	if err != nil {
 		glog.V(100).Infof("Failed to create daemonset. Error is: '%s'", err.Error())
 
@@ -348,9 +371,9 @@ func (builder *Builder) CreateAndWaitUntilReady(timeout time.Duration) (*Builder
 
 	// Polls every retryInterval to determine if daemonset is available.
 	err = wait.PollUntilContextTimeout(
-		context.TODO(), retryInterval, timeout, true, func(ctx context.Context) (bool, error) {
+		ctx, retryInterval, timeout, true, func(ctx context.Context) (bool, error) {
 			builder.Object, err = builder.apiClient.Get(
-				context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+				ctx, builder.Definition.Name, metav1.GetOptions{})
 
 			if err != nil {
 				return false, nil
@@ -385,6 +408,11 @@ func (builder *Builder) CreateAndWaitUntilReady(timeout time.Duration) (*Builder
 
 // DeleteAndWait deletes a daemonset and waits until it is removed from the cluster.
 func (builder *Builder) DeleteAndWait(timeout time.Duration) error {
+	return builder.DeleteAndWaitWithContext(context.Background(), timeout)
+}
+
+// DeleteAndWaitWithContext deletes a daemonset and waits until it is removed from the cluster or ctx is done.
+func (builder *Builder) DeleteAndWaitWithContext(ctx context.Context, timeout time.Duration) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
@@ -392,15 +420,15 @@ func (builder *Builder) DeleteAndWait(timeout time.Duration) error {
 	glog.V(100).Infof("Deleting daemonset %s in namespace %s and waiting for the defined period until it is removed",
 		builder.Definition.Name, builder.Definition.Namespace)
 
-	if err := builder.Delete(); err != nil {
+	if err := builder.DeleteWithContext(ctx); err != nil {
 		return err
 	}
 
 	// Polls the daemonset every retryInterval until it is removed.
 	return wait.PollUntilContextTimeout(
-		context.TODO(), retryInterval, timeout, true, func(ctx context.Context) (bool, error) {
+		ctx, retryInterval, timeout, true, func(ctx context.Context) (bool, error) {
 			_, err := builder.apiClient.Get(
-				context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+				ctx, builder.Definition.Name, metav1.GetOptions{})
 			if k8serrors.IsNotFound(err) {
 				return true, nil
 			}
@@ -411,6 +439,11 @@ func (builder *Builder) DeleteAndWait(timeout time.Duration) error {
 
 // Exists checks whether the given daemonset exists.
 func (builder *Builder) Exists() bool {
+	return builder.ExistsWithContext(context.Background())
+}
+
+// ExistsWithContext checks whether the given daemonset exists using the provided context.
+func (builder *Builder) ExistsWithContext(ctx context.Context) bool {
 	if valid, _ := builder.validate(); !valid {
 		return false
 	}
@@ -420,13 +453,18 @@ func (builder *Builder) Exists() bool {
 
 	var err error
 	builder.Object, err = builder.apiClient.Get(
-		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+		ctx, builder.Definition.Name, metav1.GetOptions{})
 
 	return err == nil || !k8serrors.IsNotFound(err)
 }
 
 // IsReady waits for the daemonset to reach expected number of pods in Ready state.
 func (builder *Builder) IsReady(timeout time.Duration) bool {
+	return builder.IsReadyWithContext(context.Background(), timeout)
+}
+
+// IsReadyWithContext waits for the daemonset to reach expected number of pods in Ready state or for ctx to be done.
+func (builder *Builder) IsReadyWithContext(ctx context.Context, timeout time.Duration) bool {
 	if valid, _ := builder.validate(); !valid {
 		return false
 	}
@@ -436,11 +474,11 @@ func (builder *Builder) IsReady(timeout time.Duration) bool {
 
 	// Polls every retryInterval to determine if daemonset is available.
 	err := wait.PollUntilContextTimeout(
-		context.TODO(), retryInterval, timeout, true, func(ctx context.Context) (bool, error) {
+		ctx, retryInterval, timeout, true, func(ctx context.Context) (bool, error) {
 			var err error
 
 			builder.Object, err = builder.apiClient.Get(
-				context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+				ctx, builder.Definition.Name, metav1.GetOptions{})
 
 			if err != nil {
 				glog.V(100).Infof("Failed to get daemonset from cluster. Error is: '%s'", err.Error())
@@ -10,7 +10,9 @@ import (
 
 	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/common"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/waiter"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
@@ -26,6 +28,8 @@ type Builder struct {
 	Definition *appsv1.Deployment
 	// Created deployment object
 	Object *appsv1.Deployment
+	// Copy of the object the definition was last pulled, created or updated from. It is not refreshed by Exists.
+	base *appsv1.Deployment
 	// Used in functions that define or mutate deployment definition. errorMsg is processed before the deployment
 	// object is created.
 	errorMsg  string
@@ -129,7 +133,8 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
 		return nil, fmt.Errorf("deployment object %s does not exist in namespace %s", name, nsname)
 	}
 
-	builder.Definition = builder.Object
+	builder.Definition = builder.Object.DeepCopy()
+	builder.base = builder.Object.DeepCopy()
 
 	return builder, nil
 }
@@ -424,16 +429,29 @@ func (builder *Builder) WithOptions(options ...AdditionalOptions) *Builder {
 
 // Create generates a deployment in cluster and stores the created object in struct.
 func (builder *Builder) Create() (*Builder, error) {
+	return builder.CreateWithContext(context.Background())
+}
+
+// CreateWithContext generates a deployment in cluster using the provided context and stores the created object in
+// struct.
+func (builder *Builder) CreateWithContext(ctx context.Context) (*Builder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
 
 	glog.V(100).Infof("Creating deployment %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
 
+	if err := ctx.Err(); err != nil {
+		return builder, err
+	}
+
 	var err error
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		builder.Object, err = builder.apiClient.Deployments(builder.Definition.Namespace).Create(
-			context.TODO(), builder.Definition, metav1.CreateOptions{})
+			ctx, builder.Definition, metav1.CreateOptions{})
+		if err == nil {
+			builder.base = builder.Object.DeepCopy()
+		}
 	}
 
 	return builder, err
@@ -441,6 +459,12 @@ func (builder *Builder) Create() (*Builder, error) {
 
 // Update renovates the existing deployment object with the deployment definition in builder.
 func (builder *Builder) Update() (*Builder, error) {
+	return builder.UpdateWithContext(context.Background())
+}
+
+// UpdateWithContext renovates the existing deployment object with the deployment definition in builder using the
+// provided context.
+func (builder *Builder) UpdateWithContext(ctx context.Context) (*Builder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
@@ -449,13 +473,52 @@ func (builder *Builder) Update() (*Builder, error) {
 
 	var err error
 	builder.Object, err = builder.apiClient.Deployments(builder.Definition.Namespace).Update(
-		context.TODO(), builder.Definition, metav1.UpdateOptions{})
+		ctx, builder.Definition, metav1.UpdateOptions{})
+	if err == nil {
+		builder.base = builder.Object.DeepCopy()
+	}
 
 	return builder, err
 }
 
+// UpdateWithRetry updates the deployment with merge semantics. If the deployment was modified on the cluster since it
+// was pulled, created or last updated, the changes made through the builder are re-applied to the latest version and
+// the update is retried according to backoff, for example retry.DefaultRetry.
+func (builder *Builder) UpdateWithRetry(ctx context.Context, backoff wait.Backoff) (*Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	glog.V(100).Infof("Updating deployment %s in namespace %s with retry on conflict",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	deployments := builder.apiClient.Deployments(builder.Definition.Namespace)
+
+	deployment, err := common.RetryOnConflict(ctx, backoff, builder.base, builder.Definition,
+		func(ctx context.Context) (*appsv1.Deployment, error) {
+			return deployments.Get(ctx, builder.Definition.Name, metav1.GetOptions{})
+		},
+		func(ctx context.Context, deployment *appsv1.Deployment) (*appsv1.Deployment, error) {
+			return deployments.Update(ctx, deployment, metav1.UpdateOptions{})
+		})
+	if err != nil {
+		return builder, err
+	}
+
+	builder.Object = deployment
+	builder.Definition = deployment.DeepCopy()
+	builder.base = deployment.DeepCopy()
+
+	return builder, nil
+}
+
 // Delete removes a deployment.
 func (builder *Builder) Delete() error {
+	return builder.DeleteWithContext(context.Background())
+}
+
+// DeleteWithContext removes a deployment using the provided context.
+func (builder *Builder) DeleteWithContext(ctx context.Context) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
@@ -463,7 +526,7 @@ func (builder *Builder) Delete() error {
 	glog.V(100).Infof("Deleting deployment %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		glog.V(100).Infof("Deployment %s in namespace %s does not exist",
 			builder.Definition.Name, builder.Definition.Namespace)
 
@@ -473,7 +536,7 @@ func (builder *Builder) Delete() error {
 	}
 
 	err := builder.apiClient.Deployments(builder.Definition.Namespace).Delete(
-		context.TODO(), builder.Definition.Name, metav1.DeleteOptions{})
+		ctx, builder.Definition.Name, metav1.DeleteOptions{})
 
 	if err != nil {
 		return err
@@ -487,6 +550,11 @@ func (builder *Builder) Delete() error {
 // DeleteGraceful removes a deployment while waiting for specified duration(in seconds)
 // the object should be deleted.
 func (builder *Builder) DeleteGraceful(gracePeriod *int64) error {
+	return builder.DeleteGracefulWithContext(context.Background(), gracePeriod)
+}
+
+// DeleteGracefulWithContext removes a deployment with the given grace period(in seconds) using the provided context.
+func (builder *Builder) DeleteGracefulWithContext(ctx context.Context, gracePeriod *int64) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
@@ -505,7 +573,7 @@ func (builder *Builder) DeleteGraceful(gracePeriod *int64) error {
 	glog.V(100).Infof("Deleting deployment %s in namespace %s with %v seconds grace period",
 		builder.Definition.Name, builder.Definition.Namespace, *gracePeriod)
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		glog.V(100).Infof("Deployment %s in namespace %s does not exist",
 			builder.Definition.Name, builder.Definition.Namespace)
 
@@ -515,7 +583,7 @@ func (builder *Builder) DeleteGraceful(gracePeriod *int64) error {
 	}
 
 	err := builder.apiClient.Deployments(builder.Definition.Namespace).Delete(
-		context.TODO(), builder.Definition.Name, metav1.DeleteOptions{GracePeriodSeconds: gracePeriod})
+		ctx, builder.Definition.Name, metav1.DeleteOptions{GracePeriodSeconds: gracePeriod})
 
 	if err != nil {
 		return err
@@ -528,6 +596,13 @@ func (builder *Builder) DeleteGraceful(gracePeriod *int64) error {
 
 // CreateAndWaitUntilReady creates a deployment in the cluster and waits until the deployment is available.
 func (builder *Builder) CreateAndWaitUntilReady(timeout time.Duration) (*Builder, error) {
+	return builder.CreateAndWaitUntilReadyWithContext(context.Background(), timeout)
+}
+
+// CreateAndWaitUntilReadyWithContext creates a deployment in the cluster and waits until the deployment is available,
+// the timeout expires or ctx is done.
+func (builder *Builder) CreateAndWaitUntilReadyWithContext(
+	ctx context.Context, timeout time.Duration) (*Builder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
@@ -535,13 +610,13 @@ func (builder *Builder) CreateAndWaitUntilReady(timeout time.Duration) (*Builder
 	glog.V(100).Infof("Creating deployment %s in namespace %s and waiting for the defined period until it is ready",
 		builder.Definition.Name, builder.Definition.Namespace)
 
-	if _, err := builder.Create(); err != nil {
+	if _, err := builder.CreateWithContext(ctx); err != nil {
 		glog.V(100).Infof("Failed to create deployment. Error is: '%s'", err.Error())
 
 		return nil, err
 	}
 
-	if builder.IsReady(timeout) {
+	if builder.IsReadyWithContext(ctx, timeout) {
 		return builder, nil
 	}
 
@@ -552,6 +627,11 @@ func (builder *Builder) CreateAndWaitUntilReady(timeout time.Duration) (*Builder
 
 // IsReady periodically checks if deployment is in ready status.
 func (builder *Builder) IsReady(timeout time.Duration) bool {
+	return builder.IsReadyWithContext(context.Background(), timeout)
+}
+
+// IsReadyWithContext periodically checks if deployment is in ready status until the timeout expires or ctx is done.
+func (builder *Builder) IsReadyWithContext(ctx context.Context, timeout time.Duration) bool {
 	if valid, _ := builder.validate(); !valid {
 		return false
 	}
@@ -559,15 +639,15 @@ func (builder *Builder) IsReady(timeout time.Duration) bool {
 	glog.V(100).Infof("Running periodic check until deployment %s in namespace %s is ready",
 		builder.Definition.Name, builder.Definition.Namespace)
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		return false
 	}
 
 	err := wait.PollUntilContextTimeout(
-		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+		ctx, time.Second, timeout, true, func(ctx context.Context) (bool, error) {
 			var err error
 			builder.Object, err = builder.apiClient.Deployments(builder.Definition.Namespace).Get(
-				context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+				ctx, builder.Definition.Name, metav1.GetOptions{})
 
 			if err != nil {
 				glog.V(100).Infof("Failed to get deployment from cluster. Error is: '%s'", err.Error())
@@ -587,6 +667,11 @@ func (builder *Builder) IsReady(timeout time.Duration) bool {
 
 // DeleteAndWait deletes a deployment and waits until it is removed from the cluster.
 func (builder *Builder) DeleteAndWait(timeout time.Duration) error {
+	return builder.DeleteAndWaitWithContext(context.Background(), timeout)
+}
+
+// DeleteAndWaitWithContext deletes a deployment and waits until it is removed from the cluster or ctx is done.
+func (builder *Builder) DeleteAndWaitWithContext(ctx context.Context, timeout time.Duration) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
@@ -594,15 +679,15 @@ func (builder *Builder) DeleteAndWait(timeout time.Duration) error {
 	glog.V(100).Infof("Deleting deployment %s in namespace %s and waiting for the defined period until it is removed",
 		builder.Definition.Name, builder.Definition.Namespace)
 
-	if err := builder.Delete(); err != nil {
+	if err := builder.DeleteWithContext(ctx); err != nil {
 		return err
 	}
 
 	// Polls the deployment every second until it is removed.
 	return wait.PollUntilContextTimeout(
-		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+		ctx, time.Second, timeout, true, func(ctx context.Context) (bool, error) {
 			_, err := builder.apiClient.Deployments(builder.Definition.Namespace).Get(
-				context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+				ctx, builder.Definition.Name, metav1.GetOptions{})
 			if k8serrors.IsNotFound(err) {
 				return true, nil
 			}
@@ -613,6 +698,11 @@ func (builder *Builder) DeleteAndWait(timeout time.Duration) error {
 
 // Exists checks whether the given deployment exists.
 func (builder *Builder) Exists() bool {
+	return builder.ExistsWithContext(context.Background())
+}
+
+// ExistsWithContext checks whether the given deployment exists using the provided context.
+func (builder *Builder) ExistsWithContext(ctx context.Context) bool {
 	if valid, _ := builder.validate(); !valid {
 		return false
 	}
@@ -622,7 +712,7 @@ func (builder *Builder) Exists() bool {
 
 	var err error
 	builder.Object, err = builder.apiClient.Deployments(builder.Definition.Namespace).Get(
-		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+		ctx, builder.Definition.Name, metav1.GetOptions{})
 
 	return err == nil || !k8serrors.IsNotFound(err)
 }
@@ -630,6 +720,13 @@ func (builder *Builder) Exists() bool {
 // WaitUntilCondition waits for the duration of the defined timeout or until the
 // deployment gets to a specific condition.
 func (builder *Builder) WaitUntilCondition(condition appsv1.DeploymentConditionType, timeout time.Duration) error {
+	return builder.WaitUntilConditionWithContext(context.Background(), condition, timeout)
+}
+
+// WaitUntilConditionWithContext waits until the deployment gets to a specific condition, the timeout expires or ctx
+// is done.
+func (builder *Builder) WaitUntilConditionWithContext(
+	ctx context.Context, condition appsv1.DeploymentConditionType, timeout time.Duration) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
@@ -637,19 +734,18 @@ func (builder *Builder) WaitUntilCondition(condition appsv1.DeploymentConditionT
 	glog.V(100).Infof("Waiting for the defined period until deployment %s in namespace %s has condition %v",
 		builder.Definition.Name, builder.Definition.Namespace, condition)
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		return fmt.Errorf("cannot wait for deployment condition because it does not exist")
 	}
 
-	return wait.PollUntilContextTimeout(
-		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
-			updateDeployment, err := builder.apiClient.Deployments(builder.Definition.Namespace).Get(
-				context.TODO(), builder.Definition.Name, metav1.GetOptions{})
-			if err != nil {
-				return false, nil
-			}
-
-			for _, cond := range updateDeployment.Status.Conditions {
+	deployment, err := waiter.For(ctx,
+		waiter.NewTypedSource(builder.apiClient.Deployments(builder.Definition.Namespace), builder.Definition.Name),
+		waiter.Options{
+			Description: fmt.Sprintf("deployment %s in namespace %s to have condition %s",
+				builder.Definition.Name, builder.Definition.Namespace, condition),
+			Timeout: timeout,
+		}, func(deployment *appsv1.Deployment) (bool, error) {
+			for _, cond := range deployment.Status.Conditions {
 				if cond.Type == condition && cond.Status == corev1.ConditionTrue {
 					return true, nil
 				}
@@ -657,10 +753,22 @@ func (builder *Builder) WaitUntilCondition(condition appsv1.DeploymentConditionT
 
 			return false, nil
 		})
+	if err != nil {
+		return err
+	}
+
+	builder.Object = deployment
+
+	return nil
 }
 
 // WaitUntilDeleted waits for the duration of the defined timeout or until the deployment is deleted.
 func (builder *Builder) WaitUntilDeleted(timeout time.Duration) error {
+	return builder.WaitUntilDeletedWithContext(context.Background(), timeout)
+}
+
+// WaitUntilDeletedWithContext waits until the deployment is deleted, the timeout expires or ctx is done.
+func (builder *Builder) WaitUntilDeletedWithContext(ctx context.Context, timeout time.Duration) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
@@ -669,9 +777,9 @@ func (builder *Builder) WaitUntilDeleted(timeout time.Duration) error {
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	return wait.PollUntilContextTimeout(
-		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+		ctx, time.Second, timeout, true, func(ctx context.Context) (bool, error) {
 			_, err := builder.apiClient.Deployments(builder.Definition.Namespace).Get(
-				context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+				ctx, builder.Definition.Name, metav1.GetOptions{})
 
 			if k8serrors.IsNotFound(err) {
 				return true, nil
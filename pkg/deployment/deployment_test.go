@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/waiter"
 	"github.com/stretchr/testify/assert"
 	multus "gopkg.in/k8snetworkplumbingwg/multus-cni.v4/pkg/types"
 	appsv1 "k8s.io/api/apps/v1"
@@ -14,6 +15,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/util/retry"
 )
 
 //nolint:funlen
@@ -619,6 +621,47 @@ func TestUpdate(t *testing.T) {
 	}
 }
 
+func TestUpdateWithRetry(t *testing.T) {
+	testCases := []struct {
+		concurrentChange bool
+	}{
+		{
+			concurrentChange: true,
+		},
+		{
+			concurrentChange: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects: []runtime.Object{buildValidTestBuilder().Definition},
+		})
+
+		testBuilder, err := Pull(testSettings, "test-name", "test-namespace")
+		assert.Nil(t, err)
+
+		if testCase.concurrentChange {
+			concurrentBuilder, err := Pull(testSettings, "test-name", "test-namespace")
+			assert.Nil(t, err)
+
+			_, err = concurrentBuilder.WithServiceAccountName("test-service-account").Update()
+			assert.Nil(t, err)
+		}
+
+		_, err = testBuilder.WithReplicas(3).UpdateWithRetry(t.Context(), retry.DefaultRetry)
+		assert.Nil(t, err)
+
+		deployment, err := testSettings.Deployments("test-namespace").Get(t.Context(), "test-name", metav1.GetOptions{})
+		assert.Nil(t, err)
+		assert.Equal(t, int32(3), *deployment.Spec.Replicas)
+
+		if testCase.concurrentChange {
+			assert.Equal(t, "test-service-account", deployment.Spec.Template.Spec.ServiceAccountName)
+		}
+	}
+}
+
 func TestDelete(t *testing.T) {
 	generateTestDeployment := func() *appsv1.Deployment {
 		return &appsv1.Deployment{
@@ -791,6 +834,104 @@ func TestWaitUntilCondition(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestWaitUntilConditionSimulated(t *testing.T) {
+	testCases := []struct {
+		availableAfter int
+		expectedError  error
+	}{
+		{
+			availableAfter: 3,
+			expectedError:  nil,
+		},
+		{
+			availableAfter: 10,
+			expectedError:  context.DeadlineExceeded,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects: []runtime.Object{&appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-name",
+					Namespace: "test-namespace",
+				},
+			}},
+			Simulators: []clients.ControllerSimulator{{
+				Object:   &appsv1.Deployment{},
+				Triggers: []clients.SimulatorTrigger{clients.SimulatorTriggerRead},
+				Reconcile: clients.SimulateAfter(testCase.availableAfter, func(object runtime.Object) {
+					object.(*appsv1.Deployment).Status.Conditions = []appsv1.DeploymentCondition{{
+						Type:   appsv1.DeploymentAvailable,
+						Status: corev1.ConditionTrue,
+					}}
+				}),
+			}},
+		})
+
+		testBuilder := NewBuilder(testSettings, "test-name", "test-namespace", map[string]string{
+			"test-key": "test-value",
+		}, corev1.Container{
+			Name: "test-container",
+		})
+
+		err := testBuilder.WaitUntilCondition(appsv1.DeploymentAvailable, 2*time.Second)
+		assert.ErrorIs(t, err, testCase.expectedError)
+	}
+}
+
+func TestWaitUntilConditionWatched(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: []runtime.Object{&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-name",
+				Namespace: "test-namespace",
+			},
+		}},
+	})
+
+	testBuilder, err := Pull(testSettings, "test-name", "test-namespace")
+	assert.Nil(t, err)
+
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+
+		deployment := testBuilder.Object.DeepCopy()
+		deployment.Status.Conditions = []appsv1.DeploymentCondition{{
+			Type:   appsv1.DeploymentAvailable,
+			Status: corev1.ConditionTrue,
+		}}
+
+		_, err := testSettings.AppsV1Interface.Deployments("test-namespace").UpdateStatus(
+			t.Context(), deployment, metav1.UpdateOptions{})
+		assert.Nil(t, err)
+	}()
+
+	start := time.Now()
+
+	err = testBuilder.WaitUntilCondition(appsv1.DeploymentAvailable, 5*time.Second)
+	assert.Nil(t, err)
+	assert.Less(t, time.Since(start), waiter.DefaultPollInterval)
+	assert.Equal(t, appsv1.DeploymentAvailable, testBuilder.Object.Status.Conditions[0].Type)
+}
+
+func TestWaitUntilConditionWithContext(t *testing.T) {
+	testDeployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-name",
+			Namespace: "test-namespace",
+		},
+	}
+
+	testBuilder := buildTestBuilderWithFakeObjects([]runtime.Object{testDeployment})
+
+	ctx, cancel := context.WithTimeout(t.Context(), time.Second)
+	defer cancel()
+
+	err := testBuilder.WaitUntilConditionWithContext(ctx, appsv1.DeploymentAvailable, time.Minute)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
 func TestValidate(t *testing.T) {
 	testCases := []struct {
 		builderNil    bool
@@ -11,6 +11,12 @@ import (
 
 // List returns deployment inventory in the given namespace.
 func List(apiClient *clients.Settings, nsname string, options ...metav1.ListOptions) ([]*Builder, error) {
+	return ListWithContext(context.Background(), apiClient, nsname, options...)
+}
+
+// ListWithContext returns deployment inventory in the given namespace using the provided context.
+func ListWithContext(
+	ctx context.Context, apiClient *clients.Settings, nsname string, options ...metav1.ListOptions) ([]*Builder, error) {
 	if nsname == "" {
 		glog.V(100).Infof("deployment 'nsname' parameter can not be empty")
 
@@ -33,7 +39,7 @@ func List(apiClient *clients.Settings, nsname string, options ...metav1.ListOpti
 
 	glog.V(100).Infof(logMessage)
 
-	deploymentList, err := apiClient.Deployments(nsname).List(context.TODO(), passedOptions)
+	deploymentList, err := apiClient.Deployments(nsname).List(ctx, passedOptions)
 
 	if err != nil {
 		glog.V(100).Infof("Failed to list deployments in the namespace %s due to %s", nsname, err.Error())
@@ -49,6 +55,7 @@ func List(apiClient *clients.Settings, nsname string, options ...metav1.ListOpti
 			apiClient:  apiClient.AppsV1Interface,
 			Object:     &copiedDeployment,
 			Definition: &copiedDeployment,
+			base:       copiedDeployment.DeepCopy(),
 		}
 
 		deploymentObjects = append(deploymentObjects, deploymentBuilder)
@@ -59,6 +66,12 @@ func List(apiClient *clients.Settings, nsname string, options ...metav1.ListOpti
 
 // ListInAllNamespaces returns deployment inventory in the all the namespaces.
 func ListInAllNamespaces(apiClient *clients.Settings, options ...metav1.ListOptions) ([]*Builder, error) {
+	return ListInAllNamespacesWithContext(context.Background(), apiClient, options...)
+}
+
+// ListInAllNamespacesWithContext returns deployment inventory in the all the namespaces using the provided context.
+func ListInAllNamespacesWithContext(
+	ctx context.Context, apiClient *clients.Settings, options ...metav1.ListOptions) ([]*Builder, error) {
 	passedOptions := metav1.ListOptions{}
 	logMessage := "Listing deployments in all namespaces"
 
@@ -75,7 +88,7 @@ func ListInAllNamespaces(apiClient *clients.Settings, options ...metav1.ListOpti
 
 	glog.V(100).Infof(logMessage)
 
-	deploymentList, err := apiClient.Deployments("").List(context.TODO(), passedOptions)
+	deploymentList, err := apiClient.Deployments("").List(ctx, passedOptions)
 
 	if err != nil {
 		glog.V(100).Infof("Failed to list deployments in all namespaces due to %s", err.Error())
@@ -91,6 +104,7 @@ func ListInAllNamespaces(apiClient *clients.Settings, options ...metav1.ListOpti
 			apiClient:  apiClient.AppsV1Interface,
 			Object:     &copiedDeployment,
 			Definition: &copiedDeployment,
+			base:       copiedDeployment.DeepCopy(),
 		}
 
 		deploymentObjects = append(deploymentObjects, deploymentBuilder)
@@ -0,0 +1,85 @@
+package diagnostics
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/golang/glog"
+)
+
+// archiveDirectory writes the regular files of directory to a gzipped tarball at archivePath. The paths in the
+// archive are prefixed with the base name of directory so that it extracts to a single directory.
+func archiveDirectory(directory, archivePath string) error {
+	glog.V(100).Infof("Archiving diagnostics directory %s to %s", directory, archivePath)
+
+	archiveFile, err := os.Create(archivePath)
+	if err != nil {
+		return fmt.Errorf("failed to create diagnostics archive %s: %w", archivePath, err)
+	}
+
+	gzipWriter := gzip.NewWriter(archiveFile)
+	tarWriter := tar.NewWriter(gzipWriter)
+
+	err = filepath.WalkDir(directory, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil || !entry.Type().IsRegular() {
+			return err
+		}
+
+		return addArchiveFile(tarWriter, directory, path)
+	})
+
+	// All the writers must be closed to flush the archive, even if one of them fails.
+	for _, closer := range []io.Closer{tarWriter, gzipWriter, archiveFile} {
+		if closeErr := closer.Close(); err == nil {
+			err = closeErr
+		}
+	}
+
+	if err != nil {
+		_ = os.Remove(archivePath)
+
+		return fmt.Errorf("failed to archive diagnostics directory %s: %w", directory, err)
+	}
+
+	return nil
+}
+
+// addArchiveFile adds the file at path, from the tree rooted at directory, to tarWriter.
+func addArchiveFile(tarWriter *tar.Writer, directory, path string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+
+	relativePath, err := filepath.Rel(directory, path)
+	if err != nil {
+		return err
+	}
+
+	header, err := tar.FileInfoHeader(info, "")
+	if err != nil {
+		return err
+	}
+
+	header.Name = filepath.ToSlash(filepath.Join(filepath.Base(directory), relativePath))
+
+	if err = tarWriter.WriteHeader(header); err != nil {
+		return err
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+
+	defer file.Close()
+
+	_, err = io.Copy(tarWriter, file)
+
+	return err
+}
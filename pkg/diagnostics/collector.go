@@ -0,0 +1,824 @@
+package diagnostics
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/events"
+	"github.com/openshift-kni/eco-goinfra/pkg/nodes"
+	"github.com/openshift-kni/eco-goinfra/pkg/pod"
+	"github.com/openshift-kni/eco-goinfra/pkg/reportxml"
+	configv1 "github.com/openshift/api/config/v1"
+	mcv1 "github.com/openshift/api/machineconfiguration/v1"
+	"gopkg.in/yaml.v2"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+const (
+	// DefaultMaxLogBytes is the default size limit of a single container log.
+	DefaultMaxLogBytes int64 = 10 * 1024 * 1024
+	// DefaultMaxTotalBytes is the default size limit of all the collected files.
+	DefaultMaxTotalBytes int64 = 500 * 1024 * 1024
+	// DefaultParallelism is the default number of resources collected at the same time.
+	DefaultParallelism = 8
+	// ReportProperty is the name of the reportxml property holding the path of the collected diagnostics.
+	ReportProperty = "diagnostics"
+
+	errorsFileName = "collection-errors.txt"
+)
+
+// namespacedKinds are the kinds whose objects are collected as YAML from each namespace of a Collector, with the
+// directory they are written to. Pods are collected separately along with their logs.
+var namespacedKinds = []struct {
+	directory string
+	newList   func() runtimeclient.ObjectList
+}{
+	{directory: "deployments", newList: func() runtimeclient.ObjectList { return &appsv1.DeploymentList{} }},
+	{directory: "replicasets", newList: func() runtimeclient.ObjectList { return &appsv1.ReplicaSetList{} }},
+	{directory: "statefulsets", newList: func() runtimeclient.ObjectList { return &appsv1.StatefulSetList{} }},
+	{directory: "daemonsets", newList: func() runtimeclient.ObjectList { return &appsv1.DaemonSetList{} }},
+	{directory: "jobs", newList: func() runtimeclient.ObjectList { return &batchv1.JobList{} }},
+	{directory: "services", newList: func() runtimeclient.ObjectList { return &corev1.ServiceList{} }},
+	{directory: "configmaps", newList: func() runtimeclient.ObjectList { return &corev1.ConfigMapList{} }},
+	{directory: "persistentvolumeclaims", newList: func() runtimeclient.ObjectList {
+		return &corev1.PersistentVolumeClaimList{}
+	}},
+	{directory: "serviceaccounts", newList: func() runtimeclient.ObjectList {
+		return &corev1.ServiceAccountList{}
+	}},
+}
+
+// Collector gathers the state of a cluster relevant to a failed test: the YAML of objects, namespaced workloads and
+// their logs, events, node conditions, ClusterOperator and MachineConfigPool status. The output is a directory tree,
+// optionally archived as a tarball.
+type Collector struct {
+	apiClient     *clients.Settings
+	outputDir     string
+	namespaces    []string
+	objects       []runtimeclient.Object
+	clusterState  bool
+	archive       bool
+	maxLogBytes   int64
+	maxTotalBytes int64
+	parallelism   int
+	errorMsg      string
+}
+
+// Result describes the diagnostics written by a Collector.
+type Result struct {
+	// Path is the output directory, or the tarball if the collector archives its output.
+	Path string
+	// Files are the paths of the collected files, relative to the output directory.
+	Files []string
+	// Bytes is the total size of the collected files.
+	Bytes int64
+	// Skipped are the files not written because of the total size limit.
+	Skipped []string
+	// Errors are the resources that could not be collected. They do not prevent the rest of the collection.
+	Errors []error
+}
+
+// AttachToReport attaches the path of the diagnostics to the running test case as the ReportProperty property of the
+// reportxml report. It must be called from a node of the failed spec, such as JustAfterEach or AfterEach.
+func (result *Result) AttachToReport() {
+	if result == nil || result.Path == "" {
+		return
+	}
+
+	reportxml.AddProperty(ReportProperty, result.Path)
+}
+
+// NewCollector creates a Collector writing to outputDir. By default it only collects the cluster state: nodes,
+// ClusterOperators and MachineConfigPools.
+func NewCollector(apiClient *clients.Settings, outputDir string) *Collector {
+	glog.V(100).Infof("Initializing new diagnostics collector writing to %s", outputDir)
+
+	collector := &Collector{
+		apiClient:     apiClient,
+		outputDir:     outputDir,
+		clusterState:  true,
+		maxLogBytes:   DefaultMaxLogBytes,
+		maxTotalBytes: DefaultMaxTotalBytes,
+		parallelism:   DefaultParallelism,
+	}
+
+	if apiClient == nil {
+		glog.V(100).Info("The apiClient of the diagnostics collector is nil")
+
+		return nil
+	}
+
+	if outputDir == "" {
+		glog.V(100).Info("The outputDir of the diagnostics collector is empty")
+
+		collector.errorMsg = "diagnostics collector 'outputDir' cannot be empty"
+
+		return collector
+	}
+
+	for _, attacher := range []clients.SchemeAttacher{configv1.Install, mcv1.Install} {
+		if err := apiClient.AttachScheme(attacher); err != nil {
+			glog.V(100).Info("Failed to add config and machineconfiguration v1 schemes to client schemes")
+
+			collector.errorMsg = fmt.Sprintf("failed to add schemes to the diagnostics collector client: %v", err)
+
+			return collector
+		}
+	}
+
+	return collector
+}
+
+// WithNamespaces adds namespaces whose pods, pod logs and events are collected, as well as the YAML of the objects of
+// the kinds in namespacedKinds. Secrets are not collected so that diagnostics can be shared safely.
+func (collector *Collector) WithNamespaces(namespaces ...string) *Collector {
+	if valid, _ := collector.validate(); !valid {
+		return collector
+	}
+
+	glog.V(100).Infof("Adding namespaces %v to the diagnostics collector", namespaces)
+
+	if slices.Contains(namespaces, "") {
+		glog.V(100).Info("The namespaces of the diagnostics collector contain an empty namespace")
+
+		collector.errorMsg = "diagnostics collector 'namespaces' cannot contain an empty namespace"
+
+		return collector
+	}
+
+	collector.namespaces = append(collector.namespaces, namespaces...)
+
+	return collector
+}
+
+// WithObjects adds objects whose current YAML is collected, typically the Definition of the builders used by the
+// test. Each object is fetched again from the cluster when collected.
+func (collector *Collector) WithObjects(objects ...runtimeclient.Object) *Collector {
+	if valid, _ := collector.validate(); !valid {
+		return collector
+	}
+
+	glog.V(100).Infof("Adding %d objects to the diagnostics collector", len(objects))
+
+	for _, object := range objects {
+		if object == nil || object.GetName() == "" {
+			glog.V(100).Info("The objects of the diagnostics collector contain an object without a name")
+
+			collector.errorMsg = "diagnostics collector 'objects' cannot contain a nil or unnamed object"
+
+			return collector
+		}
+	}
+
+	collector.objects = append(collector.objects, objects...)
+
+	return collector
+}
+
+// WithBuilders adds the objects of builders whose current YAML is collected, typically the builders used by the test.
+// Each builder must be a pointer to a builder of this module, which holds its object in its Definition field. The
+// object is fetched again from the cluster when collected.
+func (collector *Collector) WithBuilders(builders ...any) *Collector {
+	if valid, _ := collector.validate(); !valid {
+		return collector
+	}
+
+	glog.V(100).Infof("Adding %d builders to the diagnostics collector", len(builders))
+
+	var objects []runtimeclient.Object
+
+	for _, builder := range builders {
+		object, err := builderDefinition(builder)
+		if err != nil {
+			glog.V(100).Infof("The builders of the diagnostics collector contain an invalid builder: %v", err)
+
+			collector.errorMsg = fmt.Sprintf("diagnostics collector 'builders' contain an invalid builder: %v", err)
+
+			return collector
+		}
+
+		objects = append(objects, object)
+	}
+
+	return collector.WithObjects(objects...)
+}
+
+// WithClusterState sets whether nodes, ClusterOperators and MachineConfigPools are collected, which is the default.
+func (collector *Collector) WithClusterState(enabled bool) *Collector {
+	if valid, _ := collector.validate(); !valid {
+		return collector
+	}
+
+	glog.V(100).Infof("Setting the diagnostics collector cluster state collection to %v", enabled)
+
+	collector.clusterState = enabled
+
+	return collector
+}
+
+// WithArchive makes the collector write a gzipped tarball next to the output directory, outputDir.tar.gz, instead of
+// the directory tree. The output directory itself is left untouched.
+func (collector *Collector) WithArchive() *Collector {
+	if valid, _ := collector.validate(); !valid {
+		return collector
+	}
+
+	glog.V(100).Info("Setting the diagnostics collector to archive its output")
+
+	collector.archive = true
+
+	return collector
+}
+
+// WithMaxLogBytes sets the size limit of a single container log. Longer logs are truncated.
+func (collector *Collector) WithMaxLogBytes(maxLogBytes int64) *Collector {
+	if valid, _ := collector.validate(); !valid {
+		return collector
+	}
+
+	glog.V(100).Infof("Setting the diagnostics collector log size limit to %d bytes", maxLogBytes)
+
+	if maxLogBytes <= 0 {
+		collector.errorMsg = "diagnostics collector 'maxLogBytes' must be positive"
+
+		return collector
+	}
+
+	collector.maxLogBytes = maxLogBytes
+
+	return collector
+}
+
+// WithMaxTotalBytes sets the size limit of all the collected files. Files that would exceed it are skipped.
+func (collector *Collector) WithMaxTotalBytes(maxTotalBytes int64) *Collector {
+	if valid, _ := collector.validate(); !valid {
+		return collector
+	}
+
+	glog.V(100).Infof("Setting the diagnostics collector total size limit to %d bytes", maxTotalBytes)
+
+	if maxTotalBytes <= 0 {
+		collector.errorMsg = "diagnostics collector 'maxTotalBytes' must be positive"
+
+		return collector
+	}
+
+	collector.maxTotalBytes = maxTotalBytes
+
+	return collector
+}
+
+// WithParallelism sets the number of resources collected at the same time.
+func (collector *Collector) WithParallelism(parallelism int) *Collector {
+	if valid, _ := collector.validate(); !valid {
+		return collector
+	}
+
+	glog.V(100).Infof("Setting the diagnostics collector parallelism to %d", parallelism)
+
+	if parallelism <= 0 {
+		collector.errorMsg = "diagnostics collector 'parallelism' must be positive"
+
+		return collector
+	}
+
+	collector.parallelism = parallelism
+
+	return collector
+}
+
+// Collect gathers the diagnostics. See CollectWithContext.
+func (collector *Collector) Collect() (*Result, error) {
+	return collector.CollectWithContext(context.Background())
+}
+
+// CollectWithContext gathers the diagnostics until done or ctx is done. Resources that cannot be collected are
+// reported in the Errors of the result and in the collection-errors.txt file rather than failing the collection,
+// since diagnostics are typically gathered from a cluster in a bad state. An error is only returned when the output
+// cannot be written.
+func (collector *Collector) CollectWithContext(ctx context.Context) (*Result, error) {
+	if valid, err := collector.validate(); !valid {
+		return nil, err
+	}
+
+	glog.V(100).Infof("Collecting diagnostics of namespaces %v and %d objects to %s",
+		collector.namespaces, len(collector.objects), collector.outputDir)
+
+	directory, cleanup, err := collector.createDirectory()
+	if err != nil {
+		return nil, err
+	}
+
+	defer cleanup()
+
+	run := &collection{collector: collector, directory: directory, result: &Result{Path: directory}}
+
+	var tasks []collectionTask
+
+	for _, namespace := range collector.namespaces {
+		tasks = append(tasks, run.namespaceTasks(namespace)...)
+	}
+
+	for _, object := range collector.objects {
+		tasks = append(tasks, run.objectTask(object))
+	}
+
+	if collector.clusterState {
+		tasks = append(tasks, run.collectNodes, run.collectClusterOperators, run.collectMachineConfigPools)
+	}
+
+	// Listing the pods produces the tasks collecting their logs, which are then run in a second round.
+	run.runTasks(ctx, tasks)
+	run.runTasks(ctx, run.logTasks)
+
+	if err := run.writeErrors(); err != nil {
+		return nil, err
+	}
+
+	slices.Sort(run.result.Files)
+	slices.Sort(run.result.Skipped)
+
+	if collector.archive {
+		archivePath := collector.outputDir + ".tar.gz"
+
+		if err := archiveDirectory(directory, archivePath); err != nil {
+			return nil, err
+		}
+
+		run.result.Path = archivePath
+	}
+
+	return run.result, nil
+}
+
+// createDirectory creates the directory the diagnostics are written to and returns it with the function cleaning it
+// up. It is the output directory unless the collector archives its output. Then the diagnostics are written to a
+// temporary directory named like the output directory, which is removed once archived, so that the existing content
+// of the output directory is neither archived nor removed.
+func (collector *Collector) createDirectory() (string, func(), error) {
+	if !collector.archive {
+		if err := os.MkdirAll(collector.outputDir, 0o755); err != nil {
+			return "", nil, fmt.Errorf("failed to create diagnostics directory %s: %w", collector.outputDir, err)
+		}
+
+		return collector.outputDir, func() {}, nil
+	}
+
+	parentDir := filepath.Dir(collector.outputDir)
+
+	if err := os.MkdirAll(parentDir, 0o755); err != nil {
+		return "", nil, fmt.Errorf("failed to create diagnostics directory %s: %w", parentDir, err)
+	}
+
+	tempDir, err := os.MkdirTemp(parentDir, "."+filepath.Base(collector.outputDir)+"-")
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to create temporary diagnostics directory in %s: %w", parentDir, err)
+	}
+
+	directory := filepath.Join(tempDir, filepath.Base(collector.outputDir))
+
+	if err := os.Mkdir(directory, 0o755); err != nil {
+		_ = os.RemoveAll(tempDir)
+
+		return "", nil, fmt.Errorf("failed to create diagnostics directory %s: %w", directory, err)
+	}
+
+	return directory, func() {
+		if err := os.RemoveAll(tempDir); err != nil {
+			glog.V(100).Infof("Failed to remove temporary diagnostics directory %s: %v", tempDir, err)
+		}
+	}, nil
+}
+
+// validate checks that the collector is valid.
+func (collector *Collector) validate() (bool, error) {
+	if collector == nil {
+		glog.V(100).Info("The diagnostics collector is uninitialized")
+
+		return false, fmt.Errorf("error: received nil diagnostics collector")
+	}
+
+	if collector.apiClient == nil {
+		glog.V(100).Info("The diagnostics collector apiClient is nil")
+
+		return false, fmt.Errorf("diagnostics collector cannot have nil apiClient")
+	}
+
+	if collector.errorMsg != "" {
+		glog.V(100).Infof("The diagnostics collector has error message: %s", collector.errorMsg)
+
+		return false, fmt.Errorf("%s", collector.errorMsg)
+	}
+
+	return true, nil
+}
+
+// collectionTask collects a single resource.
+type collectionTask func(ctx context.Context)
+
+// collection is the state of a single run of a Collector, shared by its concurrent tasks.
+type collection struct {
+	collector *Collector
+	directory string
+	mutex     sync.Mutex
+	result    *Result
+	logTasks  []collectionTask
+}
+
+// runTasks runs tasks with the parallelism of the collector and returns once they are all done.
+func (run *collection) runTasks(ctx context.Context, tasks []collectionTask) {
+	var waitGroup sync.WaitGroup
+
+	semaphore := make(chan struct{}, run.collector.parallelism)
+
+	for _, task := range tasks {
+		waitGroup.Add(1)
+
+		semaphore <- struct{}{}
+
+		go func() {
+			defer waitGroup.Done()
+			defer func() { <-semaphore }()
+
+			if ctx.Err() != nil {
+				run.addError(fmt.Errorf("diagnostics collection interrupted: %w", ctx.Err()))
+
+				return
+			}
+
+			task(ctx)
+		}()
+	}
+
+	waitGroup.Wait()
+}
+
+// namespaceTasks returns the tasks collecting the pods, events and objects of namespace.
+func (run *collection) namespaceTasks(namespace string) []collectionTask {
+	collectPods := func(ctx context.Context) {
+		pods, err := pod.ListWithContext(ctx, run.collector.apiClient, namespace)
+		if err != nil {
+			run.addError(fmt.Errorf("failed to list pods in namespace %s: %w", namespace, err))
+
+			return
+		}
+
+		for _, podBuilder := range pods {
+			run.writeYAML(filepath.Join("namespaces", namespace, "pods", podBuilder.Object.Name+".yaml"),
+				podBuilder.Object)
+
+			for _, task := range run.podLogTasks(podBuilder) {
+				run.mutex.Lock()
+				run.logTasks = append(run.logTasks, task)
+				run.mutex.Unlock()
+			}
+		}
+	}
+
+	collectEvents := func(ctx context.Context) {
+		eventList, err := events.ListWithContext(ctx, run.collector.apiClient, namespace)
+		if err != nil {
+			run.addError(fmt.Errorf("failed to list events in namespace %s: %w", namespace, err))
+
+			return
+		}
+
+		run.write(filepath.Join("namespaces", namespace, "events.txt"), []byte(formatEvents(eventList)))
+	}
+
+	tasks := []collectionTask{collectPods, collectEvents}
+
+	for _, kind := range namespacedKinds {
+		tasks = append(tasks, run.namespacedKindTask(namespace, kind.directory, kind.newList))
+	}
+
+	return tasks
+}
+
+// namespacedKindTask returns the task collecting the YAML of every object of a kind in namespace to directory, given
+// the list type of the kind.
+func (run *collection) namespacedKindTask(
+	namespace, directory string, newList func() runtimeclient.ObjectList) collectionTask {
+	return func(ctx context.Context) {
+		objectList := newList()
+
+		err := run.collector.apiClient.Client.List(ctx, objectList, runtimeclient.InNamespace(namespace))
+		if err != nil {
+			run.addError(fmt.Errorf("failed to list %s in namespace %s: %w", directory, namespace, err))
+
+			return
+		}
+
+		items, err := meta.ExtractList(objectList)
+		if err != nil {
+			run.addError(fmt.Errorf("failed to read %s in namespace %s: %w", directory, namespace, err))
+
+			return
+		}
+
+		for _, item := range items {
+			object, ok := item.(runtimeclient.Object)
+			if !ok {
+				continue
+			}
+
+			run.writeYAML(filepath.Join("namespaces", namespace, directory, object.GetName()+".yaml"), object)
+		}
+	}
+}
+
+// podLogTasks returns the tasks collecting the logs of every container of podBuilder, including the logs of the
+// previous instance of restarted containers.
+func (run *collection) podLogTasks(podBuilder *pod.Builder) []collectionTask {
+	restarted := map[string]bool{}
+
+	for _, status := range slices.Concat(
+		podBuilder.Object.Status.InitContainerStatuses, podBuilder.Object.Status.ContainerStatuses) {
+		restarted[status.Name] = status.RestartCount > 0
+	}
+
+	var tasks []collectionTask
+
+	for _, container := range slices.Concat(podBuilder.Object.Spec.InitContainers, podBuilder.Object.Spec.Containers) {
+		logDir := filepath.Join("namespaces", podBuilder.Object.Namespace, "logs", podBuilder.Object.Name)
+
+		tasks = append(tasks, run.logTask(podBuilder, container.Name, false, filepath.Join(logDir,
+			container.Name+".log")))
+
+		if restarted[container.Name] {
+			tasks = append(tasks, run.logTask(podBuilder, container.Name, true, filepath.Join(logDir,
+				container.Name+".previous.log")))
+		}
+	}
+
+	return tasks
+}
+
+// logTask returns the task collecting the log of a container of podBuilder to path.
+func (run *collection) logTask(podBuilder *pod.Builder, container string, previous bool, path string) collectionTask {
+	return func(ctx context.Context) {
+		logs, err := podBuilder.GetLogsWithOptionsWithContext(ctx, &corev1.PodLogOptions{
+			Container:  container,
+			Previous:   previous,
+			LimitBytes: &run.collector.maxLogBytes,
+		})
+		if err != nil {
+			run.addError(fmt.Errorf("failed to get logs of container %s of pod %s in namespace %s: %w",
+				container, podBuilder.Object.Name, podBuilder.Object.Namespace, err))
+
+			return
+		}
+
+		if int64(len(logs)) > run.collector.maxLogBytes {
+			logs = logs[:run.collector.maxLogBytes]
+		}
+
+		run.write(path, logs)
+	}
+}
+
+// objectTask returns the task collecting the current state of object.
+func (run *collection) objectTask(object runtimeclient.Object) collectionTask {
+	return func(ctx context.Context) {
+		gvk, err := apiutil.GVKForObject(object, run.collector.apiClient.Client.Scheme())
+		if err != nil {
+			run.addError(fmt.Errorf("failed to get the kind of object %s: %w", object.GetName(), err))
+
+			return
+		}
+
+		current, ok := object.DeepCopyObject().(runtimeclient.Object)
+		if !ok {
+			run.addError(fmt.Errorf("failed to copy %s %s", gvk.Kind, object.GetName()))
+
+			return
+		}
+
+		err = run.collector.apiClient.Client.Get(ctx, runtimeclient.ObjectKeyFromObject(object), current)
+		if err != nil {
+			run.addError(fmt.Errorf("failed to get %s %s: %w", gvk.Kind, object.GetName(), err))
+
+			return
+		}
+
+		current.GetObjectKind().SetGroupVersionKind(gvk)
+
+		path := filepath.Join("objects", strings.ToLower(gvk.Kind), object.GetNamespace(), object.GetName()+".yaml")
+		run.writeYAML(path, current)
+	}
+}
+
+// collectNodes collects the conditions of the nodes.
+func (run *collection) collectNodes(ctx context.Context) {
+	nodeList, err := nodes.ListWithContext(ctx, run.collector.apiClient)
+	if err != nil {
+		run.addError(fmt.Errorf("failed to list nodes: %w", err))
+
+		return
+	}
+
+	nodeConditions := map[string][]corev1.NodeCondition{}
+
+	for _, node := range nodeList {
+		nodeConditions[node.Object.Name] = node.Object.Status.Conditions
+	}
+
+	run.writeYAML(filepath.Join("cluster", "nodes.yaml"), nodeConditions)
+}
+
+// collectClusterOperators collects the status of the ClusterOperators.
+func (run *collection) collectClusterOperators(ctx context.Context) {
+	operatorList := &configv1.ClusterOperatorList{}
+
+	if err := run.collector.apiClient.Client.List(ctx, operatorList); err != nil {
+		run.addError(fmt.Errorf("failed to list ClusterOperators: %w", err))
+
+		return
+	}
+
+	operatorStatus := map[string]configv1.ClusterOperatorStatus{}
+
+	for _, operator := range operatorList.Items {
+		operatorStatus[operator.Name] = operator.Status
+	}
+
+	run.writeYAML(filepath.Join("cluster", "clusteroperators.yaml"), operatorStatus)
+}
+
+// collectMachineConfigPools collects the status of the MachineConfigPools.
+func (run *collection) collectMachineConfigPools(ctx context.Context) {
+	poolList := &mcv1.MachineConfigPoolList{}
+
+	if err := run.collector.apiClient.Client.List(ctx, poolList); err != nil {
+		run.addError(fmt.Errorf("failed to list MachineConfigPools: %w", err))
+
+		return
+	}
+
+	poolStatus := map[string]mcv1.MachineConfigPoolStatus{}
+
+	for _, pool := range poolList.Items {
+		poolStatus[pool.Name] = pool.Status
+	}
+
+	run.writeYAML(filepath.Join("cluster", "machineconfigpools.yaml"), poolStatus)
+}
+
+// writeYAML writes object as YAML to path, relative to the output directory. Managed fields are left out since they
+// are rarely relevant and often account for most of the size of an object.
+func (run *collection) writeYAML(path string, object any) {
+	if metaObject, ok := object.(runtimeclient.Object); ok && len(metaObject.GetManagedFields()) > 0 {
+		if copied, ok := metaObject.DeepCopyObject().(runtimeclient.Object); ok {
+			copied.SetManagedFields(nil)
+			object = copied
+		}
+	}
+
+	content, err := toYAML(object)
+	if err != nil {
+		run.addError(fmt.Errorf("failed to convert %s to yaml: %w", path, err))
+
+		return
+	}
+
+	run.write(path, content)
+}
+
+// write writes content to path, relative to the output directory, unless it would exceed the total size limit.
+func (run *collection) write(path string, content []byte) {
+	run.mutex.Lock()
+
+	if run.result.Bytes+int64(len(content)) > run.collector.maxTotalBytes {
+		glog.V(100).Infof("Skipping diagnostics file %s: total size limit of %d bytes reached",
+			path, run.collector.maxTotalBytes)
+
+		run.result.Skipped = append(run.result.Skipped, path)
+		run.mutex.Unlock()
+
+		return
+	}
+
+	// The space is reserved before writing so that concurrent writes cannot exceed the limit together.
+	run.result.Bytes += int64(len(content))
+	run.mutex.Unlock()
+
+	fullPath := filepath.Join(run.directory, path)
+
+	err := os.MkdirAll(filepath.Dir(fullPath), 0o755)
+	if err == nil {
+		err = os.WriteFile(fullPath, content, 0o600)
+	}
+
+	run.mutex.Lock()
+	defer run.mutex.Unlock()
+
+	if err != nil {
+		run.result.Bytes -= int64(len(content))
+		run.result.Errors = append(run.result.Errors, fmt.Errorf("failed to write %s: %w", path, err))
+
+		return
+	}
+
+	run.result.Files = append(run.result.Files, path)
+}
+
+// addError records an error of the collection.
+func (run *collection) addError(err error) {
+	glog.V(100).Infof("Diagnostics collection error: %v", err)
+
+	run.mutex.Lock()
+	defer run.mutex.Unlock()
+
+	run.result.Errors = append(run.result.Errors, err)
+}
+
+// writeErrors writes the errors of the collection to the errors file of the output directory. It is not subject to
+// the total size limit.
+func (run *collection) writeErrors() error {
+	if len(run.result.Errors) == 0 {
+		return nil
+	}
+
+	var content strings.Builder
+
+	for _, err := range run.result.Errors {
+		content.WriteString(err.Error() + "\n")
+	}
+
+	err := os.WriteFile(filepath.Join(run.directory, errorsFileName), []byte(content.String()), 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to write diagnostics collection errors: %w", err)
+	}
+
+	run.result.Files = append(run.result.Files, errorsFileName)
+
+	return nil
+}
+
+// builderDefinition returns the object held in the Definition field of builder, which must be a pointer to a builder
+// struct. Builders of this module do not share an interface exposing their object, so the field is read by name.
+func builderDefinition(builder any) (runtimeclient.Object, error) {
+	value := reflect.ValueOf(builder)
+	if value.Kind() != reflect.Pointer || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%T is not a pointer to a builder", builder)
+	}
+
+	definition := value.Elem().FieldByName("Definition")
+	if !definition.IsValid() || !definition.CanInterface() {
+		return nil, fmt.Errorf("%T has no Definition field", builder)
+	}
+
+	object, ok := definition.Interface().(runtimeclient.Object)
+	if !ok || definition.IsNil() {
+		return nil, fmt.Errorf("%T has no Kubernetes object in its Definition field", builder)
+	}
+
+	return object, nil
+}
+
+// formatEvents returns one line per event, from the oldest to the most recent.
+func formatEvents(eventList []*events.Builder) string {
+	slices.SortStableFunc(eventList, func(first, second *events.Builder) int {
+		return first.LastSeen().Compare(second.LastSeen())
+	})
+
+	var content strings.Builder
+
+	for _, event := range eventList {
+		fmt.Fprintf(&content, "%s %s %s %s/%s: %s\n",
+			event.LastSeen().Format("2006-01-02T15:04:05Z07:00"), event.Object.Type, event.Object.Reason,
+			event.Object.InvolvedObject.Kind, event.Object.InvolvedObject.Name, event.Object.Message)
+	}
+
+	return content.String()
+}
+
+// toYAML converts object to YAML through its JSON representation so that the JSON field names are used.
+func toYAML(object any) ([]byte, error) {
+	jsonContent, err := json.Marshal(object)
+	if err != nil {
+		return nil, err
+	}
+
+	var content any
+
+	if err = json.Unmarshal(jsonContent, &content); err != nil {
+		return nil, err
+	}
+
+	return yaml.Marshal(content)
+}
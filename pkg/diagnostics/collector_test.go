@@ -0,0 +1,451 @@
+package diagnostics
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/configmap"
+	"github.com/openshift-kni/eco-goinfra/pkg/events"
+	configv1 "github.com/openshift/api/config/v1"
+	mcv1 "github.com/openshift/api/machineconfiguration/v1"
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const defaultDiagnosticsNamespace = "test-ns"
+
+var testSchemes = []clients.SchemeAttacher{
+	configv1.Install,
+	mcv1.Install,
+}
+
+//nolint:funlen
+func TestCollectorCollect(t *testing.T) {
+	testCases := []struct {
+		objects         []runtimeclient.Object
+		clusterState    bool
+		maxLogBytes     int64
+		maxTotalBytes   int64
+		expectedFiles   []string
+		expectedSkipped []string
+		expectedErrors  []string
+		expectedLog     string
+	}{
+		{
+			objects:      []runtimeclient.Object{buildDummyConfigMap()},
+			clusterState: true,
+			expectedFiles: []string{
+				"cluster/clusteroperators.yaml",
+				"cluster/machineconfigpools.yaml",
+				"cluster/nodes.yaml",
+				"namespaces/test-ns/configmaps/test-configmap.yaml",
+				"namespaces/test-ns/deployments/test-deployment.yaml",
+				"namespaces/test-ns/events.txt",
+				"namespaces/test-ns/logs/test-pod/init.log",
+				"namespaces/test-ns/logs/test-pod/test.log",
+				"namespaces/test-ns/logs/test-pod/test.previous.log",
+				"namespaces/test-ns/pods/test-pod.yaml",
+				"objects/configmap/test-ns/test-configmap.yaml",
+			},
+			expectedLog: "fake logs",
+		},
+		{
+			objects: []runtimeclient.Object{&corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "missing", Namespace: defaultDiagnosticsNamespace}}},
+			maxLogBytes: 4,
+			expectedFiles: []string{
+				"collection-errors.txt",
+				"namespaces/test-ns/configmaps/test-configmap.yaml",
+				"namespaces/test-ns/deployments/test-deployment.yaml",
+				"namespaces/test-ns/events.txt",
+				"namespaces/test-ns/logs/test-pod/init.log",
+				"namespaces/test-ns/logs/test-pod/test.log",
+				"namespaces/test-ns/logs/test-pod/test.previous.log",
+				"namespaces/test-ns/pods/test-pod.yaml",
+			},
+			expectedErrors: []string{`failed to get ConfigMap missing: configmaps "missing" not found`},
+			expectedLog:    "fake",
+		},
+		{
+			maxTotalBytes: 30,
+			expectedFiles: []string{
+				"namespaces/test-ns/logs/test-pod/init.log",
+				"namespaces/test-ns/logs/test-pod/test.log",
+				"namespaces/test-ns/logs/test-pod/test.previous.log",
+			},
+			expectedSkipped: []string{
+				"namespaces/test-ns/configmaps/test-configmap.yaml",
+				"namespaces/test-ns/deployments/test-deployment.yaml",
+				"namespaces/test-ns/events.txt",
+				"namespaces/test-ns/pods/test-pod.yaml",
+			},
+			expectedLog: "fake logs",
+		},
+	}
+
+	for _, testCase := range testCases {
+		outputDir := filepath.Join(t.TempDir(), "diagnostics")
+
+		collector := NewCollector(buildDiagnosticsTestClient(), outputDir).
+			WithNamespaces(defaultDiagnosticsNamespace).
+			WithObjects(testCase.objects...).
+			WithClusterState(testCase.clusterState).
+			WithParallelism(2)
+
+		if testCase.maxLogBytes != 0 {
+			collector.WithMaxLogBytes(testCase.maxLogBytes)
+		}
+
+		if testCase.maxTotalBytes != 0 {
+			collector.WithMaxTotalBytes(testCase.maxTotalBytes)
+		}
+
+		result, err := collector.Collect()
+		assert.Nil(t, err)
+		assert.Equal(t, outputDir, result.Path)
+		assert.Equal(t, testCase.expectedFiles, result.Files)
+		assert.Equal(t, testCase.expectedSkipped, result.Skipped)
+
+		var errorMessages []string
+		for _, err := range result.Errors {
+			errorMessages = append(errorMessages, err.Error())
+		}
+
+		assert.Equal(t, testCase.expectedErrors, errorMessages)
+
+		for _, file := range result.Files {
+			assert.FileExists(t, filepath.Join(outputDir, file))
+		}
+
+		logs, err := os.ReadFile(filepath.Join(outputDir, "namespaces/test-ns/logs/test-pod/test.log"))
+		assert.Nil(t, err)
+		assert.Equal(t, testCase.expectedLog, string(logs))
+	}
+}
+
+func TestCollectorCollectContent(t *testing.T) {
+	outputDir := filepath.Join(t.TempDir(), "diagnostics")
+	testSettings := buildDiagnosticsTestClient()
+
+	configMapBuilder, err := configmap.Pull(testSettings, "test-configmap", defaultDiagnosticsNamespace)
+	assert.Nil(t, err)
+
+	result, err := NewCollector(testSettings, outputDir).
+		WithNamespaces(defaultDiagnosticsNamespace).
+		WithBuilders(configMapBuilder).
+		Collect()
+	assert.Nil(t, err)
+	assert.Empty(t, result.Errors)
+
+	content, err := os.ReadFile(filepath.Join(outputDir, "objects/configmap/test-ns/test-configmap.yaml"))
+	assert.Nil(t, err)
+	assert.Contains(t, string(content), "kind: ConfigMap")
+	assert.Contains(t, string(content), "key: value")
+	assert.NotContains(t, string(content), "managedFields")
+
+	content, err = os.ReadFile(filepath.Join(outputDir, "namespaces/test-ns/deployments/test-deployment.yaml"))
+	assert.Nil(t, err)
+	assert.Contains(t, string(content), "replicas: 2")
+
+	content, err = os.ReadFile(filepath.Join(outputDir, "namespaces/test-ns/events.txt"))
+	assert.Nil(t, err)
+	assert.Equal(t,
+		"2024-01-01T00:00:00Z Normal Scheduled Pod/test-pod: Successfully assigned\n"+
+			"2024-01-01T00:01:00Z Warning BackOff Pod/test-pod: Back-off restarting failed container\n",
+		string(content))
+
+	content, err = os.ReadFile(filepath.Join(outputDir, "cluster/clusteroperators.yaml"))
+	assert.Nil(t, err)
+	assert.Contains(t, string(content), "authentication:")
+	assert.Contains(t, string(content), "type: Degraded")
+}
+
+func TestCollectorCollectArchive(t *testing.T) {
+	outputDir := filepath.Join(t.TempDir(), "diagnostics")
+
+	result, err := NewCollector(buildDiagnosticsTestClient(), outputDir).
+		WithNamespaces(defaultDiagnosticsNamespace).
+		WithArchive().
+		Collect()
+	assert.Nil(t, err)
+	assert.Equal(t, outputDir+".tar.gz", result.Path)
+	assert.NoDirExists(t, outputDir)
+
+	archiveFile, err := os.Open(result.Path)
+	assert.Nil(t, err)
+
+	defer archiveFile.Close()
+
+	gzipReader, err := gzip.NewReader(archiveFile)
+	assert.Nil(t, err)
+
+	var archivedFiles []string
+
+	tarReader := tar.NewReader(gzipReader)
+
+	for {
+		header, err := tarReader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		assert.Nil(t, err)
+
+		archivedFiles = append(archivedFiles, header.Name)
+	}
+
+	assert.Len(t, archivedFiles, len(result.Files))
+	assert.Contains(t, archivedFiles, "diagnostics/namespaces/test-ns/pods/test-pod.yaml")
+}
+
+func TestCollectorCollectArchiveExistingDir(t *testing.T) {
+	outputDir := filepath.Join(t.TempDir(), "diagnostics")
+	existingFile := filepath.Join(outputDir, "existing.txt")
+
+	assert.Nil(t, os.MkdirAll(outputDir, 0o755))
+	assert.Nil(t, os.WriteFile(existingFile, []byte("existing"), 0o600))
+
+	result, err := NewCollector(buildDiagnosticsTestClient(), outputDir).
+		WithNamespaces(defaultDiagnosticsNamespace).
+		WithArchive().
+		Collect()
+	assert.Nil(t, err)
+	assert.Equal(t, outputDir+".tar.gz", result.Path)
+	assert.NotContains(t, result.Files, "existing.txt")
+
+	content, err := os.ReadFile(existingFile)
+	assert.Nil(t, err)
+	assert.Equal(t, "existing", string(content))
+
+	entries, err := os.ReadDir(outputDir)
+	assert.Nil(t, err)
+	assert.Len(t, entries, 1)
+
+	parentEntries, err := os.ReadDir(filepath.Dir(outputDir))
+	assert.Nil(t, err)
+	assert.Len(t, parentEntries, 2)
+}
+
+func TestNewCollector(t *testing.T) {
+	testCases := []struct {
+		outputDir     string
+		client        bool
+		expectedError string
+	}{
+		{
+			outputDir:     "/tmp/diagnostics",
+			client:        true,
+			expectedError: "",
+		},
+		{
+			outputDir:     "",
+			client:        true,
+			expectedError: "diagnostics collector 'outputDir' cannot be empty",
+		},
+		{
+			outputDir:     "/tmp/diagnostics",
+			client:        false,
+			expectedError: "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var testSettings *clients.Settings
+
+		if testCase.client {
+			testSettings = clients.GetTestClients(clients.TestClientParams{SchemeAttachers: testSchemes})
+		}
+
+		collector := NewCollector(testSettings, testCase.outputDir)
+
+		if !testCase.client {
+			assert.Nil(t, collector)
+
+			continue
+		}
+
+		assert.Equal(t, testCase.expectedError, collector.errorMsg)
+		assert.Equal(t, testCase.outputDir, collector.outputDir)
+		assert.True(t, collector.clusterState)
+		assert.Equal(t, DefaultParallelism, collector.parallelism)
+	}
+}
+
+func TestCollectorWithOptions(t *testing.T) {
+	testCases := []struct {
+		mutate        func(collector *Collector) *Collector
+		expectedError string
+	}{
+		{
+			mutate: func(collector *Collector) *Collector {
+				return collector.WithNamespaces("ns1", "ns2").WithMaxLogBytes(1).WithMaxTotalBytes(1)
+			},
+			expectedError: "",
+		},
+		{
+			mutate: func(collector *Collector) *Collector {
+				return collector.WithNamespaces("ns1", "")
+			},
+			expectedError: "diagnostics collector 'namespaces' cannot contain an empty namespace",
+		},
+		{
+			mutate: func(collector *Collector) *Collector {
+				return collector.WithObjects(&corev1.ConfigMap{})
+			},
+			expectedError: "diagnostics collector 'objects' cannot contain a nil or unnamed object",
+		},
+		{
+			mutate: func(collector *Collector) *Collector {
+				return collector.WithBuilders(&configmap.Builder{})
+			},
+			expectedError: "diagnostics collector 'builders' contain an invalid builder: " +
+				"*configmap.Builder has no Kubernetes object in its Definition field",
+		},
+		{
+			mutate: func(collector *Collector) *Collector {
+				return collector.WithBuilders(corev1.ConfigMap{})
+			},
+			expectedError: "diagnostics collector 'builders' contain an invalid builder: " +
+				"v1.ConfigMap is not a pointer to a builder",
+		},
+		{
+			mutate: func(collector *Collector) *Collector {
+				return collector.WithBuilders(&corev1.ObjectReference{})
+			},
+			expectedError: "diagnostics collector 'builders' contain an invalid builder: " +
+				"*v1.ObjectReference has no Definition field",
+		},
+		{
+			mutate: func(collector *Collector) *Collector {
+				return collector.WithMaxLogBytes(0)
+			},
+			expectedError: "diagnostics collector 'maxLogBytes' must be positive",
+		},
+		{
+			mutate: func(collector *Collector) *Collector {
+				return collector.WithMaxTotalBytes(-1)
+			},
+			expectedError: "diagnostics collector 'maxTotalBytes' must be positive",
+		},
+		{
+			mutate: func(collector *Collector) *Collector {
+				return collector.WithParallelism(0)
+			},
+			expectedError: "diagnostics collector 'parallelism' must be positive",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := clients.GetTestClients(clients.TestClientParams{SchemeAttachers: testSchemes})
+		collector := testCase.mutate(NewCollector(testSettings, t.TempDir()))
+
+		assert.Equal(t, testCase.expectedError, collector.errorMsg)
+
+		result, err := collector.Collect()
+		if testCase.expectedError == "" {
+			assert.Nil(t, err)
+			assert.NotNil(t, result)
+		} else {
+			assert.EqualError(t, err, testCase.expectedError)
+			assert.Nil(t, result)
+		}
+	}
+}
+
+func TestResultAttachToReport(t *testing.T) {
+	var result *Result
+
+	// Neither a nil nor an empty result can be attached, so these calls must return before reaching ginkgo.
+	result.AttachToReport()
+	(&Result{}).AttachToReport()
+}
+
+func TestFormatEvents(t *testing.T) {
+	assert.Equal(t, "", formatEvents(nil))
+
+	eventList := []*events.Builder{
+		{Object: buildDummyEvent("second", "Warning", "BackOff", time.Minute)},
+		{Object: buildDummyEvent("first", "Normal", "Scheduled", 0)},
+	}
+
+	assert.Equal(t, "2024-01-01T00:00:00Z Normal Scheduled Pod/test-pod: first\n"+
+		"2024-01-01T00:01:00Z Warning BackOff Pod/test-pod: second\n", formatEvents(eventList))
+}
+
+// buildDiagnosticsTestClient returns a client with a pod whose test container restarted, two events about it, a
+// ConfigMap, a node, a ClusterOperator and a MachineConfigPool.
+func buildDiagnosticsTestClient() *clients.Settings {
+	testPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-pod", Namespace: defaultDiagnosticsNamespace},
+		Spec: corev1.PodSpec{
+			InitContainers: []corev1.Container{{Name: "init"}},
+			Containers:     []corev1.Container{{Name: "test"}},
+		},
+		Status: corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{{Name: "test", RestartCount: 2}}},
+	}
+
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: []runtime.Object{
+			testPod,
+			buildDummyEvent("Back-off restarting failed container", "Warning", "BackOff", time.Minute),
+			buildDummyEvent("Successfully assigned", "Normal", "Scheduled", 0),
+			buildDummyConfigMap(),
+			&appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-deployment", Namespace: defaultDiagnosticsNamespace},
+				Spec:       appsv1.DeploymentSpec{Replicas: ptr.To[int32](2)},
+			},
+			&corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: "worker-0"},
+				Status: corev1.NodeStatus{Conditions: []corev1.NodeCondition{
+					{Type: corev1.NodeReady, Status: corev1.ConditionTrue}}},
+			},
+			&configv1.ClusterOperator{
+				ObjectMeta: metav1.ObjectMeta{Name: "authentication"},
+				Status: configv1.ClusterOperatorStatus{Conditions: []configv1.ClusterOperatorStatusCondition{
+					{Type: configv1.OperatorDegraded, Status: configv1.ConditionFalse}}},
+			},
+			&mcv1.MachineConfigPool{
+				ObjectMeta: metav1.ObjectMeta{Name: "worker"},
+				Status:     mcv1.MachineConfigPoolStatus{MachineCount: 1, UpdatedMachineCount: 1},
+			},
+		},
+		SchemeAttachers: testSchemes,
+	})
+}
+
+func buildDummyConfigMap() *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-configmap",
+			Namespace: defaultDiagnosticsNamespace,
+			ManagedFields: []metav1.ManagedFieldsEntry{{
+				Manager: "test", Operation: metav1.ManagedFieldsOperationApply}},
+		},
+		Data: map[string]string{"key": "value"},
+	}
+}
+
+func buildDummyEvent(message, eventType, reason string, offset time.Duration) *corev1.Event {
+	return &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: fmt.Sprintf("test-pod.%s", reason), Namespace: defaultDiagnosticsNamespace},
+		InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "test-pod"},
+		Type:           eventType,
+		Reason:         reason,
+		Message:        message,
+		LastTimestamp:  metav1.NewTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC).Add(offset)),
+	}
+}
@@ -3,6 +3,7 @@ package events
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
@@ -76,6 +77,16 @@ func (builder *Builder) Exists() bool {
 	return err == nil || !k8serrors.IsNotFound(err)
 }
 
+// LastSeen returns the time the Event was last seen, falling back to its creation time for events without
+// timestamps. It returns the zero time if the Event was not retrieved.
+func (builder *Builder) LastSeen() time.Time {
+	if builder == nil || builder.Object == nil {
+		return time.Time{}
+	}
+
+	return getEventTime(builder.Object)
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *Builder) validate() (bool, error) {
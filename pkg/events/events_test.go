@@ -2,6 +2,7 @@ package events
 
 import (
 	"testing"
+	"time"
 
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
 	"github.com/stretchr/testify/assert"
@@ -136,6 +137,39 @@ func TestValidate(t *testing.T) {
 	}
 }
 
+func TestLastSeen(t *testing.T) {
+	creationTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	lastTime := creationTime.Add(time.Minute)
+
+	testCases := []struct {
+		event        *corev1.Event
+		expectedTime time.Time
+	}{
+		{
+			event:        nil,
+			expectedTime: time.Time{},
+		},
+		{
+			event:        &corev1.Event{ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(creationTime)}},
+			expectedTime: creationTime,
+		},
+		{
+			event: &corev1.Event{
+				ObjectMeta:    metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(creationTime)},
+				LastTimestamp: metav1.NewTime(lastTime),
+			},
+			expectedTime: lastTime,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidTestBuilder()
+		testBuilder.Object = testCase.event
+
+		assert.Equal(t, testCase.expectedTime, testBuilder.LastSeen())
+	}
+}
+
 func buildValidTestBuilder() *Builder {
 	return &Builder{
 		apiClient: k8sfake.NewSimpleClientset().CoreV1().Events("test-namespace"),
@@ -3,15 +3,25 @@ package events
 import (
 	"context"
 	"fmt"
+	"sort"
+	"time"
 
 	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	k8sv1 "k8s.io/api/core/v1"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
 )
 
 // List returns Events inventory in the given namespace.
 func List(
 	apiClient *clients.Settings, nsname string, options ...metaV1.ListOptions) ([]*Builder, error) {
+	return ListWithContext(context.Background(), apiClient, nsname, options...)
+}
+
+// ListWithContext returns Events inventory in the given namespace using the provided context.
+func ListWithContext(
+	ctx context.Context, apiClient *clients.Settings, nsname string, options ...metaV1.ListOptions) ([]*Builder, error) {
 	if nsname == "" {
 		glog.V(100).Infof("Events 'nsname' parameter can not be empty")
 
@@ -34,7 +44,7 @@ func List(
 
 	glog.V(100).Infof(logMessage)
 
-	eventList, err := apiClient.Events(nsname).List(context.TODO(), passedOptions)
+	eventList, err := apiClient.Events(nsname).List(ctx, passedOptions)
 
 	if err != nil {
 		glog.V(100).Infof("Failed to list Events in the namespace %s due to %s", nsname, err.Error())
@@ -54,3 +64,77 @@ func List(
 
 	return eventObjects, nil
 }
+
+// ListForObject returns the Events about the object of the given kind and name in namespace nsname, sorted from the
+// oldest to the most recent. Events about cluster-scoped objects are found in the default namespace, so nsname
+// defaults to it when empty.
+func ListForObject(apiClient *clients.Settings, nsname, kind, name string) ([]*Builder, error) {
+	return ListForObjectWithContext(context.Background(), apiClient, nsname, kind, name)
+}
+
+// ListForObjectWithContext returns the Events about the object of the given kind and name in namespace nsname, as
+// ListForObject does, using the provided context.
+func ListForObjectWithContext(
+	ctx context.Context, apiClient *clients.Settings, nsname, kind, name string) ([]*Builder, error) {
+	if apiClient == nil {
+		glog.V(100).Infof("Events 'apiClient' parameter can not be empty")
+
+		return nil, fmt.Errorf("failed to list Events, 'apiClient' parameter is empty")
+	}
+
+	if kind == "" || name == "" {
+		glog.V(100).Infof("Events 'kind' and 'name' parameters can not be empty")
+
+		return nil, fmt.Errorf("failed to list Events, 'kind' and 'name' parameters cannot be empty")
+	}
+
+	if nsname == "" {
+		nsname = metaV1.NamespaceDefault
+	}
+
+	glog.V(100).Infof("Listing Events about %s %s in the namespace %s", kind, name, nsname)
+
+	eventList, err := apiClient.Events(nsname).List(ctx, metaV1.ListOptions{
+		FieldSelector: fields.Set{"involvedObject.kind": kind, "involvedObject.name": name}.String(),
+	})
+	if err != nil {
+		glog.V(100).Infof("Failed to list Events about %s %s in the namespace %s due to %s",
+			kind, name, nsname, err.Error())
+
+		return nil, err
+	}
+
+	var eventObjects []*Builder
+
+	for _, event := range eventList.Items {
+		// The field selector is not supported by every client, so the Events are filtered again.
+		if event.InvolvedObject.Kind != kind || event.InvolvedObject.Name != name {
+			continue
+		}
+
+		copiedEvent := event
+		eventObjects = append(eventObjects, &Builder{
+			apiClient: apiClient.Events(nsname),
+			Object:    &copiedEvent})
+	}
+
+	sort.SliceStable(eventObjects, func(i, j int) bool {
+		return getEventTime(eventObjects[i].Object).Before(getEventTime(eventObjects[j].Object))
+	})
+
+	return eventObjects, nil
+}
+
+// getEventTime returns the time the event was last seen.
+func getEventTime(event *k8sv1.Event) time.Time {
+	switch {
+	case event.Series != nil && !event.Series.LastObservedTime.IsZero():
+		return event.Series.LastObservedTime.Time
+	case !event.LastTimestamp.IsZero():
+		return event.LastTimestamp.Time
+	case !event.EventTime.IsZero():
+		return event.EventTime.Time
+	default:
+		return event.CreationTimestamp.Time
+	}
+}
@@ -0,0 +1,102 @@
+package events
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestListForObject(t *testing.T) {
+	generateEvent := func(name, nsname, kind, objectName string, minute int) *corev1.Event {
+		return &corev1.Event{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+			InvolvedObject: corev1.ObjectReference{Kind: kind, Name: objectName},
+			LastTimestamp:  metav1.NewTime(time.Date(2024, 1, 1, 0, minute, 0, 0, time.UTC)),
+		}
+	}
+
+	testEvents := []runtime.Object{
+		generateEvent("second", "test-namespace", "Pod", "test-pod", 2),
+		generateEvent("first", "test-namespace", "Pod", "test-pod", 1),
+		generateEvent("other-name", "test-namespace", "Pod", "other-pod", 1),
+		generateEvent("other-kind", "test-namespace", "Service", "test-pod", 1),
+		generateEvent("cluster", "default", "Node", "test-node", 1),
+	}
+
+	testCases := []struct {
+		nsname         string
+		kind           string
+		name           string
+		client         bool
+		expectedEvents []string
+		expectedError  error
+	}{
+		{
+			nsname:         "test-namespace",
+			kind:           "Pod",
+			name:           "test-pod",
+			client:         true,
+			expectedEvents: []string{"first", "second"},
+		},
+		{
+			nsname:         "",
+			kind:           "Node",
+			name:           "test-node",
+			client:         true,
+			expectedEvents: []string{"cluster"},
+		},
+		{
+			nsname:         "test-namespace",
+			kind:           "Pod",
+			name:           "missing-pod",
+			client:         true,
+			expectedEvents: []string{},
+		},
+		{
+			nsname:        "test-namespace",
+			kind:          "",
+			name:          "test-pod",
+			client:        true,
+			expectedError: fmt.Errorf("failed to list Events, 'kind' and 'name' parameters cannot be empty"),
+		},
+		{
+			nsname:        "test-namespace",
+			kind:          "Pod",
+			name:          "test-pod",
+			client:        false,
+			expectedError: fmt.Errorf("failed to list Events, 'apiClient' parameter is empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var testSettings *clients.Settings
+
+		if testCase.client {
+			testSettings = clients.GetTestClients(clients.TestClientParams{K8sMockObjects: testEvents})
+		}
+
+		eventBuilders, err := ListForObject(testSettings, testCase.nsname, testCase.kind, testCase.name)
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError != nil {
+			continue
+		}
+
+		eventNames := []string{}
+
+		for _, eventBuilder := range eventBuilders {
+			eventNames = append(eventNames, eventBuilder.Object.Name)
+		}
+
+		assert.Equal(t, testCase.expectedEvents, eventNames)
+	}
+}
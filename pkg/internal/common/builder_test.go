@@ -0,0 +1,557 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	configv1 "github.com/openshift/api/config/v1"
+	routev1 "github.com/openshift/api/route/v1"
+	"github.com/stretchr/testify/assert"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+const (
+	defaultName      = "test-name"
+	defaultNamespace = "test-namespace"
+)
+
+type routeBuilder struct {
+	EmbeddableBuilder[routev1.Route, *routev1.Route]
+}
+
+func (builder *routeBuilder) GetKind() string {
+	return "Route"
+}
+
+type routeOption func(builder *routeBuilder) (*routeBuilder, error)
+
+type clusterOperatorBuilder struct {
+	EmbeddableBuilder[configv1.ClusterOperator, *configv1.ClusterOperator]
+}
+
+func (builder *clusterOperatorBuilder) GetKind() string {
+	return "ClusterOperator"
+}
+
+func TestNewNamespacedBuilder(t *testing.T) {
+	testCases := []struct {
+		name          string
+		nsname        string
+		client        bool
+		expectedError string
+	}{
+		{
+			name:          defaultName,
+			nsname:        defaultNamespace,
+			client:        true,
+			expectedError: "",
+		},
+		{
+			name:          "",
+			nsname:        defaultNamespace,
+			client:        true,
+			expectedError: "route 'name' cannot be empty",
+		},
+		{
+			name:          defaultName,
+			nsname:        "",
+			client:        true,
+			expectedError: "route 'nsname' cannot be empty",
+		},
+		{
+			name:          defaultName,
+			nsname:        defaultNamespace,
+			client:        false,
+			expectedError: "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var testSettings *clients.Settings
+
+		if testCase.client {
+			testSettings = clients.GetTestClients(clients.TestClientParams{})
+		}
+
+		testBuilder := NewNamespacedBuilder[routev1.Route, routeBuilder](
+			testSettings, routev1.Install, testCase.name, testCase.nsname)
+
+		if !testCase.client {
+			assert.Nil(t, testBuilder)
+
+			continue
+		}
+
+		assert.NotNil(t, testBuilder)
+		assert.Equal(t, testCase.expectedError, testBuilder.GetErrorMessage())
+		assert.Equal(t, testCase.name, testBuilder.Definition.Name)
+		assert.Equal(t, testCase.nsname, testBuilder.Definition.Namespace)
+	}
+}
+
+func TestPullClusterScopedBuilder(t *testing.T) {
+	testCases := []struct {
+		name                string
+		addToRuntimeObjects bool
+		client              bool
+		expectedError       error
+	}{
+		{
+			name:                defaultName,
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       nil,
+		},
+		{
+			name:                defaultName,
+			addToRuntimeObjects: false,
+			client:              true,
+			expectedError:       fmt.Errorf("clusterOperator object %s does not exist", defaultName),
+		},
+		{
+			name:                "",
+			addToRuntimeObjects: false,
+			client:              true,
+			expectedError:       fmt.Errorf("clusterOperator 'name' cannot be empty"),
+		},
+		{
+			name:                defaultName,
+			addToRuntimeObjects: false,
+			client:              false,
+			expectedError:       fmt.Errorf("the apiClient cannot be nil"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var (
+			runtimeObjects []runtime.Object
+			testSettings   *clients.Settings
+		)
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyClusterOperator())
+		}
+
+		if testCase.client {
+			testSettings = clients.GetTestClients(clients.TestClientParams{K8sMockObjects: runtimeObjects})
+		}
+
+		testBuilder, err := PullClusterScopedBuilder[configv1.ClusterOperator, clusterOperatorBuilder](
+			testSettings, configv1.Install, testCase.name)
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, testCase.name, testBuilder.Definition.Name)
+		}
+	}
+}
+
+func TestPullNamespacedBuilder(t *testing.T) {
+	testCases := []struct {
+		name                string
+		nsname              string
+		addToRuntimeObjects bool
+		expectedError       error
+	}{
+		{
+			name:                defaultName,
+			nsname:              defaultNamespace,
+			addToRuntimeObjects: true,
+			expectedError:       nil,
+		},
+		{
+			name:                defaultName,
+			nsname:              defaultNamespace,
+			addToRuntimeObjects: false,
+			expectedError: fmt.Errorf("route object %s does not exist in namespace %s",
+				defaultName, defaultNamespace),
+		},
+		{
+			name:                "",
+			nsname:              defaultNamespace,
+			addToRuntimeObjects: false,
+			expectedError:       fmt.Errorf("route 'name' cannot be empty"),
+		},
+		{
+			name:                defaultName,
+			nsname:              "",
+			addToRuntimeObjects: false,
+			expectedError:       fmt.Errorf("route 'namespace' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyRoute())
+		}
+
+		testBuilder, err := PullNamespacedBuilder[routev1.Route, routeBuilder](
+			clients.GetTestClients(clients.TestClientParams{K8sMockObjects: runtimeObjects}), routev1.Install,
+			testCase.name, testCase.nsname)
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, testCase.name, testBuilder.Definition.Name)
+			assert.Equal(t, testCase.nsname, testBuilder.Definition.Namespace)
+		}
+	}
+}
+
+func TestPullNamespacedBuilderWithContext(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{K8sMockObjects: []runtime.Object{buildDummyRoute()}})
+	testSettings.Client = interceptor.NewClient(testSettings.Client.(runtimeclient.WithWatch), interceptor.Funcs{
+		Get: func(ctx context.Context, client runtimeclient.WithWatch, key runtimeclient.ObjectKey,
+			object runtimeclient.Object, opts ...runtimeclient.GetOption) error {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			return client.Get(ctx, key, object, opts...)
+		},
+	})
+
+	testBuilder, err := PullNamespacedBuilderWithContext[routev1.Route, routeBuilder](
+		t.Context(), testSettings, routev1.Install, defaultName, defaultNamespace)
+	assert.Nil(t, err)
+	assert.Equal(t, defaultName, testBuilder.Definition.Name)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	_, err = PullNamespacedBuilderWithContext[routev1.Route, routeBuilder](
+		ctx, testSettings, routev1.Install, defaultName, defaultNamespace)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestValidate(t *testing.T) {
+	testCases := []struct {
+		builderNil    bool
+		definitionNil bool
+		apiClientNil  bool
+		errorMsg      string
+		expectedError error
+	}{
+		{
+			expectedError: nil,
+		},
+		{
+			builderNil:    true,
+			expectedError: fmt.Errorf("error: received nil Route builder"),
+		},
+		{
+			definitionNil: true,
+			expectedError: fmt.Errorf("can not redefine the undefined Route"),
+		},
+		{
+			apiClientNil:  true,
+			expectedError: fmt.Errorf("Route builder cannot have nil apiClient"),
+		},
+		{
+			errorMsg:      "test error",
+			expectedError: fmt.Errorf("test error"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidRouteBuilder(clients.GetTestClients(clients.TestClientParams{}))
+
+		if testCase.builderNil {
+			testBuilder = nil
+		}
+
+		if testCase.definitionNil {
+			testBuilder.Definition = nil
+		}
+
+		if testCase.apiClientNil {
+			testBuilder.SetClient(nil)
+		}
+
+		if testCase.errorMsg != "" {
+			testBuilder.SetErrorMessage(testCase.errorMsg)
+		}
+
+		err := Validate(testBuilder)
+		assert.Equal(t, testCase.expectedError, err)
+	}
+}
+
+func TestCreate(t *testing.T) {
+	testCases := []struct {
+		exists bool
+	}{
+		{exists: true},
+		{exists: false},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.exists {
+			runtimeObjects = append(runtimeObjects, buildDummyRoute())
+		}
+
+		testBuilder := buildValidRouteBuilder(clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects: runtimeObjects,
+		}))
+
+		err := Create(t.Context(), testBuilder)
+		assert.Nil(t, err)
+		assert.NotNil(t, testBuilder.Object)
+		assert.True(t, Exists(t.Context(), testBuilder))
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	testCases := []struct {
+		exists          bool
+		resourceVersion string
+		expectedError   error
+		conflict        bool
+	}{
+		{
+			exists:        true,
+			expectedError: nil,
+		},
+		{
+			exists:        false,
+			expectedError: fmt.Errorf("failed to update Route, object does not exist on cluster"),
+		},
+		{
+			exists:          true,
+			resourceVersion: "1",
+			conflict:        true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.exists {
+			runtimeObjects = append(runtimeObjects, buildDummyRoute())
+		}
+
+		testBuilder := buildValidRouteBuilder(clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects: runtimeObjects,
+		}))
+		testBuilder.Definition.Spec.Host = "test.example.com"
+		testBuilder.Definition.ResourceVersion = testCase.resourceVersion
+
+		err := Update(t.Context(), testBuilder)
+
+		if testCase.conflict {
+			assert.True(t, k8serrors.IsConflict(err))
+
+			continue
+		}
+
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			route, err := Get(t.Context(), testBuilder)
+			assert.Nil(t, err)
+			assert.Equal(t, "test.example.com", route.Spec.Host)
+		}
+	}
+}
+
+func TestApply(t *testing.T) {
+	testCases := []struct {
+		exists          bool
+		otherManager    bool
+		host            string
+		force           bool
+		fieldManager    string
+		expectedError   error
+		expectConflict  bool
+		expectedManager string
+	}{
+		{
+			exists:          false,
+			host:            "test.example.com",
+			fieldManager:    "test-manager",
+			expectedManager: "test-manager",
+		},
+		{
+			exists:          true,
+			host:            "test.example.com",
+			fieldManager:    "test-manager",
+			expectedManager: "test-manager",
+		},
+		{
+			exists:          true,
+			otherManager:    true,
+			host:            "other.example.com",
+			fieldManager:    "test-manager",
+			expectedManager: "test-manager",
+		},
+		{
+			exists:         true,
+			otherManager:   true,
+			host:           "test.example.com",
+			fieldManager:   "test-manager",
+			expectConflict: true,
+		},
+		{
+			exists:          true,
+			otherManager:    true,
+			host:            "test.example.com",
+			force:           true,
+			fieldManager:    "test-manager",
+			expectedManager: "test-manager",
+		},
+		{
+			exists:        false,
+			host:          "test.example.com",
+			fieldManager:  "",
+			expectedError: fmt.Errorf("route apply fieldManager cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.exists {
+			runtimeObjects = append(runtimeObjects, buildDummyRoute())
+		}
+
+		testSettings := clients.GetTestClients(clients.TestClientParams{K8sMockObjects: runtimeObjects})
+
+		if testCase.otherManager {
+			otherBuilder := buildValidRouteBuilder(testSettings)
+			otherBuilder.Definition.Spec.Host = "other.example.com"
+
+			err := Apply(t.Context(), otherBuilder, "other-manager", false)
+			assert.Nil(t, err)
+		}
+
+		testBuilder := buildValidRouteBuilder(testSettings)
+		testBuilder.Definition.Spec.Host = testCase.host
+
+		err := Apply(t.Context(), testBuilder, testCase.fieldManager, testCase.force)
+
+		if testCase.expectConflict {
+			assert.True(t, clients.IsApplyConflict(err))
+			assert.True(t, k8serrors.IsConflict(err))
+
+			var conflictError *clients.ApplyConflictError
+
+			assert.ErrorAs(t, err, &conflictError)
+			assert.Equal(t, []clients.ApplyConflict{{Manager: "other-manager", Field: ".spec.host"}},
+				conflictError.Conflicts)
+
+			continue
+		}
+
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError != nil {
+			continue
+		}
+
+		route, err := Get(t.Context(), testBuilder)
+		assert.Nil(t, err)
+		assert.Equal(t, testCase.host, route.Spec.Host)
+
+		hostOwners := map[string]bool{}
+
+		for _, entry := range route.ManagedFields {
+			if entry.FieldsV1 != nil && strings.Contains(string(entry.FieldsV1.Raw), `"f:host"`) {
+				hostOwners[entry.Manager] = true
+			}
+		}
+
+		assert.True(t, hostOwners[testCase.expectedManager])
+		assert.Equal(t, testCase.otherManager && !testCase.force, hostOwners["other-manager"])
+	}
+}
+
+func TestDeleteAndWait(t *testing.T) {
+	testCases := []struct {
+		exists bool
+	}{
+		{exists: true},
+		{exists: false},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.exists {
+			runtimeObjects = append(runtimeObjects, buildDummyRoute())
+		}
+
+		testBuilder := buildValidRouteBuilder(clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects: runtimeObjects,
+		}))
+
+		err := DeleteAndWait(t.Context(), testBuilder, time.Second)
+		assert.Nil(t, err)
+		assert.Nil(t, testBuilder.Object)
+		assert.False(t, Exists(t.Context(), testBuilder))
+	}
+}
+
+func TestWithOptions(t *testing.T) {
+	testCases := []struct {
+		option        routeOption
+		expectedError string
+	}{
+		{
+			option: func(builder *routeBuilder) (*routeBuilder, error) {
+				builder.Definition.Spec.Host = "test.example.com"
+
+				return builder, nil
+			},
+			expectedError: "",
+		},
+		{
+			option: func(builder *routeBuilder) (*routeBuilder, error) {
+				return builder, fmt.Errorf("error adding additional option")
+			},
+			expectedError: "error adding additional option",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidRouteBuilder(clients.GetTestClients(clients.TestClientParams{}))
+
+		testBuilder = WithOptions(testBuilder, testCase.option)
+		assert.Equal(t, testCase.expectedError, testBuilder.GetErrorMessage())
+
+		if testCase.expectedError == "" {
+			assert.Equal(t, "test.example.com", testBuilder.Definition.Spec.Host)
+		}
+	}
+}
+
+func buildValidRouteBuilder(apiClient *clients.Settings) *routeBuilder {
+	return NewNamespacedBuilder[routev1.Route, routeBuilder](apiClient, routev1.Install, defaultName, defaultNamespace)
+}
+
+func buildDummyRoute() *routev1.Route {
+	return &routev1.Route{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      defaultName,
+			Namespace: defaultNamespace,
+		},
+	}
+}
+
+func buildDummyClusterOperator() *configv1.ClusterOperator {
+	return &configv1.ClusterOperator{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: defaultName,
+		},
+	}
+}
@@ -0,0 +1,205 @@
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/golang/glog"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/retry"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// RetryOnConflict pushes definition to the cluster using update. When the update fails with a Conflict, the latest
+// version of the object is fetched using get, the changes made to definition relative to base are re-applied on top of
+// it as a strategic merge patch, and the update is retried according to backoff. If base is nil or is the same object
+// as definition, the changes cannot be isolated and definition is retried as is with the latest resource version,
+// overwriting concurrent changes.
+//
+// The changes are only as accurate as base. Base must be the object definition was derived from, as recorded when it
+// was pulled, created or last updated, and never the object refreshed by Exists: the concurrent changes made in between
+// would otherwise appear as reverted in definition and be overwritten. Lists without a patch merge key, such as
+// container args, are replaced as a whole, so concurrent changes to a list also modified through definition are lost.
+//
+// Neither base nor definition is modified. The object returned by the last successful update is returned.
+func RetryOnConflict[O any, SO ObjectPointer[O]](
+	ctx context.Context,
+	backoff wait.Backoff,
+	base, definition SO,
+	get func(ctx context.Context) (SO, error),
+	update func(ctx context.Context, object SO) (SO, error)) (SO, error) {
+	patch, err := createMutationPatch(base, definition)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		result  SO
+		attempt int
+	)
+
+	err = retry.RetryOnConflict(backoff, func() error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		desired, err := copyObject(definition)
+		if err != nil {
+			return err
+		}
+
+		if attempt > 0 || desired.GetResourceVersion() == "" {
+			latest, err := get(ctx)
+			if err != nil {
+				return err
+			}
+
+			glog.V(100).Infof("Re-applying changes to %s on top of resource version %s (attempt %d)",
+				describe(definition), latest.GetResourceVersion(), attempt+1)
+
+			desired, err = applyMutationPatch(latest, desired, patch)
+			if err != nil {
+				return err
+			}
+		}
+
+		attempt++
+
+		result, err = update(ctx, desired)
+
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// UpdateObjectWithRetry is RetryOnConflict for objects accessed through the runtime client. It allows builders that do
+// not embed EmbeddableBuilder to share the same merge semantics, including the cases where concurrent changes are lost.
+func UpdateObjectWithRetry[O any, SO ObjectPointer[O]](
+	ctx context.Context, apiClient runtimeclient.Client, backoff wait.Backoff, base, definition SO) (SO, error) {
+	return RetryOnConflict(ctx, backoff, base, definition,
+		func(ctx context.Context) (SO, error) {
+			latest := SO(new(O))
+
+			return latest, apiClient.Get(ctx, runtimeclient.ObjectKeyFromObject(definition), latest)
+		},
+		func(ctx context.Context, object SO) (SO, error) {
+			return object, apiClient.Update(ctx, object)
+		})
+}
+
+// UpdateWithRetry updates the object of the builder with merge semantics: on Conflict, the changes made to the
+// definition since it was last synchronized with the cluster, by Pull, Create or Update, are re-applied to the latest
+// version and the update is retried according to backoff. On success, the definition, the object and the base are set
+// to the updated object.
+func UpdateWithRetry[O any, SO ObjectPointer[O]](
+	ctx context.Context, builder BuilderInterface[O, SO], backoff wait.Backoff) error {
+	if err := Validate(builder); err != nil {
+		return err
+	}
+
+	glog.V(100).Infof("Updating %s %s with retry on conflict", builder.GetKind(), describe(builder.GetDefinition()))
+
+	result, err := UpdateObjectWithRetry(ctx, builder.GetClient(), backoff, builder.GetBase(), builder.GetDefinition())
+	if err != nil {
+		return fmt.Errorf("failed to update %s %s: %w", builder.GetKind(), describe(builder.GetDefinition()), err)
+	}
+
+	definition, err := copyObject(result)
+	if err != nil {
+		return err
+	}
+
+	builder.SetDefinition(definition)
+	builder.SetObject(result)
+
+	return setBase(builder, result)
+}
+
+// createMutationPatch returns the strategic merge patch turning base into definition, ignoring the fields managed by
+// the server. A nil patch is returned when base is not known.
+func createMutationPatch[O any, SO ObjectPointer[O]](base, definition SO) ([]byte, error) {
+	if base == nil || base == definition {
+		return nil, nil
+	}
+
+	original, err := marshalWithoutServerFields(base)
+	if err != nil {
+		return nil, err
+	}
+
+	modified, err := marshalWithoutServerFields(definition)
+	if err != nil {
+		return nil, err
+	}
+
+	return strategicpatch.CreateTwoWayMergePatch(original, modified, SO(new(O)))
+}
+
+// applyMutationPatch applies patch to latest. If patch is nil, desired is returned with the resource version of latest.
+func applyMutationPatch[O any, SO ObjectPointer[O]](latest, desired SO, patch []byte) (SO, error) {
+	if patch == nil {
+		desired.SetResourceVersion(latest.GetResourceVersion())
+
+		return desired, nil
+	}
+
+	current, err := json.Marshal(latest)
+	if err != nil {
+		return nil, err
+	}
+
+	merged, err := strategicpatch.StrategicMergePatch(current, patch, SO(new(O)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to re-apply changes to %s: %w", describe(latest), err)
+	}
+
+	result := SO(new(O))
+	if err = json.Unmarshal(merged, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// marshalWithoutServerFields returns the JSON encoding of object without the resource version and managed fields so
+// that they never appear in a mutation patch.
+func marshalWithoutServerFields[O any, SO ObjectPointer[O]](object SO) ([]byte, error) {
+	objectCopy, err := copyObject(object)
+	if err != nil {
+		return nil, err
+	}
+
+	objectCopy.SetResourceVersion("")
+	objectCopy.SetManagedFields(nil)
+
+	return json.Marshal(objectCopy)
+}
+
+// setBase records a copy of object as the base of the builder, the object its definition was last synchronized with.
+func setBase[O any, SO ObjectPointer[O]](builder BuilderInterface[O, SO], object SO) error {
+	base, err := copyObject(object)
+	if err != nil {
+		return err
+	}
+
+	builder.SetBase(base)
+
+	return nil
+}
+
+// copyObject returns a deep copy of object.
+func copyObject[O any, SO ObjectPointer[O]](object SO) (SO, error) {
+	objectCopy, ok := object.DeepCopyObject().(SO)
+	if !ok {
+		return nil, fmt.Errorf("failed to copy %s", describe(object))
+	}
+
+	return objectCopy, nil
+}
@@ -0,0 +1,108 @@
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	routev1 "github.com/openshift/api/route/v1"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/retry"
+)
+
+func TestUpdateWithRetry(t *testing.T) {
+	testCases := []struct {
+		pulled            bool
+		concurrentChange  bool
+		refreshed         bool
+		cancelled         bool
+		expectedLabels    map[string]string
+		expectedErrorText string
+	}{
+		{
+			pulled:           true,
+			concurrentChange: true,
+			expectedLabels:   map[string]string{"concurrent": "true"},
+		},
+		{
+			pulled:           true,
+			concurrentChange: true,
+			refreshed:        true,
+			expectedLabels:   map[string]string{"concurrent": "true"},
+		},
+		{
+			pulled:           true,
+			concurrentChange: false,
+			expectedLabels:   nil,
+		},
+		{
+			pulled:           false,
+			concurrentChange: true,
+			expectedLabels:   nil,
+		},
+		{
+			pulled:            true,
+			cancelled:         true,
+			expectedErrorText: "failed to update Route test-name in namespace test-namespace: context canceled",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects: []runtime.Object{buildDummyRoute()},
+		})
+
+		var testBuilder *routeBuilder
+
+		if testCase.pulled {
+			var err error
+
+			testBuilder, err = PullNamespacedBuilder[routev1.Route, routeBuilder](
+				testSettings, routev1.Install, defaultName, defaultNamespace)
+			assert.Nil(t, err)
+		} else {
+			testBuilder = buildValidRouteBuilder(testSettings)
+		}
+
+		if testCase.concurrentChange {
+			concurrentBuilder, err := PullNamespacedBuilder[routev1.Route, routeBuilder](
+				testSettings, routev1.Install, defaultName, defaultNamespace)
+			assert.Nil(t, err)
+
+			concurrentBuilder.Definition.Labels = map[string]string{"concurrent": "true"}
+
+			err = Update(t.Context(), concurrentBuilder)
+			assert.Nil(t, err)
+		}
+
+		if testCase.refreshed {
+			assert.True(t, Exists(t.Context(), testBuilder))
+		}
+
+		ctx, cancel := context.WithCancel(t.Context())
+		if testCase.cancelled {
+			cancel()
+		}
+
+		testBuilder.Definition.Spec.Host = "test.example.com"
+
+		err := UpdateWithRetry(ctx, testBuilder, retry.DefaultRetry)
+
+		cancel()
+
+		if testCase.expectedErrorText != "" {
+			assert.EqualError(t, err, testCase.expectedErrorText)
+
+			continue
+		}
+
+		assert.Nil(t, err)
+
+		route, err := Get(t.Context(), testBuilder)
+		assert.Nil(t, err)
+		assert.Equal(t, "test.example.com", route.Spec.Host)
+		assert.Equal(t, testCase.expectedLabels, route.Labels)
+		assert.Equal(t, route.ResourceVersion, testBuilder.Definition.ResourceVersion)
+	}
+}
@@ -0,0 +1,507 @@
+package mco
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"path"
+	"slices"
+	"strings"
+
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	// DefaultIgnitionVersion is the Ignition version of the configs generated by the MCBuilder helpers when the
+	// MachineConfig has no config yet.
+	DefaultIgnitionVersion = "3.2.0"
+	// DefaultIgnitionFileMode is the mode of the files added with MCBuilder.WithFile when none is provided.
+	DefaultIgnitionFileMode = 0o644
+	// ignitionCoreUser is the only user whose configuration is supported by the machine-config-operator.
+	ignitionCoreUser = "core"
+)
+
+var (
+	// supportedIgnitionVersions are the Ignition spec versions accepted by the machine-config-operator.
+	supportedIgnitionVersions = []string{"3.0.0", "3.1.0", "3.2.0", "3.3.0", "3.4.0"}
+	// supportedIgnitionSchemes are the URL schemes Ignition can fetch the contents of a file from.
+	supportedIgnitionSchemes = []string{"data", "http", "https", "tftp", "s3", "gs", "arn"}
+	// systemdUnitSuffixes are the suffixes of the systemd unit types.
+	systemdUnitSuffixes = []string{".service", ".socket", ".device", ".mount", ".automount", ".swap", ".target",
+		".path", ".timer", ".slice", ".scope"}
+)
+
+// IgnitionFile is a file written on the nodes by a MachineConfig.
+type IgnitionFile struct {
+	// Path is the absolute path of the file on the nodes.
+	Path string
+	// Contents are the contents of the file, embedded in the config as a base64 data URL.
+	Contents []byte
+	// Source is the URL of the contents of the file, for example a data URL, used when Contents is empty.
+	Source string
+	// Mode is the permissions of the file, for example 0o600. DefaultIgnitionFileMode is used when zero.
+	Mode int
+	// User and Group are the names of the owners of the file, root when empty.
+	User  string
+	Group string
+}
+
+// ignitionConfig is the subset of the Ignition 3.x spec validated before a MachineConfig is created.
+type ignitionConfig struct {
+	Ignition struct {
+		Version string `json:"version"`
+	} `json:"ignition"`
+	Passwd struct {
+		Users []ignitionUser `json:"users,omitempty"`
+	} `json:"passwd,omitempty"`
+	Storage struct {
+		Files []ignitionFile `json:"files,omitempty"`
+	} `json:"storage,omitempty"`
+	Systemd struct {
+		Units []ignitionUnit `json:"units,omitempty"`
+	} `json:"systemd,omitempty"`
+}
+
+type ignitionUser struct {
+	Name              string   `json:"name"`
+	SSHAuthorizedKeys []string `json:"sshAuthorizedKeys,omitempty"`
+}
+
+type ignitionFile struct {
+	Path      string            `json:"path"`
+	Overwrite *bool             `json:"overwrite,omitempty"`
+	Contents  ignitionResource  `json:"contents,omitempty"`
+	Mode      *int              `json:"mode,omitempty"`
+	User      *ignitionNodeUser `json:"user,omitempty"`
+	Group     *ignitionNodeUser `json:"group,omitempty"`
+}
+
+type ignitionResource struct {
+	Source *string `json:"source,omitempty"`
+}
+
+type ignitionNodeUser struct {
+	Name string `json:"name,omitempty"`
+}
+
+type ignitionUnit struct {
+	Name     string           `json:"name"`
+	Enabled  *bool            `json:"enabled,omitempty"`
+	Contents *string          `json:"contents,omitempty"`
+	Dropins  []ignitionDropin `json:"dropins,omitempty"`
+}
+
+type ignitionDropin struct {
+	Name     string  `json:"name"`
+	Contents *string `json:"contents,omitempty"`
+}
+
+// ValidateIgnitionConfig checks that raw is an Ignition 3.x config the machine-config-operator can apply: a supported
+// spec version, files with absolute unique paths, valid modes and sources, systemd units and drop-ins with valid
+// unique names and SSH keys of the core user only.
+func ValidateIgnitionConfig(raw []byte) error {
+	config := &ignitionConfig{}
+
+	if err := json.Unmarshal(raw, config); err != nil {
+		return fmt.Errorf("invalid ignition config: %w", err)
+	}
+
+	if config.Ignition.Version == "" {
+		return fmt.Errorf("ignition config 'ignition.version' cannot be empty")
+	}
+
+	if !slices.Contains(supportedIgnitionVersions, config.Ignition.Version) {
+		return fmt.Errorf("unsupported ignition version %s, supported versions are %v",
+			config.Ignition.Version, supportedIgnitionVersions)
+	}
+
+	if err := validateIgnitionFiles(config.Storage.Files); err != nil {
+		return err
+	}
+
+	if err := validateIgnitionUnits(config.Systemd.Units); err != nil {
+		return err
+	}
+
+	return validateIgnitionUsers(config.Passwd.Users)
+}
+
+// validateIgnitionFiles checks the paths, modes and sources of files.
+func validateIgnitionFiles(files []ignitionFile) error {
+	paths := map[string]bool{}
+
+	for _, file := range files {
+		if err := validateIgnitionFilePath(file.Path); err != nil {
+			return err
+		}
+
+		if paths[file.Path] {
+			return fmt.Errorf("ignition file %s is defined more than once", file.Path)
+		}
+
+		paths[file.Path] = true
+
+		if file.Mode != nil && (*file.Mode < 0 || *file.Mode > 0o7777) {
+			return fmt.Errorf("ignition file %s has invalid mode %o", file.Path, *file.Mode)
+		}
+
+		if file.Contents.Source != nil {
+			if err := validateIgnitionSource(*file.Contents.Source); err != nil {
+				return fmt.Errorf("ignition file %s has invalid source: %w", file.Path, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateIgnitionFilePath checks that filePath is an absolute and clean path.
+func validateIgnitionFilePath(filePath string) error {
+	if filePath == "" {
+		return fmt.Errorf("ignition file 'path' cannot be empty")
+	}
+
+	if !path.IsAbs(filePath) || path.Clean(filePath) != filePath {
+		return fmt.Errorf("ignition file path %s must be absolute and clean", filePath)
+	}
+
+	return nil
+}
+
+// validateIgnitionSource checks that source is a URL Ignition can fetch and, for data URLs, that it can be decoded.
+func validateIgnitionSource(source string) error {
+	if source == "" {
+		return nil
+	}
+
+	sourceURL, err := url.Parse(source)
+	if err != nil {
+		return err
+	}
+
+	if !slices.Contains(supportedIgnitionSchemes, sourceURL.Scheme) {
+		return fmt.Errorf("unsupported scheme %q, supported schemes are %v", sourceURL.Scheme, supportedIgnitionSchemes)
+	}
+
+	if sourceURL.Scheme == "data" {
+		_, err = decodeDataURL(source)
+	}
+
+	return err
+}
+
+// decodeDataURL returns the data embedded in an RFC 2397 data URL.
+func decodeDataURL(dataURL string) ([]byte, error) {
+	header, data, found := strings.Cut(strings.TrimPrefix(dataURL, "data:"), ",")
+	if !found {
+		return nil, fmt.Errorf("data url is missing the ',' separator")
+	}
+
+	if strings.HasSuffix(header, ";base64") {
+		decoded, err := base64.StdEncoding.DecodeString(data)
+		if err != nil {
+			return nil, fmt.Errorf("invalid base64 data url: %w", err)
+		}
+
+		return decoded, nil
+	}
+
+	decoded, err := url.PathUnescape(data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid data url: %w", err)
+	}
+
+	return []byte(decoded), nil
+}
+
+// validateIgnitionUnits checks the names of the systemd units and their drop-ins.
+func validateIgnitionUnits(units []ignitionUnit) error {
+	names := map[string]bool{}
+
+	for _, unit := range units {
+		if err := validateSystemdUnitName(unit.Name); err != nil {
+			return err
+		}
+
+		if names[unit.Name] {
+			return fmt.Errorf("systemd unit %s is defined more than once", unit.Name)
+		}
+
+		names[unit.Name] = true
+		dropinNames := map[string]bool{}
+
+		for _, dropin := range unit.Dropins {
+			if err := validateSystemdDropInName(dropin.Name); err != nil {
+				return fmt.Errorf("systemd unit %s: %w", unit.Name, err)
+			}
+
+			if dropinNames[dropin.Name] {
+				return fmt.Errorf("systemd unit %s drop-in %s is defined more than once", unit.Name, dropin.Name)
+			}
+
+			dropinNames[dropin.Name] = true
+		}
+	}
+
+	return nil
+}
+
+// validateSystemdUnitName checks that name is the file name of a systemd unit.
+func validateSystemdUnitName(name string) error {
+	if name == "" {
+		return fmt.Errorf("systemd unit 'name' cannot be empty")
+	}
+
+	if strings.Contains(name, "/") || !slices.ContainsFunc(systemdUnitSuffixes, func(suffix string) bool {
+		return strings.HasSuffix(name, suffix) && len(name) > len(suffix)
+	}) {
+		return fmt.Errorf("invalid systemd unit name %s, it must end with one of %v", name, systemdUnitSuffixes)
+	}
+
+	return nil
+}
+
+// validateSystemdDropInName checks that name is the file name of a systemd drop-in.
+func validateSystemdDropInName(name string) error {
+	if name == "" {
+		return fmt.Errorf("systemd drop-in 'name' cannot be empty")
+	}
+
+	if strings.Contains(name, "/") || !strings.HasSuffix(name, ".conf") || name == ".conf" {
+		return fmt.Errorf("invalid systemd drop-in name %s, it must end with .conf", name)
+	}
+
+	return nil
+}
+
+// validateIgnitionUsers checks that only the core user is configured and that its SSH keys can be parsed.
+func validateIgnitionUsers(users []ignitionUser) error {
+	for _, user := range users {
+		if user.Name != ignitionCoreUser {
+			return fmt.Errorf("ignition user %q is not supported, only the %s user can be configured",
+				user.Name, ignitionCoreUser)
+		}
+
+		for _, key := range user.SSHAuthorizedKeys {
+			if _, _, _, _, err := ssh.ParseAuthorizedKey([]byte(key)); err != nil {
+				return fmt.Errorf("invalid ssh authorized key of user %s: %w", user.Name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// updateIgnitionConfig applies update to the raw Ignition config and returns the result. The config is handled as
+// generic JSON so that the sections and fields the helpers do not manage are preserved. An empty config is created
+// with DefaultIgnitionVersion.
+func updateIgnitionConfig(raw []byte, update func(config map[string]any) error) ([]byte, error) {
+	config := map[string]any{}
+
+	if len(raw) > 0 {
+		if err := json.Unmarshal(raw, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the ignition config: %w", err)
+		}
+	}
+
+	metadata, err := getIgnitionObject(config, "ignition")
+	if err != nil {
+		return nil, err
+	}
+
+	if version, _ := metadata["version"].(string); version == "" {
+		metadata["version"] = DefaultIgnitionVersion
+	}
+
+	if err = update(config); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(config)
+}
+
+// getIgnitionObject returns the object in field key of parent, creating it if it does not exist.
+func getIgnitionObject(parent map[string]any, key string) (map[string]any, error) {
+	if parent[key] == nil {
+		parent[key] = map[string]any{}
+	}
+
+	object, ok := parent[key].(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("ignition config field %s is not an object", key)
+	}
+
+	return object, nil
+}
+
+// getIgnitionList returns the list in field key of the object in field section of config.
+func getIgnitionList(config map[string]any, section, key string) (map[string]any, []any, error) {
+	object, err := getIgnitionObject(config, section)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if object[key] == nil {
+		return object, nil, nil
+	}
+
+	list, ok := object[key].([]any)
+	if !ok {
+		return nil, nil, fmt.Errorf("ignition config field %s.%s is not a list", section, key)
+	}
+
+	return object, list, nil
+}
+
+// findIgnitionEntry returns the object of list whose field is value and its index, or nil and -1.
+func findIgnitionEntry(list []any, field, value string) (map[string]any, int) {
+	for index, item := range list {
+		entry, ok := item.(map[string]any)
+		if ok && entry[field] == value {
+			return entry, index
+		}
+	}
+
+	return nil, -1
+}
+
+// toIgnitionEntry returns the generic JSON representation of value.
+func toIgnitionEntry(value any) (map[string]any, error) {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	entry := map[string]any{}
+	err = json.Unmarshal(encoded, &entry)
+
+	return entry, err
+}
+
+// setIgnitionFile adds file to config, replacing the file with the same path if any.
+func setIgnitionFile(config map[string]any, file IgnitionFile) error {
+	overwrite := true
+	source := file.Source
+	mode := file.Mode
+
+	if len(file.Contents) > 0 {
+		source = "data:;base64," + base64.StdEncoding.EncodeToString(file.Contents)
+	}
+
+	if source == "" {
+		source = "data:,"
+	}
+
+	if mode == 0 {
+		mode = DefaultIgnitionFileMode
+	}
+
+	definition := ignitionFile{
+		Path: file.Path, Overwrite: &overwrite, Contents: ignitionResource{Source: &source}, Mode: &mode}
+
+	if file.User != "" {
+		definition.User = &ignitionNodeUser{Name: file.User}
+	}
+
+	if file.Group != "" {
+		definition.Group = &ignitionNodeUser{Name: file.Group}
+	}
+
+	entry, err := toIgnitionEntry(definition)
+	if err != nil {
+		return err
+	}
+
+	storage, files, err := getIgnitionList(config, "storage", "files")
+	if err != nil {
+		return err
+	}
+
+	if _, index := findIgnitionEntry(files, "path", file.Path); index >= 0 {
+		files[index] = entry
+	} else {
+		files = append(files, entry)
+	}
+
+	storage["files"] = files
+
+	return nil
+}
+
+// getIgnitionUnit returns the systemd unit with the given name from config, adding it if it does not exist.
+func getIgnitionUnit(config map[string]any, name string) (map[string]any, error) {
+	systemd, units, err := getIgnitionList(config, "systemd", "units")
+	if err != nil {
+		return nil, err
+	}
+
+	unit, _ := findIgnitionEntry(units, "name", name)
+	if unit == nil {
+		unit = map[string]any{"name": name}
+		systemd["units"] = append(units, unit)
+	}
+
+	return unit, nil
+}
+
+// setIgnitionDropIn adds the drop-in to the unit, replacing the drop-in with the same name if any.
+func setIgnitionDropIn(unit map[string]any, name, contents string) error {
+	var dropins []any
+
+	if unit["dropins"] != nil {
+		var ok bool
+
+		dropins, ok = unit["dropins"].([]any)
+		if !ok {
+			return fmt.Errorf("ignition config field dropins of systemd unit %v is not a list", unit["name"])
+		}
+	}
+
+	entry := map[string]any{"name": name, "contents": contents}
+
+	if _, index := findIgnitionEntry(dropins, "name", name); index >= 0 {
+		dropins[index] = entry
+	} else {
+		dropins = append(dropins, entry)
+	}
+
+	unit["dropins"] = dropins
+
+	return nil
+}
+
+// addIgnitionSSHKeys adds the keys missing from the authorized keys of the core user of config.
+func addIgnitionSSHKeys(config map[string]any, keys []string) error {
+	passwd, users, err := getIgnitionList(config, "passwd", "users")
+	if err != nil {
+		return err
+	}
+
+	user, _ := findIgnitionEntry(users, "name", ignitionCoreUser)
+	if user == nil {
+		user = map[string]any{"name": ignitionCoreUser}
+		passwd["users"] = append(users, user)
+	}
+
+	var authorizedKeys []any
+
+	if user["sshAuthorizedKeys"] != nil {
+		var ok bool
+
+		authorizedKeys, ok = user["sshAuthorizedKeys"].([]any)
+		if !ok {
+			return fmt.Errorf("ignition config field sshAuthorizedKeys of user %s is not a list", ignitionCoreUser)
+		}
+	}
+
+	for _, key := range keys {
+		if !slices.Contains(authorizedKeys, any(key)) {
+			authorizedKeys = append(authorizedKeys, key)
+		}
+	}
+
+	user["sshAuthorizedKeys"] = authorizedKeys
+
+	return nil
+}
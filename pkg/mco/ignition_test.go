@@ -0,0 +1,94 @@
+package mco
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+//nolint:funlen
+func TestValidateIgnitionConfig(t *testing.T) {
+	testCases := []struct {
+		config        string
+		expectedError error
+	}{
+		{
+			config: `{"ignition":{"version":"3.2.0"},` +
+				`"storage":{"files":[{"path":"/etc/test","mode":420,"contents":{"source":"data:;base64,dGVzdA=="}}]},` +
+				`"systemd":{"units":[{"name":"test.service","enabled":true,"contents":"[Unit]",` +
+				`"dropins":[{"name":"10-test.conf","contents":"[Service]"}]}]},` +
+				`"passwd":{"users":[{"name":"core","sshAuthorizedKeys":["` + defaultSSHAuthorizedKey + `"]}]}}`,
+		},
+		{
+			config:        `{"ignition":{"version":"3.2.0"}`,
+			expectedError: fmt.Errorf("invalid ignition config: unexpected end of JSON input"),
+		},
+		{
+			config:        `{"storage":{}}`,
+			expectedError: fmt.Errorf("ignition config 'ignition.version' cannot be empty"),
+		},
+		{
+			config:        `{"ignition":{"version":"3.2.0"},"storage":{"files":[{"path":"/etc/test"},{"path":"/etc/test"}]}}`,
+			expectedError: fmt.Errorf("ignition file /etc/test is defined more than once"),
+		},
+		{
+			config:        `{"ignition":{"version":"3.2.0"},"storage":{"files":[{"path":"/etc/../test"}]}}`,
+			expectedError: fmt.Errorf("ignition file path /etc/../test must be absolute and clean"),
+		},
+		{
+			config: `{"ignition":{"version":"3.2.0"},` +
+				`"storage":{"files":[{"path":"/etc/test","contents":{"source":"data:;base64,%%%"}}]}}`,
+			expectedError: fmt.Errorf("ignition file /etc/test has invalid source: invalid base64 data url: " +
+				"illegal base64 data at input byte 0"),
+		},
+		{
+			config:        `{"ignition":{"version":"3.2.0"},"storage":{"files":[{"path":"/etc/test","mode":8192}]}}`,
+			expectedError: fmt.Errorf("ignition file /etc/test has invalid mode 20000"),
+		},
+		{
+			config:        `{"ignition":{"version":"3.2.0"},"systemd":{"units":[{"name":"a.service"},{"name":"a.service"}]}}`,
+			expectedError: fmt.Errorf("systemd unit a.service is defined more than once"),
+		},
+		{
+			config: `{"ignition":{"version":"3.2.0"},"systemd":{"units":[{"name":"a.service",` +
+				`"dropins":[{"name":"10-a.conf"},{"name":"10-a.conf"}]}]}}`,
+			expectedError: fmt.Errorf("systemd unit a.service drop-in 10-a.conf is defined more than once"),
+		},
+		{
+			config:        `{"ignition":{"version":"3.2.0"},"passwd":{"users":[{"name":"root"}]}}`,
+			expectedError: fmt.Errorf("ignition user \"root\" is not supported, only the core user can be configured"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		err := ValidateIgnitionConfig([]byte(testCase.config))
+
+		if testCase.expectedError == nil {
+			assert.Nil(t, err)
+		} else {
+			assert.EqualError(t, err, testCase.expectedError.Error())
+		}
+	}
+}
+
+func TestDecodeDataURL(t *testing.T) {
+	testCases := []struct {
+		dataURL       string
+		expectedData  string
+		expectedError error
+	}{
+		{dataURL: "data:,test%20data", expectedData: "test data"},
+		{dataURL: "data:text/plain;base64,dGVzdA==", expectedData: "test"},
+		{dataURL: "data:test", expectedError: fmt.Errorf("data url is missing the ',' separator")},
+	}
+
+	for _, testCase := range testCases {
+		data, err := decodeDataURL(testCase.dataURL)
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, testCase.expectedData, string(data))
+		}
+	}
+}
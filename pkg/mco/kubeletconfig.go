@@ -113,6 +113,11 @@ func PullKubeletConfig(apiClient *clients.Settings, name string) (*KubeletConfig
 
 // Get returns the KubeletConfig object if found.
 func (builder *KubeletConfigBuilder) Get() (*mcv1.KubeletConfig, error) {
+	return builder.GetWithContext(context.Background())
+}
+
+// GetWithContext returns the KubeletConfig object if found using the provided context.
+func (builder *KubeletConfigBuilder) GetWithContext(ctx context.Context) (*mcv1.KubeletConfig, error) {
 	if valid, err := builder.validate(); !valid {
 		return nil, err
 	}
@@ -120,7 +125,7 @@ func (builder *KubeletConfigBuilder) Get() (*mcv1.KubeletConfig, error) {
 	glog.V(100).Infof("Getting KubeletConfig object %s", builder.Definition.Name)
 
 	kubeletConfig := &mcv1.KubeletConfig{}
-	err := builder.apiClient.Get(context.TODO(), runtimeclient.ObjectKey{Name: builder.Definition.Name}, kubeletConfig)
+	err := builder.apiClient.Get(ctx, runtimeclient.ObjectKey{Name: builder.Definition.Name}, kubeletConfig)
 
 	if err != nil {
 		glog.V(100).Infof("KubeletConfig object %s does not exist", builder.Definition.Name)
@@ -133,6 +138,12 @@ func (builder *KubeletConfigBuilder) Get() (*mcv1.KubeletConfig, error) {
 
 // Create generates a kubeletconfig in the cluster and stores the created object in struct.
 func (builder *KubeletConfigBuilder) Create() (*KubeletConfigBuilder, error) {
+	return builder.CreateWithContext(context.Background())
+}
+
+// CreateWithContext generates a kubeletconfig in the cluster using the provided context and stores the created object
+// in struct.
+func (builder *KubeletConfigBuilder) CreateWithContext(ctx context.Context) (*KubeletConfigBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
@@ -140,8 +151,8 @@ func (builder *KubeletConfigBuilder) Create() (*KubeletConfigBuilder, error) {
 	glog.V(100).Infof("Creating KubeletConfig %s", builder.Definition.Name)
 
 	var err error
-	if !builder.Exists() {
-		err := builder.apiClient.Create(context.TODO(), builder.Definition)
+	if !builder.ExistsWithContext(ctx) {
+		err := builder.apiClient.Create(ctx, builder.Definition)
 		if err == nil {
 			builder.Object = builder.Definition
 		}
@@ -152,13 +163,18 @@ func (builder *KubeletConfigBuilder) Create() (*KubeletConfigBuilder, error) {
 
 // Delete removes the kubeletconfig.
 func (builder *KubeletConfigBuilder) Delete() error {
+	return builder.DeleteWithContext(context.Background())
+}
+
+// DeleteWithContext removes the kubeletconfig using the provided context.
+func (builder *KubeletConfigBuilder) DeleteWithContext(ctx context.Context) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
 
 	glog.V(100).Infof("Deleting the kubeletconfig object %s", builder.Definition.Name)
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		glog.V(100).Infof("KubeletConfig %s cannot be deleted because it does not exist", builder.Definition.Name)
 
 		builder.Object = nil
@@ -166,7 +182,7 @@ func (builder *KubeletConfigBuilder) Delete() error {
 		return nil
 	}
 
-	err := builder.apiClient.Delete(context.TODO(), builder.Object)
+	err := builder.apiClient.Delete(ctx, builder.Object)
 	if err != nil {
 		return fmt.Errorf("cannot delete kubeletconfig: %w", err)
 	}
@@ -178,6 +194,11 @@ func (builder *KubeletConfigBuilder) Delete() error {
 
 // Exists checks whether the given kubeletconfig exists.
 func (builder *KubeletConfigBuilder) Exists() bool {
+	return builder.ExistsWithContext(context.Background())
+}
+
+// ExistsWithContext checks whether the given kubeletconfig exists using the provided context.
+func (builder *KubeletConfigBuilder) ExistsWithContext(ctx context.Context) bool {
 	if valid, _ := builder.validate(); !valid {
 		return false
 	}
@@ -185,7 +206,7 @@ func (builder *KubeletConfigBuilder) Exists() bool {
 	glog.V(100).Infof("Checking if the kubeletconfig object %s exists", builder.Definition.Name)
 
 	var err error
-	builder.Object, err = builder.Get()
+	builder.Object, err = builder.GetWithContext(ctx)
 
 	return err == nil || !k8serrors.IsNotFound(err)
 }
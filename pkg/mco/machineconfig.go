@@ -6,10 +6,12 @@ import (
 
 	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/common"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	mcv1 "github.com/openshift/api/machineconfiguration/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -20,6 +22,8 @@ type MCBuilder struct {
 	Definition *mcv1.MachineConfig
 	// Created MachineConfig object on the cluster.
 	Object *mcv1.MachineConfig
+	// base is the copy of the object the definition was last pulled, created or updated from. Exists does not refresh it.
+	base *mcv1.MachineConfig
 	// api client to interact with the cluster.
 	apiClient runtimeclient.Client
 	// errorMsg is processed before MachineConfig object is created.
@@ -103,13 +107,19 @@ func PullMachineConfig(apiClient *clients.Settings, name string) (*MCBuilder, er
 		return nil, fmt.Errorf("machineconfig object %s does not exist", name)
 	}
 
-	builder.Definition = builder.Object
+	builder.Definition = builder.Object.DeepCopy()
+	builder.base = builder.Object.DeepCopy()
 
 	return builder, nil
 }
 
 // Get returns the MachineConfig object if found.
 func (builder *MCBuilder) Get() (*mcv1.MachineConfig, error) {
+	return builder.GetWithContext(context.Background())
+}
+
+// GetWithContext returns the MachineConfig object if found using the provided context.
+func (builder *MCBuilder) GetWithContext(ctx context.Context) (*mcv1.MachineConfig, error) {
 	if valid, err := builder.validate(); !valid {
 		return nil, err
 	}
@@ -117,7 +127,7 @@ func (builder *MCBuilder) Get() (*mcv1.MachineConfig, error) {
 	glog.V(100).Infof("Getting MachineConfig object %s", builder.Definition.Name)
 
 	machineConfig := &mcv1.MachineConfig{}
-	err := builder.apiClient.Get(context.TODO(), runtimeclient.ObjectKey{Name: builder.Definition.Name}, machineConfig)
+	err := builder.apiClient.Get(ctx, runtimeclient.ObjectKey{Name: builder.Definition.Name}, machineConfig)
 
 	if err != nil {
 		glog.V(100).Infof("MachineConfig object %s does not exist", builder.Definition.Name)
@@ -130,6 +140,12 @@ func (builder *MCBuilder) Get() (*mcv1.MachineConfig, error) {
 
 // Create generates a machineconfig in the cluster and stores the created object in struct.
 func (builder *MCBuilder) Create() (*MCBuilder, error) {
+	return builder.CreateWithContext(context.Background())
+}
+
+// CreateWithContext generates a machineconfig in the cluster using the provided context and stores the created object
+// in struct.
+func (builder *MCBuilder) CreateWithContext(ctx context.Context) (*MCBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
@@ -137,10 +153,11 @@ func (builder *MCBuilder) Create() (*MCBuilder, error) {
 	glog.V(100).Infof("Creating MachineConfig %s", builder.Definition.Name)
 
 	var err error
-	if !builder.Exists() {
-		err := builder.apiClient.Create(context.TODO(), builder.Definition)
+	if !builder.ExistsWithContext(ctx) {
+		err := builder.apiClient.Create(ctx, builder.Definition)
 		if err == nil {
 			builder.Object = builder.Definition
+			builder.base = builder.Definition.DeepCopy()
 		}
 	}
 
@@ -149,13 +166,18 @@ func (builder *MCBuilder) Create() (*MCBuilder, error) {
 
 // Delete removes the machineconfig.
 func (builder *MCBuilder) Delete() error {
+	return builder.DeleteWithContext(context.Background())
+}
+
+// DeleteWithContext removes the machineconfig using the provided context.
+func (builder *MCBuilder) DeleteWithContext(ctx context.Context) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
 
 	glog.V(100).Infof("Deleting the MachineConfig object %s", builder.Definition.Name)
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		glog.V(100).Infof("MachineConfig %s cannot be deleted because it does not exist", builder.Definition.Name)
 
 		builder.Object = nil
@@ -163,7 +185,7 @@ func (builder *MCBuilder) Delete() error {
 		return nil
 	}
 
-	err := builder.apiClient.Delete(context.TODO(), builder.Definition)
+	err := builder.apiClient.Delete(ctx, builder.Definition)
 	if err != nil {
 		return fmt.Errorf("cannot delete machineconfig: %w", err)
 	}
@@ -175,22 +197,56 @@ func (builder *MCBuilder) Delete() error {
 
 // Update renovates the existing machineconfig object with machineconfig definition in builder.
 func (builder *MCBuilder) Update() (*MCBuilder, error) {
+	return builder.UpdateWithContext(context.Background())
+}
+
+// UpdateWithContext renovates the existing machineconfig object with machineconfig definition in builder using the
+// provided context.
+func (builder *MCBuilder) UpdateWithContext(ctx context.Context) (*MCBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
 
 	glog.V(100).Infof("Updating machineconfig %s", builder.Definition.Name)
 
-	err := builder.apiClient.Update(context.TODO(), builder.Definition)
+	err := builder.apiClient.Update(ctx, builder.Definition)
 	if err == nil {
 		builder.Object = builder.Definition
+		builder.base = builder.Definition.DeepCopy()
 	}
 
 	return builder, err
 }
 
+// UpdateWithRetry updates the machineconfig with merge semantics. If the machineconfig was modified on the cluster
+// since it was pulled, created or last updated, the changes made through the builder are re-applied to the latest
+// version and the update is retried according to backoff, for example retry.DefaultRetry.
+func (builder *MCBuilder) UpdateWithRetry(ctx context.Context, backoff wait.Backoff) (*MCBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	glog.V(100).Infof("Updating machineconfig %s with retry on conflict", builder.Definition.Name)
+
+	machineConfig, err := common.UpdateObjectWithRetry(ctx, builder.apiClient, backoff, builder.base, builder.Definition)
+	if err != nil {
+		return builder, err
+	}
+
+	builder.Object = machineConfig
+	builder.Definition = machineConfig.DeepCopy()
+	builder.base = machineConfig.DeepCopy()
+
+	return builder, nil
+}
+
 // Exists checks whether the given machineconfig exists.
 func (builder *MCBuilder) Exists() bool {
+	return builder.ExistsWithContext(context.Background())
+}
+
+// ExistsWithContext checks whether the given machineconfig exists using the provided context.
+func (builder *MCBuilder) ExistsWithContext(ctx context.Context) bool {
 	if valid, _ := builder.validate(); !valid {
 		return false
 	}
@@ -198,7 +254,7 @@ func (builder *MCBuilder) Exists() bool {
 	glog.V(100).Infof("Checking if the MachineConfig object %s exists", builder.Definition.Name)
 
 	var err error
-	builder.Object, err = builder.Get()
+	builder.Object, err = builder.GetWithContext(ctx)
 
 	return err == nil || !k8serrors.IsNotFound(err)
 }
@@ -350,6 +406,181 @@ func (builder *MCBuilder) WithRawConfig(config []byte) *MCBuilder {
 	return builder
 }
 
+// WithFile adds the file to the Ignition config of the MachineConfig, replacing any file with the same path. The
+// config is created with DefaultIgnitionVersion if the MachineConfig has none.
+func (builder *MCBuilder) WithFile(file IgnitionFile) *MCBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Adding file %s with mode %o to MachineConfig %s", file.Path, file.Mode, builder.Definition.Name)
+
+	if err := validateIgnitionFilePath(file.Path); err != nil {
+		builder.errorMsg = err.Error()
+
+		return builder
+	}
+
+	if len(file.Contents) > 0 && file.Source != "" {
+		builder.errorMsg = fmt.Sprintf("ignition file %s cannot have both 'contents' and 'source'", file.Path)
+
+		return builder
+	}
+
+	if err := validateIgnitionSource(file.Source); err != nil {
+		builder.errorMsg = fmt.Sprintf("ignition file %s has invalid source: %s", file.Path, err)
+
+		return builder
+	}
+
+	if file.Mode < 0 || file.Mode > 0o7777 {
+		builder.errorMsg = fmt.Sprintf("ignition file %s has invalid mode %o", file.Path, file.Mode)
+
+		return builder
+	}
+
+	return builder.updateConfig(func(config map[string]any) error {
+		return setIgnitionFile(config, file)
+	})
+}
+
+// WithSystemdUnit adds the systemd unit with the given contents to the Ignition config of the MachineConfig, replacing
+// the contents of any unit with the same name while keeping its drop-ins. The unit is enabled if enabled is true.
+func (builder *MCBuilder) WithSystemdUnit(name, contents string, enabled bool) *MCBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Adding systemd unit %s enabled: %v to MachineConfig %s", name, enabled, builder.Definition.Name)
+
+	if err := validateSystemdUnitName(name); err != nil {
+		builder.errorMsg = err.Error()
+
+		return builder
+	}
+
+	if contents == "" {
+		builder.errorMsg = fmt.Sprintf("systemd unit %s 'contents' cannot be empty", name)
+
+		return builder
+	}
+
+	return builder.updateConfig(func(config map[string]any) error {
+		unit, err := getIgnitionUnit(config, name)
+		if err != nil {
+			return err
+		}
+
+		unit["contents"] = contents
+		unit["enabled"] = enabled
+
+		return nil
+	})
+}
+
+// WithSystemdDropIn adds the drop-in with the given contents to the systemd unit unitName in the Ignition config of the
+// MachineConfig, replacing any drop-in with the same name. The unit does not need to be defined by the MachineConfig,
+// which allows overriding the units shipped with the OS.
+func (builder *MCBuilder) WithSystemdDropIn(unitName, dropInName, contents string) *MCBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Adding drop-in %s of systemd unit %s to MachineConfig %s",
+		dropInName, unitName, builder.Definition.Name)
+
+	if err := validateSystemdUnitName(unitName); err != nil {
+		builder.errorMsg = err.Error()
+
+		return builder
+	}
+
+	if err := validateSystemdDropInName(dropInName); err != nil {
+		builder.errorMsg = err.Error()
+
+		return builder
+	}
+
+	if contents == "" {
+		builder.errorMsg = fmt.Sprintf("systemd drop-in %s 'contents' cannot be empty", dropInName)
+
+		return builder
+	}
+
+	return builder.updateConfig(func(config map[string]any) error {
+		unit, err := getIgnitionUnit(config, unitName)
+		if err != nil {
+			return err
+		}
+
+		return setIgnitionDropIn(unit, dropInName, contents)
+	})
+}
+
+// WithSSHAuthorizedKeys adds the SSH public keys to the authorized keys of the core user in the Ignition config of the
+// MachineConfig.
+func (builder *MCBuilder) WithSSHAuthorizedKeys(keys ...string) *MCBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	glog.V(100).Infof("Adding %d ssh authorized keys to MachineConfig %s", len(keys), builder.Definition.Name)
+
+	if len(keys) == 0 {
+		builder.errorMsg = "'keys' cannot be empty"
+
+		return builder
+	}
+
+	if err := validateIgnitionUsers([]ignitionUser{{Name: ignitionCoreUser, SSHAuthorizedKeys: keys}}); err != nil {
+		builder.errorMsg = err.Error()
+
+		return builder
+	}
+
+	return builder.updateConfig(func(config map[string]any) error {
+		return addIgnitionSSHKeys(config, keys)
+	})
+}
+
+// updateConfig applies update to the Ignition config of the MachineConfig, setting the error message of the builder if
+// the config cannot be updated.
+func (builder *MCBuilder) updateConfig(update func(config map[string]any) error) *MCBuilder {
+	config, err := updateIgnitionConfig(builder.Definition.Spec.Config.Raw, update)
+	if err != nil {
+		glog.V(100).Infof("Failed to update the ignition config of MachineConfig %s: %v", builder.Definition.Name, err)
+
+		builder.errorMsg = err.Error()
+
+		return builder
+	}
+
+	builder.Definition.Spec.Config.Raw = config
+
+	return builder
+}
+
+// Validate checks the Ignition config of the MachineConfig, if any, without accessing the cluster, so that a
+// malformed config can be rejected before it degrades a MachineConfigPool. Only Ignition 3.x configs pass, while the
+// machine-config-operator also converts 2.x configs, so Create and Update do not call it.
+func (builder *MCBuilder) Validate() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	if len(builder.Definition.Spec.Config.Raw) == 0 {
+		return nil
+	}
+
+	if err := ValidateIgnitionConfig(builder.Definition.Spec.Config.Raw); err != nil {
+		glog.V(100).Infof("MachineConfig %s has an invalid ignition config: %v", builder.Definition.Name, err)
+
+		return fmt.Errorf("machineconfig %s has an invalid config: %w", builder.Definition.Name, err)
+	}
+
+	return nil
+}
+
 func (builder *MCBuilder) validate() (bool, error) {
 	resourceCRD := "MachineConfig"
 
@@ -1,6 +1,7 @@
 package mco
 
 import (
+	"encoding/json"
 	"fmt"
 	"testing"
 
@@ -11,7 +12,11 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
-const defaultMachineConfigName = "test-machine-config"
+const (
+	defaultMachineConfigName = "test-machine-config"
+	defaultSSHAuthorizedKey  = "ssh-ed25519 " +
+		"AAAAC3NzaC1lZDI1NTE5AAAAIGbxDL8y4i3buu4a/FofW8AXyqaNAiJdiQpYlpqKfjPP test@example.com"
+)
 
 var testSchemes = []clients.SchemeAttacher{
 	mcv1.Install,
@@ -170,11 +175,21 @@ func TestMachineConfigCreate(t *testing.T) {
 			testBuilder:   buildValidMachineConfigTestBuilder(clients.GetTestClients(clients.TestClientParams{})),
 			expectedError: nil,
 		},
+		{
+			testBuilder: buildValidMachineConfigTestBuilder(clients.GetTestClients(clients.TestClientParams{})).
+				WithRawConfig([]byte(`{"ignition":{"version":"2.2.0"}}`)),
+			expectedError: nil,
+		},
 	}
 
 	for _, testCase := range testCases {
 		testBuilder, err := testCase.testBuilder.Create()
-		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Nil(t, err)
+		} else {
+			assert.EqualError(t, err, testCase.expectedError.Error())
+		}
 
 		if testCase.expectedError == nil {
 			assert.Equal(t, testBuilder.Definition.Name, testBuilder.Object.Name)
@@ -182,6 +197,41 @@ func TestMachineConfigCreate(t *testing.T) {
 	}
 }
 
+func TestMachineConfigValidate(t *testing.T) {
+	testCases := []struct {
+		testBuilder   *MCBuilder
+		expectedError string
+	}{
+		{
+			testBuilder: buildValidMachineConfigTestBuilder(buildTestClientWithDummyMachineConfig()),
+		},
+		{
+			testBuilder: buildValidMachineConfigTestBuilder(buildTestClientWithDummyMachineConfig()).
+				WithRawConfig([]byte(`{"ignition":{"version":"3.2.0"}}`)),
+		},
+		{
+			testBuilder: buildValidMachineConfigTestBuilder(buildTestClientWithDummyMachineConfig()).
+				WithRawConfig([]byte(`{"ignition":{"version":"2.2.0"}}`)),
+			expectedError: fmt.Sprintf("machineconfig %s has an invalid config: unsupported ignition version 2.2.0, "+
+				"supported versions are %v", defaultMachineConfigName, supportedIgnitionVersions),
+		},
+		{
+			testBuilder:   buildInvalidMachineConfigTestBuilder(buildTestClientWithDummyMachineConfig()),
+			expectedError: "machineconfig 'name' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		err := testCase.testBuilder.Validate()
+
+		if testCase.expectedError == "" {
+			assert.Nil(t, err)
+		} else {
+			assert.EqualError(t, err, testCase.expectedError)
+		}
+	}
+}
+
 func TestMachineConfigUpdate(t *testing.T) {
 	testCases := []struct {
 		testBuilder   *MCBuilder
@@ -454,6 +504,206 @@ func TestMachineConfigWithKernelType(t *testing.T) {
 	}
 }
 
+func TestMachineConfigWithFile(t *testing.T) {
+	testCases := []struct {
+		file           IgnitionFile
+		expectedSource string
+		expectedMode   float64
+		expectedError  string
+	}{
+		{
+			file:           IgnitionFile{Path: "/etc/test.conf", Contents: []byte("test"), Mode: 0o600, User: "core"},
+			expectedSource: "data:;base64,dGVzdA==",
+			expectedMode:   0o600,
+		},
+		{
+			file:           IgnitionFile{Path: "/etc/test.conf", Source: "data:,test%20file"},
+			expectedSource: "data:,test%20file",
+			expectedMode:   DefaultIgnitionFileMode,
+		},
+		{
+			file:          IgnitionFile{Path: "etc/test.conf"},
+			expectedError: "ignition file path etc/test.conf must be absolute and clean",
+		},
+		{
+			file:          IgnitionFile{Path: "/etc/test.conf", Contents: []byte("test"), Source: "data:,test"},
+			expectedError: "ignition file /etc/test.conf cannot have both 'contents' and 'source'",
+		},
+		{
+			file: IgnitionFile{Path: "/etc/test.conf", Source: "ftp://example.com/test"},
+			expectedError: fmt.Sprintf("ignition file /etc/test.conf has invalid source: unsupported scheme \"ftp\", "+
+				"supported schemes are %v", supportedIgnitionSchemes),
+		},
+		{
+			file:          IgnitionFile{Path: "/etc/test.conf", Mode: 0o10000},
+			expectedError: "ignition file /etc/test.conf has invalid mode 10000",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidMachineConfigTestBuilder(clients.GetTestClients(clients.TestClientParams{})).
+			WithRawConfig([]byte(`{"ignition":{"version":"3.4.0"},"storage":{"files":[{"path":"/etc/test.conf"}]}}`))
+		testBuilder = testBuilder.WithFile(testCase.file)
+
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError != "" {
+			continue
+		}
+
+		config := getTestIgnitionConfig(t, testBuilder)
+		assert.Equal(t, "3.4.0", config["ignition"].(map[string]any)["version"])
+
+		files := config["storage"].(map[string]any)["files"].([]any)
+		assert.Len(t, files, 1)
+
+		file := files[0].(map[string]any)
+		assert.Equal(t, testCase.file.Path, file["path"])
+		assert.Equal(t, testCase.expectedSource, file["contents"].(map[string]any)["source"])
+		assert.Equal(t, testCase.expectedMode, file["mode"])
+		assert.Nil(t, ValidateIgnitionConfig(testBuilder.Definition.Spec.Config.Raw))
+	}
+}
+
+func TestMachineConfigWithSystemdUnit(t *testing.T) {
+	testCases := []struct {
+		name          string
+		contents      string
+		expectedError string
+	}{
+		{
+			name:     "test.service",
+			contents: "[Unit]\nDescription=test",
+		},
+		{
+			name:          "test",
+			contents:      "[Unit]\nDescription=test",
+			expectedError: fmt.Sprintf("invalid systemd unit name test, it must end with one of %v", systemdUnitSuffixes),
+		},
+		{
+			name:          "test.service",
+			expectedError: "systemd unit test.service 'contents' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidMachineConfigTestBuilder(clients.GetTestClients(clients.TestClientParams{}))
+		testBuilder = testBuilder.WithSystemdDropIn("test.service", "10-test.conf", "[Service]\nRestart=always").
+			WithSystemdUnit(testCase.name, testCase.contents, true)
+
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError != "" {
+			continue
+		}
+
+		config := getTestIgnitionConfig(t, testBuilder)
+		assert.Equal(t, DefaultIgnitionVersion, config["ignition"].(map[string]any)["version"])
+
+		units := config["systemd"].(map[string]any)["units"].([]any)
+		assert.Len(t, units, 1)
+
+		unit := units[0].(map[string]any)
+		assert.Equal(t, testCase.contents, unit["contents"])
+		assert.Equal(t, true, unit["enabled"])
+		assert.Len(t, unit["dropins"], 1)
+		assert.Nil(t, ValidateIgnitionConfig(testBuilder.Definition.Spec.Config.Raw))
+	}
+}
+
+func TestMachineConfigWithSystemdDropIn(t *testing.T) {
+	testCases := []struct {
+		unitName      string
+		dropInName    string
+		contents      string
+		expectedError string
+	}{
+		{
+			unitName:   "kubelet.service",
+			dropInName: "20-test.conf",
+			contents:   "[Service]\nEnvironment=TEST=1",
+		},
+		{
+			unitName:      "kubelet.service",
+			dropInName:    "20-test",
+			contents:      "[Service]\nEnvironment=TEST=1",
+			expectedError: "invalid systemd drop-in name 20-test, it must end with .conf",
+		},
+		{
+			unitName:      "kubelet.service",
+			dropInName:    "20-test.conf",
+			expectedError: "systemd drop-in 20-test.conf 'contents' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidMachineConfigTestBuilder(clients.GetTestClients(clients.TestClientParams{}))
+		testBuilder = testBuilder.WithSystemdDropIn(testCase.unitName, testCase.dropInName, "[Service]").
+			WithSystemdDropIn(testCase.unitName, testCase.dropInName, testCase.contents)
+
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError != "" {
+			continue
+		}
+
+		config := getTestIgnitionConfig(t, testBuilder)
+		unit := config["systemd"].(map[string]any)["units"].([]any)[0].(map[string]any)
+		assert.Equal(t, testCase.unitName, unit["name"])
+		assert.Nil(t, unit["contents"])
+		assert.Equal(t, []any{map[string]any{"name": testCase.dropInName, "contents": testCase.contents}},
+			unit["dropins"])
+		assert.Nil(t, ValidateIgnitionConfig(testBuilder.Definition.Spec.Config.Raw))
+	}
+}
+
+func TestMachineConfigWithSSHAuthorizedKeys(t *testing.T) {
+	testCases := []struct {
+		keys          []string
+		expectedError string
+	}{
+		{
+			keys: []string{defaultSSHAuthorizedKey},
+		},
+		{
+			keys:          []string{},
+			expectedError: "'keys' cannot be empty",
+		},
+		{
+			keys:          []string{"ssh-rsa invalid"},
+			expectedError: "invalid ssh authorized key of user core: ssh: no key found",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidMachineConfigTestBuilder(clients.GetTestClients(clients.TestClientParams{}))
+		testBuilder = testBuilder.WithSSHAuthorizedKeys(testCase.keys...).WithSSHAuthorizedKeys(testCase.keys...)
+
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError != "" {
+			continue
+		}
+
+		config := getTestIgnitionConfig(t, testBuilder)
+		user := config["passwd"].(map[string]any)["users"].([]any)[0].(map[string]any)
+		assert.Equal(t, "core", user["name"])
+		assert.Equal(t, []any{defaultSSHAuthorizedKey}, user["sshAuthorizedKeys"])
+		assert.Nil(t, ValidateIgnitionConfig(testBuilder.Definition.Spec.Config.Raw))
+	}
+}
+
+// getTestIgnitionConfig returns the ignition config of the MCBuilder as generic JSON.
+func getTestIgnitionConfig(t *testing.T, builder *MCBuilder) map[string]any {
+	t.Helper()
+
+	config := map[string]any{}
+	err := json.Unmarshal(builder.Definition.Spec.Config.Raw, &config)
+	assert.Nil(t, err)
+
+	return config
+}
+
 // buildDummyMachineConfig returns a MachineConfig with the provided name.
 func buildDummyMachineConfig(name string) *mcv1.MachineConfig {
 	return &mcv1.MachineConfig{
@@ -12,6 +12,12 @@ import (
 
 // ListMC returns a list of builders for MachineConfigs.
 func ListMC(apiClient *clients.Settings, options ...runtimeclient.ListOptions) ([]*MCBuilder, error) {
+	return ListMCWithContext(context.Background(), apiClient, options...)
+}
+
+// ListMCWithContext returns a list of builders for MachineConfigs using the provided context.
+func ListMCWithContext(
+	ctx context.Context, apiClient *clients.Settings, options ...runtimeclient.ListOptions) ([]*MCBuilder, error) {
 	if apiClient == nil {
 		glog.V(100).Info("MachineConfig 'apiClient' can not be empty")
 
@@ -42,7 +48,7 @@ func ListMC(apiClient *clients.Settings, options ...runtimeclient.ListOptions) (
 	glog.V(100).Infof(logMessage)
 
 	mcList := new(mcv1.MachineConfigList)
-	err = apiClient.List(context.TODO(), mcList, &passedOptions)
+	err = apiClient.List(ctx, mcList, &passedOptions)
 
 	if err != nil {
 		glog.V(100).Info("Failed to list MC objects due to %s", err.Error())
@@ -58,6 +64,7 @@ func ListMC(apiClient *clients.Settings, options ...runtimeclient.ListOptions) (
 			apiClient:  apiClient.Client,
 			Object:     &copiedMc,
 			Definition: &copiedMc,
+			base:       copiedMc.DeepCopy(),
 		}
 
 		mcObjects = append(mcObjects, mcBuilder)
@@ -14,7 +14,8 @@ import (
 	mcv1 "github.com/openshift/api/machineconfiguration/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/waiter"
 )
 
 const (
@@ -29,7 +30,7 @@ type MCPBuilder struct {
 	// Created MachineConfigPool object on the cluster.
 	Object *mcv1.MachineConfigPool
 	// api client to interact with the cluster.
-	apiClient runtimeclient.Client
+	apiClient *clients.Settings
 	// errorMsg is processed before MachineConfigPool object is created.
 	errorMsg string
 }
@@ -56,7 +57,7 @@ func NewMCPBuilder(apiClient *clients.Settings, mcpName string) *MCPBuilder {
 	}
 
 	builder := &MCPBuilder{
-		apiClient: apiClient.Client,
+		apiClient: apiClient,
 		Definition: &mcv1.MachineConfigPool{
 			ObjectMeta: metav1.ObjectMeta{
 				Name: mcpName,
@@ -93,7 +94,7 @@ func Pull(apiClient *clients.Settings, name string) (*MCPBuilder, error) {
 	}
 
 	builder := &MCPBuilder{
-		apiClient: apiClient.Client,
+		apiClient: apiClient,
 		Definition: &mcv1.MachineConfigPool{
 			ObjectMeta: metav1.ObjectMeta{
 				Name: name,
@@ -118,6 +119,11 @@ func Pull(apiClient *clients.Settings, name string) (*MCPBuilder, error) {
 
 // Get returns the MachineConfigPool object if found.
 func (builder *MCPBuilder) Get() (*mcv1.MachineConfigPool, error) {
+	return builder.GetWithContext(context.Background())
+}
+
+// GetWithContext returns the MachineConfigPool object if found, using the provided context.
+func (builder *MCPBuilder) GetWithContext(ctx context.Context) (*mcv1.MachineConfigPool, error) {
 	if valid, err := builder.validate(); !valid {
 		return nil, err
 	}
@@ -125,7 +131,7 @@ func (builder *MCPBuilder) Get() (*mcv1.MachineConfigPool, error) {
 	glog.V(100).Infof("Getting MachineConfigPool object %s", builder.Definition.Name)
 
 	machineConfigPool := &mcv1.MachineConfigPool{}
-	err := builder.apiClient.Get(context.TODO(), runtimeclient.ObjectKey{Name: builder.Definition.Name}, machineConfigPool)
+	err := builder.apiClient.Get(ctx, runtimeclient.ObjectKey{Name: builder.Definition.Name}, machineConfigPool)
 
 	if err != nil {
 		glog.V(100).Infof("MachineConfigPool object %s does not exist", builder.Definition.Name)
@@ -138,6 +144,12 @@ func (builder *MCPBuilder) Get() (*mcv1.MachineConfigPool, error) {
 
 // Create makes a MachineConfigPool in cluster and stores the created object in struct.
 func (builder *MCPBuilder) Create() (*MCPBuilder, error) {
+	return builder.CreateWithContext(context.Background())
+}
+
+// CreateWithContext makes a MachineConfigPool in cluster using the provided context and stores the created object in
+// struct.
+func (builder *MCPBuilder) CreateWithContext(ctx context.Context) (*MCPBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
@@ -145,9 +157,13 @@ func (builder *MCPBuilder) Create() (*MCPBuilder, error) {
 	glog.V(100).Infof("Creating the MachineConfigPool %s",
 		builder.Definition.Name)
 
+	if err := ctx.Err(); err != nil {
+		return builder, err
+	}
+
 	var err error
-	if !builder.Exists() {
-		err = builder.apiClient.Create(context.TODO(), builder.Definition)
+	if !builder.ExistsWithContext(ctx) {
+		err = builder.apiClient.Create(ctx, builder.Definition)
 		if err == nil {
 			builder.Object = builder.Definition
 		}
@@ -158,6 +174,11 @@ func (builder *MCPBuilder) Create() (*MCPBuilder, error) {
 
 // Delete removes a MachineConfigPool object from a cluster.
 func (builder *MCPBuilder) Delete() error {
+	return builder.DeleteWithContext(context.Background())
+}
+
+// DeleteWithContext removes a MachineConfigPool object from a cluster using the provided context.
+func (builder *MCPBuilder) DeleteWithContext(ctx context.Context) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
@@ -165,7 +186,7 @@ func (builder *MCPBuilder) Delete() error {
 	glog.V(100).Infof("Deleting the MachineConfigPool object %s",
 		builder.Definition.Name)
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		glog.V(100).Infof("MachineConfigPool %s cannot be deleted because it does not exist", builder.Definition.Name)
 
 		builder.Object = nil
@@ -173,7 +194,7 @@ func (builder *MCPBuilder) Delete() error {
 		return nil
 	}
 
-	err := builder.apiClient.Delete(context.TODO(), builder.Object)
+	err := builder.apiClient.Delete(ctx, builder.Object)
 	if err != nil {
 		return fmt.Errorf("cannot delete machineconfigpool: %w", err)
 	}
@@ -185,6 +206,11 @@ func (builder *MCPBuilder) Delete() error {
 
 // Exists checks whether the given MachineConfigPool exists.
 func (builder *MCPBuilder) Exists() bool {
+	return builder.ExistsWithContext(context.Background())
+}
+
+// ExistsWithContext checks whether the given MachineConfigPool exists using the provided context.
+func (builder *MCPBuilder) ExistsWithContext(ctx context.Context) bool {
 	if valid, _ := builder.validate(); !valid {
 		return false
 	}
@@ -193,7 +219,7 @@ func (builder *MCPBuilder) Exists() bool {
 		builder.Definition.Name)
 
 	var err error
-	builder.Object, err = builder.Get()
+	builder.Object, err = builder.GetWithContext(ctx)
 
 	return err == nil || !k8serrors.IsNotFound(err)
 }
@@ -228,6 +254,17 @@ func (builder *MCPBuilder) WaitToBeInCondition(
 	conditionType mcv1.MachineConfigPoolConditionType,
 	conditionStatus corev1.ConditionStatus,
 	timeout time.Duration,
+) error {
+	return builder.WaitToBeInConditionWithContext(context.Background(), conditionType, conditionStatus, timeout)
+}
+
+// WaitToBeInConditionWithContext waits for a specific time duration until the MachineConfigPool will have a
+// specified condition type with the expected status. The wait is aborted once ctx is done.
+func (builder *MCPBuilder) WaitToBeInConditionWithContext(
+	ctx context.Context,
+	conditionType mcv1.MachineConfigPoolConditionType,
+	conditionStatus corev1.ConditionStatus,
+	timeout time.Duration,
 ) error {
 	if valid, err := builder.validate(); !valid {
 		return err
@@ -236,25 +273,24 @@ func (builder *MCPBuilder) WaitToBeInCondition(
 	glog.V(100).Infof("WaitToBeInCondition waits up to specified time duration %v until "+
 		"MachineConfigPool condition %v is met", timeout, conditionType)
 
-	return wait.PollUntilContextTimeout(
-		context.TODO(), fiveScds, timeout, true, func(ctx context.Context) (bool, error) {
-			mcp, err := builder.Get()
-			if err != nil {
-				return false, nil
-			}
+	_, err := builder.waitFor(ctx, waiter.Options{
+		Description: fmt.Sprintf("MachineConfigPool %s to have condition %s with status %s",
+			builder.Definition.Name, conditionType, conditionStatus),
+		Timeout: timeout,
+	}, func(mcp *mcv1.MachineConfigPool) (bool, error) {
+		return hasCondition(mcp, conditionType, conditionStatus), nil
+	})
 
-			for _, condition := range mcp.Status.Conditions {
-				if condition.Type == conditionType && condition.Status == conditionStatus {
-					return true, nil
-				}
-			}
-
-			return false, nil
-		})
+	return err
 }
 
 // WaitForUpdate waits for a MachineConfigPool to be updating and then updated.
 func (builder *MCPBuilder) WaitForUpdate(timeout time.Duration) error {
+	return builder.WaitForUpdateWithContext(context.Background(), timeout)
+}
+
+// WaitForUpdateWithContext waits for a MachineConfigPool to be updating and then updated or until ctx is done.
+func (builder *MCPBuilder) WaitForUpdateWithContext(ctx context.Context, timeout time.Duration) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
@@ -262,40 +298,34 @@ func (builder *MCPBuilder) WaitForUpdate(timeout time.Duration) error {
 	glog.V(100).Infof("WaitForUpdate waits up to specified time %v until updating"+
 		" machineConfigPool object is updated", timeout)
 
-	mcpUpdating, err := builder.Get()
+	mcpUpdating, err := builder.GetWithContext(ctx)
 	if err != nil {
 		return err
 	}
 
-	for _, condition := range mcpUpdating.Status.Conditions {
-		if condition.Type == "Updating" && condition.Status == corev1.ConditionTrue {
-			err := wait.PollUntilContextTimeout(
-				context.TODO(), fiveScds, timeout, true, func(ctx context.Context) (bool, error) {
-					mcpUpdated, err := builder.Get()
-					if err != nil {
-						return false, nil
-					}
-
-					for _, condition := range mcpUpdated.Status.Conditions {
-						if condition.Type == "Updated" && condition.Status == corev1.ConditionTrue {
-							return true, nil
-						}
-					}
-
-					return false, nil
-				})
-
-			if err != nil {
-				return err
-			}
-		}
+	if !hasCondition(mcpUpdating, mcv1.MachineConfigPoolUpdating, corev1.ConditionTrue) {
+		return nil
 	}
 
-	return nil
+	_, err = builder.waitFor(ctx, waiter.Options{
+		Description: fmt.Sprintf("MachineConfigPool %s to be updated", builder.Definition.Name),
+		Timeout:     timeout,
+	}, func(mcp *mcv1.MachineConfigPool) (bool, error) {
+		return hasCondition(mcp, mcv1.MachineConfigPoolUpdated, corev1.ConditionTrue), nil
+	})
+
+	return err
 }
 
 // WaitToBeStableFor waits on MachineConfigPool to stable for a time duration or until timeout.
 func (builder *MCPBuilder) WaitToBeStableFor(stableDuration time.Duration, timeout time.Duration) error {
+	return builder.WaitToBeStableForWithContext(context.Background(), stableDuration, timeout)
+}
+
+// WaitToBeStableForWithContext waits on MachineConfigPool to stable for a time duration, until timeout or until ctx
+// is done. The MachineConfigPool is stable when all its machines are ready and updated and none is degraded.
+func (builder *MCPBuilder) WaitToBeStableForWithContext(
+	ctx context.Context, stableDuration time.Duration, timeout time.Duration) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
@@ -303,59 +333,11 @@ func (builder *MCPBuilder) WaitToBeStableFor(stableDuration time.Duration, timeo
 	glog.V(100).Infof("WaitToBeStableFor waits up to duration of %v for "+
 		"MachineConfigPool to be stable for %v", timeout, stableDuration)
 
-	isMcpStable := true
-
-	// Wait 5 secs in each iteration before condition function () returns true or errors
-	// or times out after stableDuration
-	err := wait.PollUntilContextTimeout(
-		context.TODO(), fiveScds, timeout, true, func(ctx context.Context) (bool, error) {
-			isMcpStable = true
-
-			_ = wait.PollUntilContextTimeout(
-				context.TODO(), fiveScds, stableDuration, true, func(ctx2 context.Context) (done bool, err error) {
-					if !builder.Exists() {
-						return false, nil
-					}
-
-					if builder.Object.Status.ReadyMachineCount != builder.Object.Status.MachineCount ||
-						builder.Object.Status.MachineCount != builder.Object.Status.UpdatedMachineCount ||
-						builder.Object.Status.DegradedMachineCount != 0 {
-						glog.V(100).Infof("MachineConfigPool: %v degraded and has a mismatch in "+
-							"machineCount: %v "+"vs machineCountUpdated: "+"%v vs readyMachineCount: %v and "+
-							"degradedMachineCount is : %v \n", builder.Object.Name,
-							builder.Object.Status.MachineCount, builder.Object.Status.UpdatedMachineCount,
-							builder.Object.Status.ReadyMachineCount, builder.Object.Status.DegradedMachineCount)
-
-						isMcpStable = false
-
-						return true, nil
-					}
-
-					return false, nil
-				})
-
-			if isMcpStable {
-				glog.V(100).Infof("MachineConfigPool was stable during during stableDuration: %v",
-					stableDuration)
-
-				// this will exit the outer wait.PollUntilContextTimeout block since the mcp was stable during stableDuration
-				return true, nil
-			}
-
-			glog.V(100).Infof("MachineConfigPool was not stable during stableDuration: %v, retrying ...",
-				stableDuration)
-
-			// keep iterating in the outer wait.PollUntilContextTimeout waiting for cluster to be stable
-			return false, nil
-		})
-
-	// After the timout in outer wait.PollUntilContextTimeout.
-	if err == nil {
-		glog.V(100).Infof("Cluster was stable during stableDuration: %v", stableDuration)
-	} else {
-		// Here err is "timed out waiting for the condition"
-		glog.V(100).Infof("Cluster was Un-stable during stableDuration: %v", stableDuration)
-	}
+	_, err := builder.waitFor(ctx, waiter.Options{
+		Description: fmt.Sprintf("MachineConfigPool %s to be stable for %v", builder.Definition.Name, stableDuration),
+		Timeout:     timeout,
+		HoldFor:     stableDuration,
+	}, isStable)
 
 	return err
 }
@@ -406,6 +388,52 @@ func (builder *MCPBuilder) IsInCondition(mcpConditionType mcv1.MachineConfigPool
 	return false
 }
 
+// waitFor waits until the MachineConfigPool satisfies condition according to options and updates the builder object.
+func (builder *MCPBuilder) waitFor(ctx context.Context, options waiter.Options,
+	condition waiter.Condition[*mcv1.MachineConfigPool]) (*mcv1.MachineConfigPool, error) {
+	mcp, err := waiter.For(ctx, builder.newSource(), options, condition)
+	if err == nil {
+		builder.Object = mcp
+	}
+
+	return mcp, err
+}
+
+// newSource returns the waiter source of the MachineConfigPool, including its events. It is built from the runtime
+// client rather than the client settings since only the former supports watches.
+func (builder *MCPBuilder) newSource() waiter.Source[*mcv1.MachineConfigPool] {
+	return waiter.WithEvents(
+		waiter.NewRuntimeSource[mcv1.MachineConfigPool](builder.apiClient.Client, "", builder.Definition.Name),
+		builder.apiClient, "MachineConfigPool", "")
+}
+
+// hasCondition returns true if mcp has the condition of type conditionType with status conditionStatus.
+func hasCondition(mcp *mcv1.MachineConfigPool,
+	conditionType mcv1.MachineConfigPoolConditionType, conditionStatus corev1.ConditionStatus) bool {
+	for _, condition := range mcp.Status.Conditions {
+		if condition.Type == conditionType && condition.Status == conditionStatus {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isStable returns true if all the machines of mcp are ready and updated and none is degraded.
+func isStable(mcp *mcv1.MachineConfigPool) (bool, error) {
+	if mcp.Status.ReadyMachineCount != mcp.Status.MachineCount ||
+		mcp.Status.MachineCount != mcp.Status.UpdatedMachineCount ||
+		mcp.Status.DegradedMachineCount != 0 {
+		glog.V(100).Infof("MachineConfigPool %s is not stable: machineCount: %d, updatedMachineCount: %d, "+
+			"readyMachineCount: %d, degradedMachineCount: %d", mcp.Name, mcp.Status.MachineCount,
+			mcp.Status.UpdatedMachineCount, mcp.Status.ReadyMachineCount, mcp.Status.DegradedMachineCount)
+
+		return false, nil
+	}
+
+	return true, nil
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *MCPBuilder) validate() (bool, error) {
@@ -2,6 +2,7 @@ package mco
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 	"time"
@@ -306,7 +307,11 @@ func TestMachineConfigPoolWaitToBeInCondition(t *testing.T) {
 		testBuilder := buildMCPBuilderWithUpdatingCondition(testCase.exists, testCase.hasCondition, testCase.valid)
 		err := testBuilder.WaitToBeInCondition(updatingMCPCondition.Type, updatingMCPCondition.Status, time.Second)
 
-		assert.Equal(t, testCase.expectedError, err)
+		if errors.Is(testCase.expectedError, context.DeadlineExceeded) {
+			assert.ErrorIs(t, err, context.DeadlineExceeded)
+		} else {
+			assert.Equal(t, testCase.expectedError, err)
+		}
 	}
 }
 
@@ -324,10 +329,13 @@ func TestMachineConfigPoolWaitForUpdate(t *testing.T) {
 			expectedError: nil,
 		},
 		{
-			valid:         true,
-			exists:        true,
-			updating:      true,
-			expectedError: context.DeadlineExceeded,
+			valid:    true,
+			exists:   true,
+			updating: true,
+			expectedError: fmt.Errorf(
+				"timed out waiting for MachineConfigPool test-machine-config-pool to be updated: context deadline exceeded " +
+					"(status: degradedMachineCount=0, machineCount=0, readyMachineCount=0, unavailableMachineCount=0, " +
+					"updatedMachineCount=0; conditions: Updating=True)"),
 		},
 		{
 			valid:         false,
@@ -356,6 +364,47 @@ func TestMachineConfigPoolWaitForUpdate(t *testing.T) {
 	}
 }
 
+func TestMachineConfigPoolWaitForUpdateWithContext(t *testing.T) {
+	testBuilder := buildMCPBuilderWithUpdatingCondition(true, true, true)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	err := testBuilder.WaitForUpdateWithContext(ctx, time.Minute)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestMachineConfigPoolWaitForUpdateSimulated(t *testing.T) {
+	mcp := buildDummyMCP(defaultMCPName)
+	mcp.Status.Conditions = []mcv1.MachineConfigPoolCondition{updatingMCPCondition}
+
+	testSettings := clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects:  []runtime.Object{mcp},
+		SchemeAttachers: testSchemes,
+		Simulators: []clients.ControllerSimulator{{
+			Object:   &mcv1.MachineConfigPool{},
+			Triggers: []clients.SimulatorTrigger{clients.SimulatorTriggerRead},
+			Reconcile: clients.SimulateSequence(
+				func(object runtime.Object) {},
+				func(object runtime.Object) {
+					object.(*mcv1.MachineConfigPool).Status.Conditions = []mcv1.MachineConfigPoolCondition{
+						{Type: mcv1.MachineConfigPoolUpdating, Status: corev1.ConditionFalse},
+						{Type: mcv1.MachineConfigPoolUpdated, Status: corev1.ConditionTrue},
+					}
+				}),
+		}},
+	})
+
+	err := buildValidMCPTestBuilder(testSettings).WaitForUpdate(time.Second)
+	assert.Nil(t, err)
+}
+
+func TestMachineConfigPoolNewSource(t *testing.T) {
+	source := buildValidMCPTestBuilder(buildTestClientWithDummyMCP()).newSource()
+	assert.NotNil(t, source.Watch)
+	assert.NotNil(t, source.Events)
+}
+
 func TestMachineConfigPoolWaitToBeStableFor(t *testing.T) {
 	testCases := []struct {
 		valid         bool
@@ -379,7 +428,7 @@ func TestMachineConfigPoolWaitToBeStableFor(t *testing.T) {
 			valid:         true,
 			exists:        false,
 			stable:        true,
-			expectedError: nil,
+			expectedError: context.DeadlineExceeded,
 		},
 		{
 			valid:         true,
@@ -417,7 +466,12 @@ func TestMachineConfigPoolWaitToBeStableFor(t *testing.T) {
 		}
 
 		err := testBuilder.WaitToBeStableFor(500*time.Millisecond, time.Second)
-		assert.Equal(t, testCase.expectedError, err)
+
+		if errors.Is(testCase.expectedError, context.DeadlineExceeded) {
+			assert.ErrorIs(t, err, context.DeadlineExceeded)
+		} else {
+			assert.Equal(t, testCase.expectedError, err)
+		}
 	}
 }
 
@@ -15,6 +15,12 @@ import (
 
 // ListMCP returns a list of MachineConfigPoolBuilder.
 func ListMCP(apiClient *clients.Settings, options ...runtimeclient.ListOptions) ([]*MCPBuilder, error) {
+	return ListMCPWithContext(context.Background(), apiClient, options...)
+}
+
+// ListMCPWithContext returns a list of MachineConfigPoolBuilder using the provided context.
+func ListMCPWithContext(
+	ctx context.Context, apiClient *clients.Settings, options ...runtimeclient.ListOptions) ([]*MCPBuilder, error) {
 	if apiClient == nil {
 		glog.V(100).Info("MachineConfigPool 'apiClient' can not be empty")
 
@@ -45,7 +51,7 @@ func ListMCP(apiClient *clients.Settings, options ...runtimeclient.ListOptions)
 	glog.V(100).Infof(logMessage)
 
 	mcpList := new(mcv1.MachineConfigPoolList)
-	err = apiClient.List(context.TODO(), mcpList, &passedOptions)
+	err = apiClient.List(ctx, mcpList, &passedOptions)
 
 	if err != nil {
 		glog.V(100).Infof("Failed to list MCP objects due to %s", err.Error())
@@ -58,7 +64,7 @@ func ListMCP(apiClient *clients.Settings, options ...runtimeclient.ListOptions)
 	for _, mcp := range mcpList.Items {
 		copiedMcp := mcp
 		mcpBuilder := &MCPBuilder{
-			apiClient:  apiClient.Client,
+			apiClient:  apiClient,
 			Object:     &copiedMcp,
 			Definition: &copiedMcp,
 		}
@@ -72,9 +78,19 @@ func ListMCP(apiClient *clients.Settings, options ...runtimeclient.ListOptions)
 // ListMCPByMachineConfigSelector returns a list of MachineConfigurationPoolBuilders for given selector.
 func ListMCPByMachineConfigSelector(
 	apiClient *clients.Settings, mcpLabel string, options ...runtimeclient.ListOptions) (*MCPBuilder, error) {
+	return ListMCPByMachineConfigSelectorWithContext(context.Background(), apiClient, mcpLabel, options...)
+}
+
+// ListMCPByMachineConfigSelectorWithContext returns a list of MachineConfigurationPoolBuilders for given selector using
+// the provided context.
+func ListMCPByMachineConfigSelectorWithContext(
+	ctx context.Context,
+	apiClient *clients.Settings,
+	mcpLabel string,
+	options ...runtimeclient.ListOptions) (*MCPBuilder, error) {
 	glog.V(100).Infof("GetByLabel returns MachineConfigPool with the specified label: %v", mcpLabel)
 
-	mcpList, err := ListMCP(apiClient, options...)
+	mcpList, err := ListMCPWithContext(ctx, apiClient, options...)
 
 	if err != nil {
 		return nil, err
@@ -106,6 +122,16 @@ func ListMCPByMachineConfigSelector(
 // ListMCPWaitToBeStableFor waits for a given MachineConfigurationPool to be stable for a given period.
 func ListMCPWaitToBeStableFor(
 	apiClient *clients.Settings, stableDuration, timeout time.Duration, options ...runtimeclient.ListOptions) error {
+	return ListMCPWaitToBeStableForWithContext(context.Background(), apiClient, stableDuration, timeout, options...)
+}
+
+// ListMCPWaitToBeStableForWithContext waits for a given MachineConfigurationPool to be stable for a given period,
+// aborting the wait once ctx is done.
+func ListMCPWaitToBeStableForWithContext(
+	ctx context.Context,
+	apiClient *clients.Settings,
+	stableDuration, timeout time.Duration,
+	options ...runtimeclient.ListOptions) error {
 	if apiClient == nil {
 		glog.V(100).Info("MachineConfigPool 'apiClient' can not be empty")
 
@@ -120,13 +146,13 @@ func ListMCPWaitToBeStableFor(
 	// Wait 5 secs in each iteration before condition function () returns true or errors or times out
 	// after stableDuration
 	err := wait.PollUntilContextTimeout(
-		context.TODO(), fiveScds, timeout, true, func(ctx context.Context) (bool, error) {
+		ctx, fiveScds, timeout, true, func(ctx context.Context) (bool, error) {
 			isMcpListStable = true
 
 			// check if cluster is stable every 5 seconds during entire stableDuration time period
 			// Here we need to run through the entire stableDuration till it times out.
 			_ = wait.PollUntilContextTimeout(
-				context.TODO(), fiveScds, stableDuration, true, func(ctx2 context.Context) (done bool, err error) {
+				ctx, fiveScds, stableDuration, true, func(ctx2 context.Context) (done bool, err error) {
 					mcpList, err := ListMCP(apiClient, options...)
 
 					if err != nil {
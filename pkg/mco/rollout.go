@@ -0,0 +1,305 @@
+package mco
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/nodes"
+	"github.com/openshift-kni/eco-goinfra/pkg/waiter"
+	mcv1 "github.com/openshift/api/machineconfiguration/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// nodeCurrentConfigAnnotation is set by the machine-config-daemon to the rendered config applied to the node.
+	nodeCurrentConfigAnnotation = "machineconfiguration.openshift.io/currentConfig"
+	// nodeDesiredConfigAnnotation is set by the machine-config-controller to the rendered config the node must apply.
+	nodeDesiredConfigAnnotation = "machineconfiguration.openshift.io/desiredConfig"
+	// nodeStateAnnotation is set by the machine-config-daemon to the state of the update of the node.
+	nodeStateAnnotation = "machineconfiguration.openshift.io/state"
+	// nodeReasonAnnotation is set by the machine-config-daemon to the reason of a degraded node.
+	nodeReasonAnnotation = "machineconfiguration.openshift.io/reason"
+)
+
+// NodeRolloutState is the state of a node during the rollout of a MachineConfigPool.
+type NodeRolloutState string
+
+const (
+	// NodeRolloutPending means the node has not started applying the target config of the pool yet.
+	NodeRolloutPending NodeRolloutState = "Pending"
+	// NodeRolloutWorking means the machine-config-daemon is applying the desired config to the node.
+	NodeRolloutWorking NodeRolloutState = "Working"
+	// NodeRolloutDone means the node runs the target config of the pool.
+	NodeRolloutDone NodeRolloutState = "Done"
+	// NodeRolloutDegraded means the machine-config-daemon failed to apply the desired config to the node.
+	NodeRolloutDegraded NodeRolloutState = "Degraded"
+)
+
+// RolloutOptions configures MCPBuilder.TrackRollout.
+type RolloutOptions struct {
+	// Timeout is the maximum duration of the rollout. The rollout is only bounded by ctx when zero.
+	Timeout time.Duration
+	// PollInterval is the interval between two observations of the pool and its nodes, waiter.DefaultPollInterval
+	// when zero.
+	PollInterval time.Duration
+	// StuckTimeout is the duration after which a node still Working is considered stuck and the rollout fails. Stuck
+	// nodes are not detected when zero.
+	StuckTimeout time.Duration
+}
+
+// NodeRolloutStatus is the last observed state of a node of the MachineConfigPool.
+type NodeRolloutStatus struct {
+	Name          string
+	CurrentConfig string
+	DesiredConfig string
+	State         NodeRolloutState
+	// Reason is the reason reported by the machine-config-daemon for a Degraded node.
+	Reason string
+	// Since is the time the node was first observed in State.
+	Since time.Time
+}
+
+// NodeRolloutTransition is a change of state of a node observed during the rollout.
+type NodeRolloutTransition struct {
+	Time          time.Time
+	Node          string
+	From          NodeRolloutState
+	To            NodeRolloutState
+	CurrentConfig string
+	Reason        string
+}
+
+// String returns a short description of the transition, for example "worker-0: Pending -> Working".
+func (transition NodeRolloutTransition) String() string {
+	description := fmt.Sprintf("%s: %s -> %s", transition.Node, transition.From, transition.To)
+	if transition.From == "" {
+		description = fmt.Sprintf("%s: %s", transition.Node, transition.To)
+	}
+
+	if transition.Reason != "" {
+		description += fmt.Sprintf(" (%s)", transition.Reason)
+	}
+
+	return description
+}
+
+// MCPRollout is the progress of the rollout of a MachineConfigPool to its target config.
+type MCPRollout struct {
+	Pool string
+	// TargetConfig is the rendered config the nodes of the pool are updated to.
+	TargetConfig string
+	Start        time.Time
+	Duration     time.Duration
+	// Nodes are the nodes of the pool sorted by name.
+	Nodes []*NodeRolloutStatus
+	// Timeline lists the state transitions of the nodes in the order they were observed.
+	Timeline []NodeRolloutTransition
+}
+
+// DoneNodes returns the number of nodes of the pool running the target config.
+func (rollout *MCPRollout) DoneNodes() int {
+	done := 0
+
+	for _, node := range rollout.Nodes {
+		if node.State == NodeRolloutDone {
+			done++
+		}
+	}
+
+	return done
+}
+
+// String returns a human readable summary of the rollout and its timeline.
+func (rollout *MCPRollout) String() string {
+	var builder strings.Builder
+
+	fmt.Fprintf(&builder, "MachineConfigPool %s rollout to %s: %d/%d nodes done in %v\n",
+		rollout.Pool, rollout.TargetConfig, rollout.DoneNodes(), len(rollout.Nodes), rollout.Duration.Round(time.Second))
+
+	for _, transition := range rollout.Timeline {
+		fmt.Fprintf(&builder, "  +%v %s\n", transition.Time.Sub(rollout.Start).Round(time.Second), transition)
+	}
+
+	return builder.String()
+}
+
+// TrackRollout waits for the MachineConfigPool to roll out its target config to all its nodes and returns the
+// progress of every node. Unlike WaitForUpdate, it fails as soon as the pool or one of its nodes is degraded or a node
+// is stuck. The rollout is returned along with the error so that the progress made can be reported.
+func (builder *MCPBuilder) TrackRollout(options RolloutOptions) (*MCPRollout, error) {
+	return builder.TrackRolloutWithContext(context.Background(), options)
+}
+
+// TrackRolloutWithContext waits for the MachineConfigPool to roll out its target config to all its nodes or until ctx
+// is done. See TrackRollout.
+func (builder *MCPBuilder) TrackRolloutWithContext(ctx context.Context, options RolloutOptions) (*MCPRollout, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	glog.V(100).Infof("Tracking the rollout of MachineConfigPool %s for up to %v", builder.Definition.Name,
+		options.Timeout)
+
+	tracker := &rolloutTracker{
+		builder: builder,
+		options: options,
+		rollout: &MCPRollout{Pool: builder.Definition.Name, Start: time.Now()},
+		nodes:   map[string]*NodeRolloutStatus{},
+	}
+
+	// The source has no watch so that the nodes are observed on every poll, even when the pool does not change.
+	source := waiter.Source[*mcv1.MachineConfigPool]{
+		Name: fmt.Sprintf("MachineConfigPool %s", builder.Definition.Name),
+		Get:  builder.GetWithContext,
+	}
+
+	mcp, err := waiter.For(ctx, source, waiter.Options{
+		Description:  fmt.Sprintf("MachineConfigPool %s to roll out its target config", builder.Definition.Name),
+		Timeout:      options.Timeout,
+		PollInterval: options.PollInterval,
+	}, tracker.observe)
+
+	tracker.rollout.Duration = time.Since(tracker.rollout.Start)
+
+	if mcp != nil {
+		builder.Object = mcp
+	}
+
+	return tracker.rollout, err
+}
+
+// rolloutTracker records the progress of the nodes of a MachineConfigPool on every observation of the pool.
+type rolloutTracker struct {
+	builder *MCPBuilder
+	options RolloutOptions
+	rollout *MCPRollout
+	nodes   map[string]*NodeRolloutStatus
+}
+
+// observe updates the rollout with the state of mcp and its nodes. It returns true once all the nodes run the target
+// config and the pool is updated, and an error if the pool or one of its nodes is degraded or a node is stuck.
+func (tracker *rolloutTracker) observe(mcp *mcv1.MachineConfigPool) (bool, error) {
+	target := mcp.Spec.Configuration.Name
+	if target == "" {
+		target = mcp.Status.Configuration.Name
+	}
+
+	tracker.rollout.TargetConfig = target
+
+	if mcp.Spec.NodeSelector == nil {
+		return false, fmt.Errorf("machineconfigpool %s has no nodeSelector", mcp.Name)
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(mcp.Spec.NodeSelector)
+	if err != nil {
+		return false, fmt.Errorf("invalid nodeSelector of machineconfigpool %s: %w", mcp.Name, err)
+	}
+
+	nodeBuilders, err := nodes.List(tracker.builder.apiClient, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return false, fmt.Errorf("failed to list the nodes of machineconfigpool %s: %w", mcp.Name, err)
+	}
+
+	now := time.Now()
+	tracker.rollout.Nodes = nil
+
+	for _, nodeBuilder := range nodeBuilders {
+		tracker.rollout.Nodes = append(tracker.rollout.Nodes, tracker.observeNode(nodeBuilder.Object, target, now))
+	}
+
+	sort.Slice(tracker.rollout.Nodes, func(i, j int) bool {
+		return tracker.rollout.Nodes[i].Name < tracker.rollout.Nodes[j].Name
+	})
+
+	for _, node := range tracker.rollout.Nodes {
+		if node.State == NodeRolloutDegraded {
+			return false, fmt.Errorf("node %s of machineconfigpool %s is degraded: %s", node.Name, mcp.Name, node.Reason)
+		}
+
+		if node.State == NodeRolloutWorking && tracker.options.StuckTimeout > 0 &&
+			now.Sub(node.Since) > tracker.options.StuckTimeout {
+			return false, fmt.Errorf("node %s of machineconfigpool %s is stuck applying %s for more than %v",
+				node.Name, mcp.Name, node.DesiredConfig, tracker.options.StuckTimeout)
+		}
+	}
+
+	for _, conditionType := range []mcv1.MachineConfigPoolConditionType{
+		mcv1.MachineConfigPoolNodeDegraded, mcv1.MachineConfigPoolRenderDegraded, mcv1.MachineConfigPoolDegraded} {
+		for _, condition := range mcp.Status.Conditions {
+			if condition.Type == conditionType && condition.Status == corev1.ConditionTrue {
+				return false, fmt.Errorf("machineconfigpool %s is %s: %s: %s",
+					mcp.Name, conditionType, condition.Reason, condition.Message)
+			}
+		}
+	}
+
+	if tracker.rollout.DoneNodes() != len(tracker.rollout.Nodes) {
+		glog.V(100).Infof("MachineConfigPool %s rollout in progress: %d/%d nodes done",
+			mcp.Name, tracker.rollout.DoneNodes(), len(tracker.rollout.Nodes))
+
+		return false, nil
+	}
+
+	return mcp.Status.Configuration.Name == target && mcp.Status.UpdatedMachineCount == mcp.Status.MachineCount &&
+		hasCondition(mcp, mcv1.MachineConfigPoolUpdated, corev1.ConditionTrue), nil
+}
+
+// observeNode returns the status of node, recording a transition in the timeline if its state changed.
+func (tracker *rolloutTracker) observeNode(node *corev1.Node, target string, now time.Time) *NodeRolloutStatus {
+	status := &NodeRolloutStatus{
+		Name:          node.Name,
+		CurrentConfig: node.Annotations[nodeCurrentConfigAnnotation],
+		DesiredConfig: node.Annotations[nodeDesiredConfigAnnotation],
+		Since:         now,
+	}
+
+	status.State, status.Reason = getNodeRolloutState(node, target)
+
+	previous, found := tracker.nodes[node.Name]
+	if found && previous.State == status.State {
+		status.Since = previous.Since
+	} else {
+		transition := NodeRolloutTransition{
+			Time: now, Node: node.Name, To: status.State, CurrentConfig: status.CurrentConfig, Reason: status.Reason}
+
+		if found {
+			transition.From = previous.State
+		}
+
+		glog.V(100).Infof("Node %s of MachineConfigPool %s is %s", node.Name, tracker.rollout.Pool, status.State)
+
+		tracker.rollout.Timeline = append(tracker.rollout.Timeline, transition)
+	}
+
+	tracker.nodes[node.Name] = status
+
+	return status
+}
+
+// getNodeRolloutState returns the state of node during the rollout of target from the annotations of the
+// machine-config-daemon, along with the reason if the node is degraded.
+func getNodeRolloutState(node *corev1.Node, target string) (NodeRolloutState, string) {
+	switch node.Annotations[nodeStateAnnotation] {
+	case "Degraded", "Unreconcilable":
+		return NodeRolloutDegraded, node.Annotations[nodeReasonAnnotation]
+	case "Working":
+		return NodeRolloutWorking, ""
+	}
+
+	currentConfig := node.Annotations[nodeCurrentConfigAnnotation]
+	desiredConfig := node.Annotations[nodeDesiredConfigAnnotation]
+
+	switch {
+	case currentConfig == target && desiredConfig == target:
+		return NodeRolloutDone, ""
+	case desiredConfig != currentConfig:
+		return NodeRolloutWorking, ""
+	default:
+		return NodeRolloutPending, ""
+	}
+}
@@ -0,0 +1,271 @@
+package mco
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	mcv1 "github.com/openshift/api/machineconfiguration/v1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	defaultRolloutCurrentConfig = "rendered-worker-1"
+	defaultRolloutTargetConfig  = "rendered-worker-2"
+)
+
+//nolint:funlen
+func TestMachineConfigPoolTrackRollout(t *testing.T) {
+	testCases := []struct {
+		nodeSteps        [][]func(object runtime.Object)
+		mcpConditions    []mcv1.MachineConfigPoolCondition
+		options          RolloutOptions
+		valid            bool
+		expectedStates   []NodeRolloutState
+		expectedTimeline []string
+		expectedError    error
+	}{
+		{
+			nodeSteps: [][]func(object runtime.Object){
+				{setNodeWorking, setNodeDone},
+				{setNodePending, setNodeWorking, setNodeWorking, setNodeDone},
+			},
+			valid:          true,
+			expectedStates: []NodeRolloutState{NodeRolloutDone, NodeRolloutDone},
+			expectedTimeline: []string{
+				"worker-0: Working", "worker-1: Pending", "worker-0: Working -> Done", "worker-1: Pending -> Working",
+				"worker-1: Working -> Done"},
+		},
+		{
+			nodeSteps: [][]func(object runtime.Object){
+				{setNodeWorking, setNodeDegraded},
+				{setNodePending},
+			},
+			valid:          true,
+			expectedStates: []NodeRolloutState{NodeRolloutDegraded, NodeRolloutPending},
+			expectedTimeline: []string{
+				"worker-0: Working", "worker-1: Pending", "worker-0: Working -> Degraded (failed to drain node)"},
+			expectedError: fmt.Errorf("failed to wait for MachineConfigPool %s to roll out its target config: "+
+				"node worker-0 of machineconfigpool %s is degraded: failed to drain node", defaultMCPName, defaultMCPName),
+		},
+		{
+			nodeSteps: [][]func(object runtime.Object){
+				{setNodeWorking},
+				{setNodeDone},
+			},
+			options:          RolloutOptions{StuckTimeout: 50 * time.Millisecond},
+			valid:            true,
+			expectedStates:   []NodeRolloutState{NodeRolloutWorking, NodeRolloutDone},
+			expectedTimeline: []string{"worker-0: Working", "worker-1: Done"},
+			expectedError: fmt.Errorf("failed to wait for MachineConfigPool %s to roll out its target config: "+
+				"node worker-0 of machineconfigpool %s is stuck applying %s for more than 50ms",
+				defaultMCPName, defaultMCPName, defaultRolloutTargetConfig),
+		},
+		{
+			nodeSteps: [][]func(object runtime.Object){
+				{setNodeDone},
+				{setNodeDone},
+			},
+			mcpConditions: []mcv1.MachineConfigPoolCondition{{
+				Type: mcv1.MachineConfigPoolRenderDegraded, Status: corev1.ConditionTrue,
+				Reason: "RenderFailed", Message: "invalid ignition config"}},
+			valid:            true,
+			expectedStates:   []NodeRolloutState{NodeRolloutDone, NodeRolloutDone},
+			expectedTimeline: []string{"worker-0: Done", "worker-1: Done"},
+			expectedError: fmt.Errorf("failed to wait for MachineConfigPool %s to roll out its target config: "+
+				"machineconfigpool %s is RenderDegraded: RenderFailed: invalid ignition config",
+				defaultMCPName, defaultMCPName),
+		},
+		{
+			valid:         false,
+			expectedError: fmt.Errorf("machineconfigpool 'name' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := buildRolloutTestClient(testCase.mcpConditions, testCase.nodeSteps...)
+
+		testBuilder := buildValidMCPTestBuilder(testSettings)
+		if !testCase.valid {
+			testBuilder = buildInvalidMCPTestBuilder(testSettings)
+		}
+
+		testCase.options.Timeout = 5 * time.Second
+		testCase.options.PollInterval = 10 * time.Millisecond
+
+		rollout, err := testBuilder.TrackRollout(testCase.options)
+
+		if testCase.expectedError == nil {
+			assert.Nil(t, err)
+		} else {
+			assert.EqualError(t, err, testCase.expectedError.Error())
+		}
+
+		if !testCase.valid {
+			assert.Nil(t, rollout)
+
+			continue
+		}
+
+		assert.Equal(t, defaultRolloutTargetConfig, rollout.TargetConfig)
+		assert.Len(t, rollout.Nodes, len(testCase.expectedStates))
+
+		for index, node := range rollout.Nodes {
+			assert.Equal(t, fmt.Sprintf("worker-%d", index), node.Name)
+			assert.Equal(t, testCase.expectedStates[index], node.State)
+		}
+
+		var timeline []string
+		for _, transition := range rollout.Timeline {
+			timeline = append(timeline, transition.String())
+		}
+
+		assert.Equal(t, testCase.expectedTimeline, timeline)
+		assert.Contains(t, rollout.String(), fmt.Sprintf("MachineConfigPool %s rollout to %s", defaultMCPName,
+			defaultRolloutTargetConfig))
+	}
+}
+
+func TestMachineConfigPoolTrackRolloutTimeout(t *testing.T) {
+	testSettings := buildRolloutTestClient(nil, []func(object runtime.Object){setNodePending})
+
+	ctx, cancel := context.WithTimeout(t.Context(), 50*time.Millisecond)
+	defer cancel()
+
+	rollout, err := buildValidMCPTestBuilder(testSettings).TrackRolloutWithContext(
+		ctx, RolloutOptions{PollInterval: 10 * time.Millisecond})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, 0, rollout.DoneNodes())
+	assert.Equal(t, NodeRolloutPending, rollout.Nodes[0].State)
+}
+
+func TestGetNodeRolloutState(t *testing.T) {
+	testCases := []struct {
+		annotations    map[string]string
+		expectedState  NodeRolloutState
+		expectedReason string
+	}{
+		{
+			annotations: map[string]string{
+				nodeCurrentConfigAnnotation: defaultRolloutTargetConfig,
+				nodeDesiredConfigAnnotation: defaultRolloutTargetConfig,
+				nodeStateAnnotation:         "Done",
+			},
+			expectedState: NodeRolloutDone,
+		},
+		{
+			annotations: map[string]string{
+				nodeCurrentConfigAnnotation: defaultRolloutCurrentConfig,
+				nodeDesiredConfigAnnotation: defaultRolloutCurrentConfig,
+				nodeStateAnnotation:         "Done",
+			},
+			expectedState: NodeRolloutPending,
+		},
+		{
+			annotations: map[string]string{
+				nodeCurrentConfigAnnotation: defaultRolloutCurrentConfig,
+				nodeDesiredConfigAnnotation: defaultRolloutTargetConfig,
+				nodeStateAnnotation:         "Done",
+			},
+			expectedState: NodeRolloutWorking,
+		},
+		{
+			annotations: map[string]string{
+				nodeCurrentConfigAnnotation: defaultRolloutCurrentConfig,
+				nodeDesiredConfigAnnotation: defaultRolloutTargetConfig,
+				nodeStateAnnotation:         "Unreconcilable",
+				nodeReasonAnnotation:        "ignition version not supported",
+			},
+			expectedState:  NodeRolloutDegraded,
+			expectedReason: "ignition version not supported",
+		},
+	}
+
+	for _, testCase := range testCases {
+		node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "worker-0", Annotations: testCase.annotations}}
+
+		state, reason := getNodeRolloutState(node, defaultRolloutTargetConfig)
+		assert.Equal(t, testCase.expectedState, state)
+		assert.Equal(t, testCase.expectedReason, reason)
+	}
+}
+
+// buildRolloutTestClient returns a client with a MachineConfigPool rolling out defaultRolloutTargetConfig to one
+// worker node per element of nodeSteps. Each node goes through its steps, one per poll, and the pool becomes updated
+// once all the nodes are done.
+func buildRolloutTestClient(
+	mcpConditions []mcv1.MachineConfigPoolCondition, nodeSteps ...[]func(object runtime.Object)) *clients.Settings {
+	mcp := buildDummyMCP(defaultMCPName)
+	mcp.Spec.NodeSelector = &metav1.LabelSelector{
+		MatchLabels: map[string]string{"node-role.kubernetes.io/worker": ""}}
+	mcp.Spec.Configuration.Name = defaultRolloutTargetConfig
+	mcp.Status.Configuration.Name = defaultRolloutCurrentConfig
+	mcp.Status.MachineCount = int32(len(nodeSteps))
+	mcp.Status.Conditions = append([]mcv1.MachineConfigPoolCondition{updatingMCPCondition}, mcpConditions...)
+
+	runtimeObjects := []runtime.Object{
+		mcp,
+		&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "master-0", Labels: map[string]string{
+			"node-role.kubernetes.io/master": ""}}},
+	}
+
+	simulators := []clients.ControllerSimulator{{
+		Object:   &mcv1.MachineConfigPool{},
+		Triggers: []clients.SimulatorTrigger{clients.SimulatorTriggerRead},
+		Reconcile: clients.SimulateAfter(len(nodeSteps)+2, func(object runtime.Object) {
+			mcp := object.(*mcv1.MachineConfigPool)
+			mcp.Status.Configuration.Name = defaultRolloutTargetConfig
+			mcp.Status.UpdatedMachineCount = mcp.Status.MachineCount
+			mcp.Status.Conditions = append(mcp.Status.Conditions, mcv1.MachineConfigPoolCondition{
+				Type: mcv1.MachineConfigPoolUpdated, Status: corev1.ConditionTrue})
+		}),
+	}}
+
+	for index, steps := range nodeSteps {
+		name := fmt.Sprintf("worker-%d", index)
+		runtimeObjects = append(runtimeObjects, &corev1.Node{ObjectMeta: metav1.ObjectMeta{
+			Name: name, Labels: map[string]string{"node-role.kubernetes.io/worker": ""}}})
+		simulators = append(simulators, clients.ControllerSimulator{
+			Object:    &corev1.Node{},
+			Name:      name,
+			Triggers:  []clients.SimulatorTrigger{clients.SimulatorTriggerRead},
+			Reconcile: clients.SimulateSequence(steps...),
+		})
+	}
+
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects:  runtimeObjects,
+		SchemeAttachers: testSchemes,
+		Simulators:      simulators,
+	})
+}
+
+func setNodePending(object runtime.Object) {
+	setNodeAnnotations(object, defaultRolloutCurrentConfig, defaultRolloutCurrentConfig, "Done", "")
+}
+
+func setNodeWorking(object runtime.Object) {
+	setNodeAnnotations(object, defaultRolloutCurrentConfig, defaultRolloutTargetConfig, "Working", "")
+}
+
+func setNodeDone(object runtime.Object) {
+	setNodeAnnotations(object, defaultRolloutTargetConfig, defaultRolloutTargetConfig, "Done", "")
+}
+
+func setNodeDegraded(object runtime.Object) {
+	setNodeAnnotations(object, defaultRolloutCurrentConfig, defaultRolloutTargetConfig, "Degraded", "failed to drain node")
+}
+
+func setNodeAnnotations(object runtime.Object, currentConfig, desiredConfig, state, reason string) {
+	object.(*corev1.Node).Annotations = map[string]string{
+		nodeCurrentConfigAnnotation: currentConfig,
+		nodeDesiredConfigAnnotation: desiredConfig,
+		nodeStateAnnotation:         state,
+		nodeReasonAnnotation:        reason,
+	}
+}
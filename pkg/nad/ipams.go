@@ -1,5 +1,11 @@
 package nad
 
+import (
+	"net"
+
+	"github.com/golang/glog"
+)
+
 // IPAMStatic returns static ipam type.
 func IPAMStatic() *IPAM {
 	return &IPAM{Type: "static"}
@@ -32,3 +38,86 @@ func WhereAboutsAppendRange(ipam *IPAM, ipRange, gateway string) *IPAM {
 
 	return ipam
 }
+
+// IPAMDHCP returns dhcp ipam type. The dhcp ipam requires the CNI dhcp daemon to run on the nodes.
+func IPAMDHCP() *IPAM {
+	return &IPAM{Type: "dhcp"}
+}
+
+// IPAMHostLocal returns host-local ipam type with a single subnet. The gateway is optional.
+func IPAMHostLocal(subnet, gateway string) *IPAM {
+	return HostLocalAppendRange(&IPAM{Type: "host-local"}, subnet, gateway)
+}
+
+// HostLocalAppendRange returns host-local ipam type with an additional subnet, for example to make it dual-stack.
+// The gateway is optional.
+func HostLocalAppendRange(ipam *IPAM, subnet, gateway string) *IPAM {
+	if ipam == nil {
+		glog.V(100).Infof("The host-local ipam is nil")
+
+		return nil
+	}
+
+	if _, _, err := net.ParseCIDR(subnet); err != nil {
+		glog.V(100).Infof("The host-local ipam subnet %s is not a valid CIDR: %v", subnet, err)
+
+		return nil
+	}
+
+	if gateway != "" && net.ParseIP(gateway) == nil {
+		glog.V(100).Infof("The host-local ipam gateway %s is not a valid IP address", gateway)
+
+		return nil
+	}
+
+	ipam.Ranges = append(ipam.Ranges, []Range{{Subnet: subnet, Gateway: gateway}})
+
+	return ipam
+}
+
+// IPAMAppendRoute returns ipam with an additional route. An empty gateway means the default gateway of the
+// interface is used.
+func IPAMAppendRoute(ipam *IPAM, destination, gateway string) *IPAM {
+	if ipam == nil {
+		glog.V(100).Infof("The ipam is nil")
+
+		return nil
+	}
+
+	if _, _, err := net.ParseCIDR(destination); err != nil {
+		glog.V(100).Infof("The route destination %s is not a valid CIDR: %v", destination, err)
+
+		return nil
+	}
+
+	if gateway != "" && net.ParseIP(gateway) == nil {
+		glog.V(100).Infof("The route gateway %s is not a valid IP address", gateway)
+
+		return nil
+	}
+
+	ipam.Routes = append(ipam.Routes, Routes{Dst: destination, Gw: gateway})
+
+	return ipam
+}
+
+// IPAMWithDNS returns ipam with the given DNS configuration.
+func IPAMWithDNS(ipam *IPAM, dns DNS) *IPAM {
+	if ipam == nil {
+		glog.V(100).Infof("The ipam is nil")
+
+		return nil
+	}
+
+	for _, nameserver := range dns.Nameservers {
+		if net.ParseIP(nameserver) == nil {
+			glog.V(100).Infof("The DNS nameserver %s is not a valid IP address", nameserver)
+
+			return nil
+		}
+	}
+
+	ipam.DNS = &dns
+
+	return ipam
+}
@@ -0,0 +1,58 @@
+package nad
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIPAMHostLocal(t *testing.T) {
+	testCases := []struct {
+		subnet      string
+		gateway     string
+		expectedNil bool
+	}{
+		{subnet: "192.168.0.0/24", gateway: "192.168.0.1"},
+		{subnet: "fd00::/64"},
+		{subnet: "192.168.0.0", expectedNil: true},
+		{subnet: "192.168.0.0/24", gateway: "192.168.0", expectedNil: true},
+	}
+
+	for _, testCase := range testCases {
+		ipam := IPAMHostLocal(testCase.subnet, testCase.gateway)
+
+		if testCase.expectedNil {
+			assert.Nil(t, ipam)
+
+			continue
+		}
+
+		assert.Equal(t, &IPAM{
+			Type: "host-local", Ranges: [][]Range{{{Subnet: testCase.subnet, Gateway: testCase.gateway}}}}, ipam)
+	}
+
+	assert.Nil(t, HostLocalAppendRange(nil, "192.168.0.0/24", ""))
+}
+
+func TestIPAMHostLocalWithRoutesAndDNS(t *testing.T) {
+	ipam := HostLocalAppendRange(IPAMHostLocal("192.168.0.0/24", "192.168.0.1"), "fd00::/64", "")
+	ipam = IPAMAppendRoute(ipam, "0.0.0.0/0", "")
+	ipam = IPAMAppendRoute(ipam, "10.0.0.0/8", "192.168.0.254")
+	ipam = IPAMWithDNS(ipam, DNS{Nameservers: []string{"192.168.0.53"}, Domain: "example.com"})
+
+	assert.Equal(t, &IPAM{
+		Type: "host-local",
+		Ranges: [][]Range{
+			{{Subnet: "192.168.0.0/24", Gateway: "192.168.0.1"}},
+			{{Subnet: "fd00::/64"}},
+		},
+		Routes: []Routes{{Dst: "0.0.0.0/0"}, {Dst: "10.0.0.0/8", Gw: "192.168.0.254"}},
+		DNS:    &DNS{Nameservers: []string{"192.168.0.53"}, Domain: "example.com"},
+	}, ipam)
+
+	assert.Nil(t, IPAMAppendRoute(IPAMDHCP(), "10.0.0.0", ""))
+	assert.Nil(t, IPAMAppendRoute(IPAMDHCP(), "10.0.0.0/8", "10.0.0"))
+	assert.Nil(t, IPAMAppendRoute(nil, "10.0.0.0/8", ""))
+	assert.Nil(t, IPAMWithDNS(IPAMStatic(), DNS{Nameservers: []string{"dns.example.com"}}))
+	assert.Nil(t, IPAMWithDNS(nil, DNS{}))
+}
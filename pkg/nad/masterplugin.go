@@ -2,6 +2,8 @@ package nad
 
 import (
 	"fmt"
+	"net"
+	"strings"
 
 	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
@@ -10,7 +12,11 @@ import (
 
 var (
 	// allowedMacVlanMode represents all allowed modes for macvlan plugin type.
-	allowedMacVlanMode      = []string{"bridge", "passthru", "private", "vepa"}
+	allowedMacVlanMode = []string{"bridge", "passthru", "private", "vepa"}
+	// allowedSRIOVLinkState represents all allowed link states for sriov plugin type.
+	allowedSRIOVLinkState = []string{"auto", "enable", "disable"}
+	// allowedOVNKTopology represents all supported topologies for ovn-k8s-cni-overlay plugin type.
+	allowedOVNKTopology     = []string{"layer2", "localnet"}
 	invalidIpamParameterMsg = "invalid ipam parameter"
 )
 
@@ -197,6 +203,58 @@ func (plugin *MasterBridgePlugin) WithIPAM(ipam *IPAM) *MasterBridgePlugin {
 	return plugin
 }
 
+// WithVlanTrunk defines the VLAN IDs and ranges allowed on the bridge port of MasterBridgePlugin.
+func (plugin *MasterBridgePlugin) WithVlanTrunk(trunks ...VlanTrunk) *MasterBridgePlugin {
+	glog.V(100).Infof("Adding vlanTrunk %v to MasterBridgePlugin", trunks)
+
+	if plugin.masterPlugin == nil {
+		glog.V(100).Infof(msg.UndefinedCrdObjectErrString("MasterBridgePlugin"))
+		plugin.errorMsg = msg.UndefinedCrdObjectErrString("MasterBridgePlugin")
+
+		return plugin
+	}
+
+	if len(trunks) == 0 {
+		glog.V(100).Infof("error adding empty vlanTrunk to MasterBridgePlugin")
+
+		plugin.errorMsg = "error adding empty vlanTrunk to MasterBridgePlugin"
+
+		return plugin
+	}
+
+	for _, trunk := range trunks {
+		if !isValidVlanTrunk(trunk) {
+			glog.V(100).Infof("error adding invalid vlanTrunk %v to MasterBridgePlugin", trunk)
+
+			plugin.errorMsg = fmt.Sprintf("invalid vlanTrunk %+v, expected either id or minID and maxID in range "+
+				"1-4094", trunk)
+
+			return plugin
+		}
+	}
+
+	plugin.masterPlugin.VlanTrunk = append(plugin.masterPlugin.VlanTrunk, trunks...)
+
+	return plugin
+}
+
+// WithMacSpoofCheck enables MAC spoof check on MasterBridgePlugin, limiting the traffic originating from the
+// container to the MAC address of the interface.
+func (plugin *MasterBridgePlugin) WithMacSpoofCheck() *MasterBridgePlugin {
+	glog.V(100).Infof("Adding macspoofchk to MasterBridgePlugin")
+
+	if plugin.masterPlugin == nil {
+		glog.V(100).Infof(msg.UndefinedCrdObjectErrString("MasterBridgePlugin"))
+		plugin.errorMsg = msg.UndefinedCrdObjectErrString("MasterBridgePlugin")
+
+		return plugin
+	}
+
+	plugin.masterPlugin.MacSpoofChk = true
+
+	return plugin
+}
+
 // MasterVlanPlugin provides struct for MasterPlugin set to vlan in NetworkAttachmentDefinition.
 type MasterVlanPlugin struct {
 	masterPlugin *MasterPlugin
@@ -646,3 +704,449 @@ func (plugin *MasterHostDevicePlugin) GetHostDevicePluginConfig() (*MasterPlugin
 
 	return plugin.masterPlugin, nil
 }
+
+// MasterSRIOVPlugin provides struct for MasterPlugin set to sriov in NetworkAttachmentDefinition. The
+// NetworkAttachmentDefinition has to carry the k8s.v1.cni.cncf.io/resourceName annotation of the SR-IOV resource
+// so that the device plugin allocates a VF to the pod.
+type MasterSRIOVPlugin struct {
+	masterPlugin *MasterPlugin
+	errorMsg     string
+}
+
+// NewMasterSRIOVPlugin creates new instance of MasterSRIOVPlugin.
+func NewMasterSRIOVPlugin(name string) *MasterSRIOVPlugin {
+	glog.V(100).Infof("Initializing new MasterSRIOVPlugin structure %s", name)
+
+	builder := &MasterSRIOVPlugin{
+		masterPlugin: &MasterPlugin{
+			CniVersion: "0.3.1",
+			Name:       name,
+			Type:       "sriov",
+		},
+	}
+
+	if builder.masterPlugin.Name == "" {
+		glog.V(100).Infof("error MasterSRIOVPlugin name can not be empty")
+
+		builder.errorMsg = "MasterSRIOVPlugin name is empty"
+
+		return builder
+	}
+
+	return builder
+}
+
+// WithVlan defines the VLAN ID and QoS assigned to the VF of MasterSRIOVPlugin. Default is no VLAN.
+func (plugin *MasterSRIOVPlugin) WithVlan(vlan uint16, qos uint8) *MasterSRIOVPlugin {
+	glog.V(100).Infof("Adding vlan %d with qos %d to MasterSRIOVPlugin", vlan, qos)
+
+	if plugin.masterPlugin == nil {
+		glog.V(100).Infof(msg.UndefinedCrdObjectErrString("MasterSRIOVPlugin"))
+		plugin.errorMsg = msg.UndefinedCrdObjectErrString("MasterSRIOVPlugin")
+
+		return plugin
+	}
+
+	if vlan > 4094 {
+		glog.V(100).Infof("error vlan id %d can not be greater than 4094", vlan)
+
+		plugin.errorMsg = "MasterSRIOVPlugin vlan is greater than 4094"
+
+		return plugin
+	}
+
+	if qos > 7 {
+		glog.V(100).Infof("error vlan qos %d can not be greater than 7", qos)
+
+		plugin.errorMsg = "MasterSRIOVPlugin vlanQoS is greater than 7"
+
+		return plugin
+	}
+
+	if vlan == 0 && qos != 0 {
+		glog.V(100).Infof("error vlan qos can not be set without vlan id")
+
+		plugin.errorMsg = "MasterSRIOVPlugin vlanQoS requires a vlan"
+
+		return plugin
+	}
+
+	plugin.masterPlugin.Vlan = vlan
+	plugin.masterPlugin.VlanQoS = qos
+
+	return plugin
+}
+
+// WithSpoofCheck defines whether MAC spoof check is turned on or off for the VF of MasterSRIOVPlugin.
+func (plugin *MasterSRIOVPlugin) WithSpoofCheck(enabled bool) *MasterSRIOVPlugin {
+	glog.V(100).Infof("Adding spoofchk %t to MasterSRIOVPlugin", enabled)
+
+	if plugin.masterPlugin == nil {
+		glog.V(100).Infof(msg.UndefinedCrdObjectErrString("MasterSRIOVPlugin"))
+		plugin.errorMsg = msg.UndefinedCrdObjectErrString("MasterSRIOVPlugin")
+
+		return plugin
+	}
+
+	plugin.masterPlugin.SpoofChk = onOff(enabled)
+
+	return plugin
+}
+
+// WithTrust defines whether the VF of MasterSRIOVPlugin is trusted.
+func (plugin *MasterSRIOVPlugin) WithTrust(enabled bool) *MasterSRIOVPlugin {
+	glog.V(100).Infof("Adding trust %t to MasterSRIOVPlugin", enabled)
+
+	if plugin.masterPlugin == nil {
+		glog.V(100).Infof(msg.UndefinedCrdObjectErrString("MasterSRIOVPlugin"))
+		plugin.errorMsg = msg.UndefinedCrdObjectErrString("MasterSRIOVPlugin")
+
+		return plugin
+	}
+
+	plugin.masterPlugin.Trust = onOff(enabled)
+
+	return plugin
+}
+
+// WithLinkState defines the link state of the VF of MasterSRIOVPlugin.
+func (plugin *MasterSRIOVPlugin) WithLinkState(linkState string) *MasterSRIOVPlugin {
+	glog.V(100).Infof("Adding link_state %s to MasterSRIOVPlugin", linkState)
+
+	if plugin.masterPlugin == nil {
+		glog.V(100).Infof(msg.UndefinedCrdObjectErrString("MasterSRIOVPlugin"))
+		plugin.errorMsg = msg.UndefinedCrdObjectErrString("MasterSRIOVPlugin")
+
+		return plugin
+	}
+
+	if !slices.Contains(allowedSRIOVLinkState, linkState) {
+		glog.V(100).Infof("error to add link_state %s, allowed states are %v", linkState, allowedSRIOVLinkState)
+
+		plugin.errorMsg = "invalid link_state parameter"
+
+		return plugin
+	}
+
+	plugin.masterPlugin.LinkState = linkState
+
+	return plugin
+}
+
+// WithTxRate defines the minimum and maximum transmit rate of the VF of MasterSRIOVPlugin in Mbps. A value of 0
+// leaves the rate unlimited.
+func (plugin *MasterSRIOVPlugin) WithTxRate(minTxRate, maxTxRate uint) *MasterSRIOVPlugin {
+	glog.V(100).Infof("Adding min_tx_rate %d and max_tx_rate %d to MasterSRIOVPlugin", minTxRate, maxTxRate)
+
+	if plugin.masterPlugin == nil {
+		glog.V(100).Infof(msg.UndefinedCrdObjectErrString("MasterSRIOVPlugin"))
+		plugin.errorMsg = msg.UndefinedCrdObjectErrString("MasterSRIOVPlugin")
+
+		return plugin
+	}
+
+	if maxTxRate != 0 && minTxRate > maxTxRate {
+		glog.V(100).Infof("error min_tx_rate %d can not be greater than max_tx_rate %d", minTxRate, maxTxRate)
+
+		plugin.errorMsg = "MasterSRIOVPlugin min_tx_rate is greater than max_tx_rate"
+
+		return plugin
+	}
+
+	plugin.masterPlugin.MinTxRate = minTxRate
+	plugin.masterPlugin.MaxTxRate = maxTxRate
+
+	return plugin
+}
+
+// WithCapabilities defines Capabilities configuration to MasterSRIOVPlugin.
+func (plugin *MasterSRIOVPlugin) WithCapabilities(capabilities *Capability) *MasterSRIOVPlugin {
+	glog.V(100).Infof("Adding capabilities %v to MasterSRIOVPlugin", capabilities)
+
+	if plugin.masterPlugin == nil {
+		glog.V(100).Infof(msg.UndefinedCrdObjectErrString("MasterSRIOVPlugin"))
+		plugin.errorMsg = msg.UndefinedCrdObjectErrString("MasterSRIOVPlugin")
+
+		return plugin
+	}
+
+	if capabilities == nil {
+		glog.V(100).Infof("error adding empty capabilities to MasterSRIOVPlugin")
+
+		plugin.errorMsg = "error adding empty capabilities to MasterSRIOVPlugin"
+
+		return plugin
+	}
+
+	plugin.masterPlugin.Capabilities = capabilities
+
+	return plugin
+}
+
+// WithIPAM defines IPAM configuration to MasterSRIOVPlugin. Default is empty.
+func (plugin *MasterSRIOVPlugin) WithIPAM(ipam *IPAM) *MasterSRIOVPlugin {
+	glog.V(100).Infof("Adding IPAM configuration %v to MasterSRIOVPlugin", ipam)
+
+	if plugin.masterPlugin == nil {
+		glog.V(100).Infof(msg.UndefinedCrdObjectErrString("MasterSRIOVPlugin"))
+		plugin.errorMsg = msg.UndefinedCrdObjectErrString("MasterSRIOVPlugin")
+
+		return plugin
+	}
+
+	if ipam == nil {
+		glog.V(100).Infof("error adding empty ipam to MasterSRIOVPlugin")
+
+		plugin.errorMsg = invalidIpamParameterMsg
+
+		return plugin
+	}
+
+	plugin.masterPlugin.Ipam = ipam
+
+	return plugin
+}
+
+// GetMasterPluginConfig returns master plugin if error does not occur.
+func (plugin *MasterSRIOVPlugin) GetMasterPluginConfig() (*MasterPlugin, error) {
+	if plugin.errorMsg != "" {
+		return nil, fmt.Errorf("error to build MasterPlugin config due to :%s", plugin.errorMsg)
+	}
+
+	return plugin.masterPlugin, nil
+}
+
+// MasterOVNKubernetesPlugin provides struct for MasterPlugin set to ovn-k8s-cni-overlay secondary network in
+// NetworkAttachmentDefinition.
+type MasterOVNKubernetesPlugin struct {
+	masterPlugin *MasterPlugin
+	errorMsg     string
+}
+
+// NewMasterOVNKubernetesPlugin creates new instance of MasterOVNKubernetesPlugin. The netAttachDefName is the
+// namespace/name of the NetworkAttachmentDefinition carrying the config and topology is either layer2 or localnet.
+// All NetworkAttachmentDefinitions sharing the same name describe the same OVN-Kubernetes network.
+func NewMasterOVNKubernetesPlugin(name, netAttachDefName, topology string) *MasterOVNKubernetesPlugin {
+	glog.V(100).Infof("Initializing new MasterOVNKubernetesPlugin structure %s, for nad %s with topology %s",
+		name, netAttachDefName, topology)
+
+	builder := &MasterOVNKubernetesPlugin{
+		masterPlugin: &MasterPlugin{
+			CniVersion:       "0.3.1",
+			Name:             name,
+			Type:             "ovn-k8s-cni-overlay",
+			NetAttachDefName: netAttachDefName,
+			Topology:         topology,
+		},
+	}
+
+	if builder.masterPlugin.Name == "" {
+		glog.V(100).Infof("error MasterOVNKubernetesPlugin name can not be empty")
+
+		builder.errorMsg = "MasterOVNKubernetesPlugin name is empty"
+
+		return builder
+	}
+
+	if namespace, nadName, found := strings.Cut(netAttachDefName, "/"); !found || namespace == "" || nadName == "" {
+		glog.V(100).Infof("error MasterOVNKubernetesPlugin netAttachDefName %s is not namespace/name", netAttachDefName)
+
+		builder.errorMsg = "MasterOVNKubernetesPlugin netAttachDefName must be in namespace/name format"
+
+		return builder
+	}
+
+	if !slices.Contains(allowedOVNKTopology, topology) {
+		glog.V(100).Infof("error to add topology %s, allowed topologies are %v", topology, allowedOVNKTopology)
+
+		builder.errorMsg = "invalid topology parameter"
+
+		return builder
+	}
+
+	return builder
+}
+
+// WithSubnets defines the subnets from which OVN-Kubernetes assigns pod IPs in MasterOVNKubernetesPlugin. Default
+// is no IPAM.
+func (plugin *MasterOVNKubernetesPlugin) WithSubnets(subnets ...string) *MasterOVNKubernetesPlugin {
+	glog.V(100).Infof("Adding subnets %v to MasterOVNKubernetesPlugin", subnets)
+
+	if plugin.masterPlugin == nil {
+		glog.V(100).Infof(msg.UndefinedCrdObjectErrString("MasterOVNKubernetesPlugin"))
+		plugin.errorMsg = msg.UndefinedCrdObjectErrString("MasterOVNKubernetesPlugin")
+
+		return plugin
+	}
+
+	if err := validateCIDRs(subnets); err != nil {
+		glog.V(100).Infof("error adding subnets to MasterOVNKubernetesPlugin: %v", err)
+
+		plugin.errorMsg = fmt.Sprintf("invalid subnets parameter: %v", err)
+
+		return plugin
+	}
+
+	plugin.masterPlugin.Subnets = strings.Join(subnets, ",")
+
+	return plugin
+}
+
+// WithExcludeSubnets defines the subnets OVN-Kubernetes does not assign to pods in MasterOVNKubernetesPlugin.
+func (plugin *MasterOVNKubernetesPlugin) WithExcludeSubnets(excludeSubnets ...string) *MasterOVNKubernetesPlugin {
+	glog.V(100).Infof("Adding excludeSubnets %v to MasterOVNKubernetesPlugin", excludeSubnets)
+
+	if plugin.masterPlugin == nil {
+		glog.V(100).Infof(msg.UndefinedCrdObjectErrString("MasterOVNKubernetesPlugin"))
+		plugin.errorMsg = msg.UndefinedCrdObjectErrString("MasterOVNKubernetesPlugin")
+
+		return plugin
+	}
+
+	if err := validateCIDRs(excludeSubnets); err != nil {
+		glog.V(100).Infof("error adding excludeSubnets to MasterOVNKubernetesPlugin: %v", err)
+
+		plugin.errorMsg = fmt.Sprintf("invalid excludeSubnets parameter: %v", err)
+
+		return plugin
+	}
+
+	plugin.masterPlugin.ExcludeSubnets = strings.Join(excludeSubnets, ",")
+
+	return plugin
+}
+
+// WithMTU defines the MTU of MasterOVNKubernetesPlugin. Default is the MTU of the cluster network.
+func (plugin *MasterOVNKubernetesPlugin) WithMTU(mtu int) *MasterOVNKubernetesPlugin {
+	glog.V(100).Infof("Adding mtu %d to MasterOVNKubernetesPlugin", mtu)
+
+	if plugin.masterPlugin == nil {
+		glog.V(100).Infof(msg.UndefinedCrdObjectErrString("MasterOVNKubernetesPlugin"))
+		plugin.errorMsg = msg.UndefinedCrdObjectErrString("MasterOVNKubernetesPlugin")
+
+		return plugin
+	}
+
+	if mtu <= 0 {
+		glog.V(100).Infof("error mtu %d must be positive", mtu)
+
+		plugin.errorMsg = "invalid mtu parameter"
+
+		return plugin
+	}
+
+	plugin.masterPlugin.Mtu = mtu
+
+	return plugin
+}
+
+// WithVlanID defines the VLAN ID of the localnet MasterOVNKubernetesPlugin traffic on the physical network.
+func (plugin *MasterOVNKubernetesPlugin) WithVlanID(vlanID uint16) *MasterOVNKubernetesPlugin {
+	glog.V(100).Infof("Adding vlanID %d to MasterOVNKubernetesPlugin", vlanID)
+
+	if plugin.masterPlugin == nil {
+		glog.V(100).Infof(msg.UndefinedCrdObjectErrString("MasterOVNKubernetesPlugin"))
+		plugin.errorMsg = msg.UndefinedCrdObjectErrString("MasterOVNKubernetesPlugin")
+
+		return plugin
+	}
+
+	if plugin.masterPlugin.Topology != "localnet" {
+		glog.V(100).Infof("error vlanID is only supported by the localnet topology")
+
+		plugin.errorMsg = "MasterOVNKubernetesPlugin vlanID requires localnet topology"
+
+		return plugin
+	}
+
+	if vlanID == 0 || vlanID > 4094 {
+		glog.V(100).Infof("error vlanID %d is not in range 1-4094", vlanID)
+
+		plugin.errorMsg = "MasterOVNKubernetesPlugin vlanID is not in range 1-4094"
+
+		return plugin
+	}
+
+	plugin.masterPlugin.LocalnetVlanID = vlanID
+
+	return plugin
+}
+
+// WithPhysicalNetworkName defines the name of the OVS bridge mapping the localnet MasterOVNKubernetesPlugin is
+// attached to. Default is the network name.
+func (plugin *MasterOVNKubernetesPlugin) WithPhysicalNetworkName(physicalNetwork string) *MasterOVNKubernetesPlugin {
+	glog.V(100).Infof("Adding physicalNetworkName %s to MasterOVNKubernetesPlugin", physicalNetwork)
+
+	if plugin.masterPlugin == nil {
+		glog.V(100).Infof(msg.UndefinedCrdObjectErrString("MasterOVNKubernetesPlugin"))
+		plugin.errorMsg = msg.UndefinedCrdObjectErrString("MasterOVNKubernetesPlugin")
+
+		return plugin
+	}
+
+	if plugin.masterPlugin.Topology != "localnet" {
+		glog.V(100).Infof("error physicalNetworkName is only supported by the localnet topology")
+
+		plugin.errorMsg = "MasterOVNKubernetesPlugin physicalNetworkName requires localnet topology"
+
+		return plugin
+	}
+
+	if physicalNetwork == "" {
+		glog.V(100).Infof("error physicalNetworkName can not be empty")
+
+		plugin.errorMsg = "invalid physicalNetworkName parameter"
+
+		return plugin
+	}
+
+	plugin.masterPlugin.PhysicalNetwork = physicalNetwork
+
+	return plugin
+}
+
+// GetMasterPluginConfig returns master plugin if error does not occur.
+func (plugin *MasterOVNKubernetesPlugin) GetMasterPluginConfig() (*MasterPlugin, error) {
+	if plugin.errorMsg != "" {
+		return nil, fmt.Errorf("error to build MasterPlugin config due to :%s", plugin.errorMsg)
+	}
+
+	return plugin.masterPlugin, nil
+}
+
+// isValidVlanTrunk checks that trunk holds either a single VLAN ID or an ordered range of VLAN IDs.
+func isValidVlanTrunk(trunk VlanTrunk) bool {
+	isValidID := func(id uint16) bool {
+		return id > 0 && id <= 4094
+	}
+
+	if trunk.ID != 0 {
+		return trunk.MinID == 0 && trunk.MaxID == 0 && isValidID(trunk.ID)
+	}
+
+	return isValidID(trunk.MinID) && isValidID(trunk.MaxID) && trunk.MinID <= trunk.MaxID
+}
+
+// validateCIDRs returns an error if cidrs is empty or any of its elements is not a valid CIDR.
+func validateCIDRs(cidrs []string) error {
+	if len(cidrs) == 0 {
+		return fmt.Errorf("list of CIDRs can not be empty")
+	}
+
+	for _, cidr := range cidrs {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// onOff returns the on/off string representation of enabled used by the sriov plugin.
+func onOff(enabled bool) string {
+	if enabled {
+		return "on"
+	}
+
+	return "off"
+}
@@ -0,0 +1,279 @@
+package nad
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMasterBridgePluginWithVlanTrunk(t *testing.T) {
+	testCases := []struct {
+		trunks        []VlanTrunk
+		expectedError string
+	}{
+		{
+			trunks: []VlanTrunk{{ID: 100}, {MinID: 200, MaxID: 300}},
+		},
+		{
+			trunks:        []VlanTrunk{},
+			expectedError: "error adding empty vlanTrunk to MasterBridgePlugin",
+		},
+		{
+			trunks: []VlanTrunk{{ID: 100, MinID: 200, MaxID: 300}},
+			expectedError: "invalid vlanTrunk {MinID:200 MaxID:300 ID:100}, expected either id or minID and maxID " +
+				"in range 1-4094",
+		},
+		{
+			trunks: []VlanTrunk{{MinID: 300, MaxID: 200}},
+			expectedError: "invalid vlanTrunk {MinID:300 MaxID:200 ID:0}, expected either id or minID and maxID " +
+				"in range 1-4094",
+		},
+		{
+			trunks: []VlanTrunk{{ID: 4095}},
+			expectedError: "invalid vlanTrunk {MinID:0 MaxID:0 ID:4095}, expected either id or minID and maxID " +
+				"in range 1-4094",
+		},
+	}
+
+	for _, testCase := range testCases {
+		plugin := NewMasterBridgePlugin("test", "br0").WithVlanTrunk(testCase.trunks...).WithMacSpoofCheck()
+		assert.Equal(t, testCase.expectedError, plugin.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.Equal(t, testCase.trunks, plugin.masterPlugin.VlanTrunk)
+			assert.True(t, plugin.masterPlugin.MacSpoofChk)
+		}
+	}
+}
+
+func TestMasterSRIOVPlugin(t *testing.T) {
+	testCases := []struct {
+		name          string
+		vlan          uint16
+		qos           uint8
+		linkState     string
+		minTxRate     uint
+		maxTxRate     uint
+		expectedError string
+	}{
+		{
+			name:      "test",
+			vlan:      100,
+			qos:       2,
+			linkState: "enable",
+			minTxRate: 10,
+			maxTxRate: 100,
+		},
+		{
+			name:          "",
+			linkState:     "auto",
+			expectedError: "MasterSRIOVPlugin name is empty",
+		},
+		{
+			name:          "test",
+			vlan:          4095,
+			linkState:     "auto",
+			expectedError: "MasterSRIOVPlugin vlan is greater than 4094",
+		},
+		{
+			name:          "test",
+			vlan:          100,
+			qos:           8,
+			linkState:     "auto",
+			expectedError: "MasterSRIOVPlugin vlanQoS is greater than 7",
+		},
+		{
+			name:          "test",
+			qos:           1,
+			linkState:     "auto",
+			expectedError: "MasterSRIOVPlugin vlanQoS requires a vlan",
+		},
+		{
+			name:          "test",
+			linkState:     "up",
+			expectedError: "invalid link_state parameter",
+		},
+		{
+			name:          "test",
+			linkState:     "auto",
+			minTxRate:     100,
+			maxTxRate:     10,
+			expectedError: "MasterSRIOVPlugin min_tx_rate is greater than max_tx_rate",
+		},
+	}
+
+	for _, testCase := range testCases {
+		masterPlugin, err := NewMasterSRIOVPlugin(testCase.name).
+			WithVlan(testCase.vlan, testCase.qos).
+			WithSpoofCheck(false).
+			WithTrust(true).
+			WithLinkState(testCase.linkState).
+			WithTxRate(testCase.minTxRate, testCase.maxTxRate).
+			WithCapabilities(&Capability{Mac: true}).
+			WithIPAM(IPAMStatic()).
+			GetMasterPluginConfig()
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, fmt.Sprintf("error to build MasterPlugin config due to :%s", testCase.expectedError))
+			assert.Nil(t, masterPlugin)
+
+			continue
+		}
+
+		assert.Nil(t, err)
+		assert.Equal(t, &MasterPlugin{
+			CniVersion:   "0.3.1",
+			Name:         testCase.name,
+			Type:         "sriov",
+			Vlan:         testCase.vlan,
+			VlanQoS:      testCase.qos,
+			SpoofChk:     "off",
+			Trust:        "on",
+			LinkState:    testCase.linkState,
+			MinTxRate:    testCase.minTxRate,
+			MaxTxRate:    testCase.maxTxRate,
+			Capabilities: &Capability{Mac: true},
+			Ipam:         IPAMStatic(),
+		}, masterPlugin)
+	}
+}
+
+//nolint:funlen
+func TestMasterOVNKubernetesPlugin(t *testing.T) {
+	testCases := []struct {
+		netAttachDefName string
+		topology         string
+		subnets          []string
+		vlanID           uint16
+		physicalNetwork  string
+		expectedError    string
+	}{
+		{
+			netAttachDefName: "test-ns/test",
+			topology:         "layer2",
+			subnets:          []string{"10.100.0.0/16", "fd00:10:100::/64"},
+		},
+		{
+			netAttachDefName: "test-ns/test",
+			topology:         "localnet",
+			subnets:          []string{"192.168.100.0/24"},
+			vlanID:           100,
+			physicalNetwork:  "physnet",
+		},
+		{
+			netAttachDefName: "test",
+			topology:         "layer2",
+			subnets:          []string{"10.100.0.0/16"},
+			expectedError:    "MasterOVNKubernetesPlugin netAttachDefName must be in namespace/name format",
+		},
+		{
+			netAttachDefName: "test-ns/test",
+			topology:         "layer3",
+			subnets:          []string{"10.100.0.0/16"},
+			expectedError:    "invalid topology parameter",
+		},
+		{
+			netAttachDefName: "test-ns/test",
+			topology:         "layer2",
+			subnets:          []string{"10.100.0.0"},
+			expectedError:    "invalid subnets parameter: invalid CIDR address: 10.100.0.0",
+		},
+		{
+			netAttachDefName: "test-ns/test",
+			topology:         "layer2",
+			subnets:          []string{"10.100.0.0/16"},
+			vlanID:           100,
+			expectedError:    "MasterOVNKubernetesPlugin vlanID requires localnet topology",
+		},
+		{
+			netAttachDefName: "test-ns/test",
+			topology:         "localnet",
+			subnets:          []string{"10.100.0.0/16"},
+			vlanID:           4095,
+			expectedError:    "MasterOVNKubernetesPlugin vlanID is not in range 1-4094",
+		},
+		{
+			netAttachDefName: "test-ns/test",
+			topology:         "layer2",
+			subnets:          []string{"10.100.0.0/16"},
+			physicalNetwork:  "physnet",
+			expectedError:    "MasterOVNKubernetesPlugin physicalNetworkName requires localnet topology",
+		},
+	}
+
+	for _, testCase := range testCases {
+		plugin := NewMasterOVNKubernetesPlugin("test", testCase.netAttachDefName, testCase.topology).
+			WithSubnets(testCase.subnets...).
+			WithExcludeSubnets("10.100.0.0/30").
+			WithMTU(1400)
+
+		if testCase.vlanID != 0 {
+			plugin = plugin.WithVlanID(testCase.vlanID)
+		}
+
+		if testCase.physicalNetwork != "" {
+			plugin = plugin.WithPhysicalNetworkName(testCase.physicalNetwork)
+		}
+
+		masterPlugin, err := plugin.GetMasterPluginConfig()
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, fmt.Sprintf("error to build MasterPlugin config due to :%s", testCase.expectedError))
+			assert.Nil(t, masterPlugin)
+
+			continue
+		}
+
+		assert.Nil(t, err)
+		assert.Equal(t, "ovn-k8s-cni-overlay", masterPlugin.Type)
+		assert.Equal(t, testCase.topology, masterPlugin.Topology)
+		assert.Equal(t, testCase.netAttachDefName, masterPlugin.NetAttachDefName)
+		assert.Equal(t, "10.100.0.0/30", masterPlugin.ExcludeSubnets)
+		assert.Equal(t, 1400, masterPlugin.Mtu)
+		assert.Equal(t, testCase.vlanID, masterPlugin.LocalnetVlanID)
+		assert.Equal(t, testCase.physicalNetwork, masterPlugin.PhysicalNetwork)
+	}
+}
+
+func TestMasterOVNKubernetesPluginConfig(t *testing.T) {
+	masterPlugin, err := NewMasterOVNKubernetesPlugin("localnet-net", defaultNetNsName+"/"+defaultNetName, "localnet").
+		WithSubnets("192.168.100.0/24", "fd00:192:168:100::/64").
+		WithVlanID(100).
+		WithPhysicalNetworkName("physnet").
+		GetMasterPluginConfig()
+	assert.Nil(t, err)
+
+	builder := buildValidNADNetworkTestBuilder(buildTestClientWithDummyObject()).WithMasterPlugin(masterPlugin)
+	assert.Empty(t, builder.errorMsg)
+	assert.JSONEq(t, `{
+		"cniVersion": "0.3.1",
+		"name": "localnet-net",
+		"type": "ovn-k8s-cni-overlay",
+		"topology": "localnet",
+		"netAttachDefName": "nadnamespace/nadtest",
+		"subnets": "192.168.100.0/24,fd00:192:168:100::/64",
+		"vlanID": 100,
+		"physicalNetworkName": "physnet"
+	}`, builder.Definition.Spec.Config)
+}
+
+func TestMasterSRIOVPluginConfig(t *testing.T) {
+	masterPlugin, err := NewMasterSRIOVPlugin("sriov-net").
+		WithVlan(200, 0).
+		WithSpoofCheck(true).
+		WithIPAM(IPAMWhereAbouts("192.168.0.0/24", "192.168.0.1")).
+		GetMasterPluginConfig()
+	assert.Nil(t, err)
+
+	builder := buildValidNADNetworkTestBuilder(buildTestClientWithDummyObject()).WithMasterPlugin(masterPlugin)
+	assert.Empty(t, builder.errorMsg)
+	assert.JSONEq(t, `{
+		"cniVersion": "0.3.1",
+		"name": "sriov-net",
+		"type": "sriov",
+		"vlan": 200,
+		"spoofchk": "on",
+		"ipam": {"type": "whereabouts", "ipRanges": [{"range": "192.168.0.0/24", "gateway": "192.168.0.1"}]}
+	}`, builder.Definition.Spec.Config)
+}
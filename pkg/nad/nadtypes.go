@@ -3,8 +3,9 @@ package nad
 type (
 	// Capability tells if the plugin supports MAC.
 	Capability struct {
-		Mac bool `json:"mac,omitempty"`
-		IPs bool `json:"ips,omitempty"`
+		Mac       bool `json:"mac,omitempty"`
+		IPs       bool `json:"ips,omitempty"`
+		Bandwidth bool `json:"bandwidth,omitempty"`
 	}
 
 	// Link contains the link name of a link.
@@ -40,6 +41,17 @@ type (
 		Group            int               `json:"group,omitempty"`
 		MultiQueue       bool              `json:"multiQueue,omitempty"`
 		SelinuxContext   string            `json:"selinuxcontext,omitempty"`
+		FlushRoutes      bool              `json:"flushroutes,omitempty"`
+		FlushGateway     bool              `json:"flushgateway,omitempty"`
+		DelRoutes        []Routes          `json:"delroutes,omitempty"`
+		AddRoutes        []Routes          `json:"addroutes,omitempty"`
+		Table            int               `json:"table,omitempty"`
+		IngressRate      uint64            `json:"ingressRate,omitempty"`
+		IngressBurst     uint64            `json:"ingressBurst,omitempty"`
+		EgressRate       uint64            `json:"egressRate,omitempty"`
+		EgressBurst      uint64            `json:"egressBurst,omitempty"`
+		Backend          string            `json:"backend,omitempty"`
+		FirewalldZone    string            `json:"firewalldZone,omitempty"`
 	}
 
 	// MasterPlugin contains the master plugin configuration for a NAD.
@@ -61,6 +73,28 @@ type (
 		Mtu              int         `json:"mtu,omitempty"`
 		Links            []Link      `json:"links,omitempty"`
 		Capabilities     *Capability `json:"capabilities,omitempty"`
+		VlanTrunk        []VlanTrunk `json:"vlanTrunk,omitempty"`
+		MacSpoofChk      bool        `json:"macspoofchk,omitempty"`
+		Vlan             uint16      `json:"vlan,omitempty"`
+		VlanQoS          uint8       `json:"vlanQoS,omitempty"`
+		SpoofChk         string      `json:"spoofchk,omitempty"`
+		Trust            string      `json:"trust,omitempty"`
+		LinkState        string      `json:"link_state,omitempty"`
+		MinTxRate        uint        `json:"min_tx_rate,omitempty"`
+		MaxTxRate        uint        `json:"max_tx_rate,omitempty"`
+		Topology         string      `json:"topology,omitempty"`
+		NetAttachDefName string      `json:"netAttachDefName,omitempty"`
+		Subnets          string      `json:"subnets,omitempty"`
+		ExcludeSubnets   string      `json:"excludeSubnets,omitempty"`
+		PhysicalNetwork  string      `json:"physicalNetworkName,omitempty"`
+		LocalnetVlanID   uint16      `json:"vlanID,omitempty"`
+	}
+
+	// VlanTrunk contains either a single VLAN ID or a range of VLAN IDs allowed on a bridge port.
+	VlanTrunk struct {
+		MinID uint16 `json:"minID,omitempty"`
+		MaxID uint16 `json:"maxID,omitempty"`
+		ID    uint16 `json:"id,omitempty"`
 	}
 
 	// IPRanges contains ip range for WhereAbout IPAM plugin.
@@ -85,5 +119,23 @@ type (
 		Exclude    []string   `json:"exclude,omitempty"`
 		Routes     []Routes   `json:"routes,omitempty"`
 		IPRanges   []IPRanges `json:"ipRanges,omitempty"`
+		Ranges     [][]Range  `json:"ranges,omitempty"`
+		DNS        *DNS       `json:"dns,omitempty"`
+	}
+
+	// Range contains a single subnet for host-local IPAM plugin.
+	Range struct {
+		Subnet     string `json:"subnet,omitempty"`
+		RangeStart string `json:"rangeStart,omitempty"`
+		RangeEnd   string `json:"rangeEnd,omitempty"`
+		Gateway    string `json:"gateway,omitempty"`
+	}
+
+	// DNS contains the DNS configuration returned by IPAM plugin.
+	DNS struct {
+		Nameservers []string `json:"nameservers,omitempty"`
+		Domain      string   `json:"domain,omitempty"`
+		Search      []string `json:"search,omitempty"`
+		Options     []string `json:"options,omitempty"`
 	}
 )
@@ -1,5 +1,13 @@
 package nad
 
+import (
+	"github.com/golang/glog"
+	"k8s.io/utils/strings/slices"
+)
+
+// allowedFirewallBackends represents all allowed backends for firewall plugin type.
+var allowedFirewallBackends = []string{"iptables", "firewalld"}
+
 // TapPlugin returns tap network plugin configuration.
 func TapPlugin(owner, group int, multiQueue bool) *Plugin {
 	return &Plugin{
@@ -27,3 +35,69 @@ func TuningMacPlugin(macCap bool) *Plugin {
 		Capabilities: &Capability{Mac: macCap},
 	}
 }
+
+// RouteOverridePlugin returns route-override plugin configuration. The routes in delRoutes only need a destination.
+func RouteOverridePlugin(flushRoutes, flushGateway bool, delRoutes, addRoutes []Routes) *Plugin {
+	return &Plugin{
+		Type:         "route-override",
+		FlushRoutes:  flushRoutes,
+		FlushGateway: flushGateway,
+		DelRoutes:    delRoutes,
+		AddRoutes:    addRoutes,
+	}
+}
+
+// SBRPlugin returns source based routing plugin configuration. A table of 0 lets the plugin pick the first free
+// routing table.
+func SBRPlugin(table int) *Plugin {
+	if table < 0 {
+		glog.V(100).Infof("error sbr routing table %d can not be negative", table)
+
+		return nil
+	}
+
+	return &Plugin{
+		Type:  "sbr",
+		Table: table,
+	}
+}
+
+// BandwidthPlugin returns bandwidth plugin configuration. Rates and bursts are in bits per second and bits, each
+// rate requires a matching burst. When all values are 0 the limits are taken from the pod bandwidth annotations.
+func BandwidthPlugin(ingressRate, ingressBurst, egressRate, egressBurst uint64) *Plugin {
+	if (ingressRate == 0) != (ingressBurst == 0) || (egressRate == 0) != (egressBurst == 0) {
+		glog.V(100).Infof("error bandwidth rate and burst must be set together")
+
+		return nil
+	}
+
+	return &Plugin{
+		Type:         "bandwidth",
+		IngressRate:  ingressRate,
+		IngressBurst: ingressBurst,
+		EgressRate:   egressRate,
+		EgressBurst:  egressBurst,
+		Capabilities: &Capability{Bandwidth: true},
+	}
+}
+
+// FirewallPlugin returns firewall plugin configuration. The firewalldZone is only used by the firewalld backend.
+func FirewallPlugin(backend, firewalldZone string) *Plugin {
+	if !slices.Contains(allowedFirewallBackends, backend) {
+		glog.V(100).Infof("error firewall backend %s is invalid, allowed backends are %v", backend, allowedFirewallBackends)
+
+		return nil
+	}
+
+	if firewalldZone != "" && backend != "firewalld" {
+		glog.V(100).Infof("error firewalld zone can only be used with the firewalld backend")
+
+		return nil
+	}
+
+	return &Plugin{
+		Type:          "firewall",
+		Backend:       backend,
+		FirewalldZone: firewalldZone,
+	}
+}
@@ -0,0 +1,88 @@
+package nad
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSBRPlugin(t *testing.T) {
+	assert.Equal(t, &Plugin{Type: "sbr", Table: 100}, SBRPlugin(100))
+	assert.Equal(t, &Plugin{Type: "sbr"}, SBRPlugin(0))
+	assert.Nil(t, SBRPlugin(-1))
+}
+
+func TestBandwidthPlugin(t *testing.T) {
+	testCases := []struct {
+		ingressRate  uint64
+		ingressBurst uint64
+		egressRate   uint64
+		egressBurst  uint64
+		expectedNil  bool
+	}{
+		{ingressRate: 1000000, ingressBurst: 100000, egressRate: 2000000, egressBurst: 200000},
+		{ingressRate: 1000000, ingressBurst: 100000},
+		{},
+		{ingressRate: 1000000, expectedNil: true},
+		{egressBurst: 100000, expectedNil: true},
+	}
+
+	for _, testCase := range testCases {
+		plugin := BandwidthPlugin(
+			testCase.ingressRate, testCase.ingressBurst, testCase.egressRate, testCase.egressBurst)
+
+		if testCase.expectedNil {
+			assert.Nil(t, plugin)
+
+			continue
+		}
+
+		assert.Equal(t, &Plugin{
+			Type:         "bandwidth",
+			IngressRate:  testCase.ingressRate,
+			IngressBurst: testCase.ingressBurst,
+			EgressRate:   testCase.egressRate,
+			EgressBurst:  testCase.egressBurst,
+			Capabilities: &Capability{Bandwidth: true},
+		}, plugin)
+	}
+}
+
+func TestFirewallPlugin(t *testing.T) {
+	assert.Equal(t, &Plugin{Type: "firewall", Backend: "iptables"}, FirewallPlugin("iptables", ""))
+	assert.Equal(t, &Plugin{Type: "firewall", Backend: "firewalld", FirewalldZone: "trusted"},
+		FirewallPlugin("firewalld", "trusted"))
+	assert.Nil(t, FirewallPlugin("nftables", ""))
+	assert.Nil(t, FirewallPlugin("iptables", "trusted"))
+}
+
+func TestChainedPluginsConfig(t *testing.T) {
+	bridgePlugin := Plugin{Type: "bridge", Bridge: "br0", Ipam: IPAMDHCP()}
+	plugins := []Plugin{
+		bridgePlugin,
+		*RouteOverridePlugin(false, true, []Routes{{Dst: "10.0.0.0/8"}},
+			[]Routes{{Dst: "192.168.0.0/16", Gw: "10.1.0.1"}}),
+		*SBRPlugin(0),
+		*BandwidthPlugin(1000000, 100000, 0, 0),
+		*FirewallPlugin("iptables", ""),
+	}
+
+	builder := buildValidNADNetworkTestBuilder(buildTestClientWithDummyObject()).WithPlugins("chain", &plugins)
+	assert.Empty(t, builder.errorMsg)
+	assert.JSONEq(t, `{
+		"cniVersion": "0.4.0",
+		"name": "chain",
+		"plugins": [
+			{"type": "bridge", "bridge": "br0", "ipam": {"type": "dhcp"}},
+			{
+				"type": "route-override",
+				"flushgateway": true,
+				"delroutes": [{"dst": "10.0.0.0/8"}],
+				"addroutes": [{"dst": "192.168.0.0/16", "gw": "10.1.0.1"}]
+			},
+			{"type": "sbr"},
+			{"type": "bandwidth", "ingressRate": 1000000, "ingressBurst": 100000, "capabilities": {"bandwidth": true}},
+			{"type": "firewall", "backend": "iptables"}
+		]
+	}`, builder.Definition.Spec.Config)
+}
@@ -0,0 +1,640 @@
+package nad
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/golang/glog"
+	"k8s.io/utils/strings/slices"
+)
+
+var (
+	// metaPluginTypes represents the chained plugin types that modify an interface created by a previous plugin and
+	// therefore can not be the first plugin of a config.
+	metaPluginTypes = []string{"tuning", "route-override", "sbr", "bandwidth", "firewall"}
+	// allowedIPVlanMode represents all allowed modes for ipvlan plugin type.
+	allowedIPVlanMode = []string{"l2", "l3", "l3s"}
+	// allowedBondMode represents all bonding modes supported by the kernel and therefore by bond plugin type.
+	allowedBondMode = []string{
+		"balance-rr", "active-backup", "balance-xor", "broadcast", "802.3ad", "balance-tlb", "balance-alb"}
+	// allowedOnOff represents all allowed values for the on/off settings of sriov plugin type.
+	allowedOnOff = []string{"on", "off"}
+	// validOVNKTopology represents all topologies of ovn-k8s-cni-overlay plugin type, including the ones the
+	// builder does not support.
+	validOVNKTopology = []string{"layer2", "layer3", "localnet"}
+)
+
+type (
+	// cniConfig contains the fields shared by single plugin configs and plugin config lists.
+	cniConfig struct {
+		CNIVersion string            `json:"cniVersion"`
+		Name       string            `json:"name"`
+		Type       string            `json:"type"`
+		Plugins    []json.RawMessage `json:"plugins"`
+	}
+
+	// cniPluginConfig contains the fields of all plugin types that Validate checks, typed as the plugins expect them.
+	cniPluginConfig struct {
+		Type                string      `json:"type"`
+		Master              string      `json:"master"`
+		Mode                string      `json:"mode"`
+		Device              string      `json:"device"`
+		HWAddr              string      `json:"hwaddr"`
+		KernelPath          string      `json:"kernelpath"`
+		PCIBusID            string      `json:"pciBusID"`
+		Mtu                 *int        `json:"mtu"`
+		VlanID              *int        `json:"vlanId"`
+		Vlan                *int        `json:"vlan"`
+		VlanQoS             *int        `json:"vlanQoS"`
+		VlanTrunk           []VlanTrunk `json:"vlanTrunk"`
+		Links               []Link      `json:"links"`
+		Miimon              string      `json:"miimon"`
+		FailOverMac         int         `json:"failOverMac"`
+		SpoofChk            string      `json:"spoofchk"`
+		Trust               string      `json:"trust"`
+		LinkState           string      `json:"link_state"`
+		MinTxRate           uint        `json:"min_tx_rate"`
+		MaxTxRate           uint        `json:"max_tx_rate"`
+		Topology            string      `json:"topology"`
+		NetAttachDefName    string      `json:"netAttachDefName"`
+		Subnets             string      `json:"subnets"`
+		ExcludeSubnets      string      `json:"excludeSubnets"`
+		PhysicalNetworkName string      `json:"physicalNetworkName"`
+		LocalnetVlanID      *int        `json:"vlanID"`
+		DelRoutes           []Routes    `json:"delroutes"`
+		AddRoutes           []Routes    `json:"addroutes"`
+		Table               int         `json:"table"`
+		IngressRate         uint64      `json:"ingressRate"`
+		IngressBurst        uint64      `json:"ingressBurst"`
+		EgressRate          uint64      `json:"egressRate"`
+		EgressBurst         uint64      `json:"egressBurst"`
+		Backend             string      `json:"backend"`
+		FirewalldZone       string      `json:"firewalldZone"`
+		Ipam                *IPAM       `json:"ipam"`
+	}
+)
+
+// Validate parses the CNI config the NetworkAttachmentDefinition would be created with and checks it against the
+// rules of the plugin types and their IPAM, without accessing the cluster. All the problems found are returned
+// together in a single error.
+func (builder *Builder) Validate() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	glog.V(100).Infof("Validating CNI config of NetworkAttachmentDefinition %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if err := builder.fillConfigureString(); err != nil {
+		return fmt.Errorf("failed to render CNI config of NetworkAttachmentDefinition %s in namespace %s: %w",
+			builder.Definition.Name, builder.Definition.Namespace, err)
+	}
+
+	if err := validateCNIConfig(builder.Definition.Spec.Config,
+		builder.Definition.Namespace+"/"+builder.Definition.Name); err != nil {
+		glog.V(100).Infof("NetworkAttachmentDefinition %s in namespace %s has invalid CNI config: %v",
+			builder.Definition.Name, builder.Definition.Namespace, err)
+
+		return fmt.Errorf("invalid CNI config of NetworkAttachmentDefinition %s in namespace %s: %w",
+			builder.Definition.Name, builder.Definition.Namespace, err)
+	}
+
+	return nil
+}
+
+// validateCNIConfig validates config, which is either a single plugin config or a plugin config list, of the
+// NetworkAttachmentDefinition nadName in namespace/name format.
+func validateCNIConfig(config, nadName string) error {
+	if config == "" {
+		return fmt.Errorf("config is empty")
+	}
+
+	netConfig := &cniConfig{}
+	if err := json.Unmarshal([]byte(config), netConfig); err != nil {
+		return fmt.Errorf("failed to parse config: %w", err)
+	}
+
+	var errs []error
+
+	if netConfig.CNIVersion == "" {
+		errs = append(errs, fmt.Errorf("cniVersion is empty"))
+	}
+
+	if netConfig.Name == "" {
+		errs = append(errs, fmt.Errorf("name is empty"))
+	}
+
+	// A config without plugins is a single plugin config whose type is defined at the top level.
+	if netConfig.Plugins == nil {
+		if netConfig.Type == "" {
+			return errors.Join(append(errs, fmt.Errorf("config has neither type nor plugins"))...)
+		}
+
+		return errors.Join(append(errs, validateCNIPlugin([]byte(config), "plugin", true, nadName)...)...)
+	}
+
+	if len(netConfig.Plugins) == 0 {
+		return errors.Join(append(errs, fmt.Errorf("plugins list is empty"))...)
+	}
+
+	var pluginTypes []string
+
+	for index, rawPlugin := range netConfig.Plugins {
+		plugin := &cniPluginConfig{}
+		_ = json.Unmarshal(rawPlugin, plugin)
+
+		if plugin.Type != "" && slices.Contains(pluginTypes, plugin.Type) {
+			errs = append(errs, fmt.Errorf("plugins[%d]: duplicate plugin type %s in chain", index, plugin.Type))
+		}
+
+		pluginTypes = append(pluginTypes, plugin.Type)
+		errs = append(errs, validateCNIPlugin(rawPlugin, fmt.Sprintf("plugins[%d]", index), index == 0, nadName)...)
+	}
+
+	return errors.Join(errs...)
+}
+
+// validateCNIPlugin validates the config of a single plugin. The errors are prefixed with location and the plugin
+// type, first tells if the plugin is the first of the config and therefore has to create the interface.
+func validateCNIPlugin(rawPlugin []byte, location string, first bool, nadName string) []error {
+	plugin := &cniPluginConfig{}
+	if err := json.Unmarshal(rawPlugin, plugin); err != nil {
+		return []error{fmt.Errorf("%s: failed to parse plugin config: %w", location, err)}
+	}
+
+	if plugin.Type == "" {
+		return []error{fmt.Errorf("%s: type is empty", location)}
+	}
+
+	var errs []error
+
+	if first && slices.Contains(metaPluginTypes, plugin.Type) {
+		errs = append(errs, fmt.Errorf("%s plugin can only be chained after a plugin creating the interface",
+			plugin.Type))
+	}
+
+	if plugin.Mtu != nil && *plugin.Mtu <= 0 {
+		errs = append(errs, fmt.Errorf("mtu %d must be positive", *plugin.Mtu))
+	}
+
+	switch plugin.Type {
+	case "macvlan":
+		errs = append(errs, validateAllowedValue("mode", plugin.Mode, allowedMacVlanMode)...)
+	case "ipvlan":
+		errs = append(errs, validateAllowedValue("mode", plugin.Mode, allowedIPVlanMode)...)
+	case "vlan":
+		errs = append(errs, validateVlanPlugin(plugin)...)
+	case "bridge":
+		errs = append(errs, validateBridgePlugin(plugin)...)
+	case "host-device":
+		errs = append(errs, validateHostDevicePlugin(plugin)...)
+	case "bond":
+		errs = append(errs, validateBondPlugin(plugin)...)
+	case "sriov":
+		errs = append(errs, validateSRIOVPlugin(plugin)...)
+	case "ovn-k8s-cni-overlay":
+		errs = append(errs, validateOVNKubernetesPlugin(plugin, nadName)...)
+	case "route-override":
+		errs = append(errs, validateRoutes("delroutes", plugin.DelRoutes)...)
+		errs = append(errs, validateRoutes("addroutes", plugin.AddRoutes)...)
+	case "sbr":
+		if plugin.Table < 0 {
+			errs = append(errs, fmt.Errorf("table %d can not be negative", plugin.Table))
+		}
+	case "bandwidth":
+		if (plugin.IngressRate == 0) != (plugin.IngressBurst == 0) ||
+			(plugin.EgressRate == 0) != (plugin.EgressBurst == 0) {
+			errs = append(errs, fmt.Errorf("rate and burst must be set together"))
+		}
+	case "firewall":
+		errs = append(errs, validateFirewallPlugin(plugin)...)
+	}
+
+	if plugin.Ipam != nil {
+		errs = append(errs, validateIPAM(plugin.Ipam)...)
+	}
+
+	for index, err := range errs {
+		errs[index] = fmt.Errorf("%s (%s): %w", location, plugin.Type, err)
+	}
+
+	return errs
+}
+
+// validateVlanPlugin validates the config of vlan plugin type.
+func validateVlanPlugin(plugin *cniPluginConfig) []error {
+	var errs []error
+
+	if plugin.Master == "" {
+		errs = append(errs, fmt.Errorf("master interface is empty"))
+	}
+
+	if plugin.VlanID == nil {
+		errs = append(errs, fmt.Errorf("vlanId is missing"))
+	} else if *plugin.VlanID < 0 || *plugin.VlanID > 4094 {
+		errs = append(errs, fmt.Errorf("vlanId %d is not in range 0-4094", *plugin.VlanID))
+	}
+
+	return errs
+}
+
+// validateBridgePlugin validates the config of bridge plugin type.
+func validateBridgePlugin(plugin *cniPluginConfig) []error {
+	var errs []error
+
+	if plugin.Vlan != nil && (*plugin.Vlan < 0 || *plugin.Vlan > 4094) {
+		errs = append(errs, fmt.Errorf("vlan %d is not in range 0-4094", *plugin.Vlan))
+	}
+
+	if plugin.Vlan != nil && *plugin.Vlan != 0 && len(plugin.VlanTrunk) > 0 {
+		errs = append(errs, fmt.Errorf("vlan and vlanTrunk can not be set together"))
+	}
+
+	for _, trunk := range plugin.VlanTrunk {
+		if !isValidVlanTrunk(trunk) {
+			errs = append(errs, fmt.Errorf("invalid vlanTrunk %+v, expected either id or minID and maxID in range "+
+				"1-4094", trunk))
+		}
+	}
+
+	return errs
+}
+
+// validateHostDevicePlugin validates the config of host-device plugin type.
+func validateHostDevicePlugin(plugin *cniPluginConfig) []error {
+	var identifiers int
+
+	for _, identifier := range []string{plugin.Device, plugin.HWAddr, plugin.KernelPath, plugin.PCIBusID} {
+		if identifier != "" {
+			identifiers++
+		}
+	}
+
+	if identifiers != 1 {
+		return []error{fmt.Errorf("exactly one of device, hwaddr, kernelpath and pciBusID must be set, found %d",
+			identifiers)}
+	}
+
+	return nil
+}
+
+// validateBondPlugin validates the config of bond plugin type.
+func validateBondPlugin(plugin *cniPluginConfig) []error {
+	var errs []error
+
+	if plugin.Mode == "" {
+		errs = append(errs, fmt.Errorf("mode is empty"))
+	} else {
+		errs = append(errs, validateAllowedValue("mode", plugin.Mode, allowedBondMode)...)
+	}
+
+	if len(plugin.Links) == 0 {
+		errs = append(errs, fmt.Errorf("links are empty"))
+	}
+
+	var linkNames []string
+
+	for index, link := range plugin.Links {
+		switch {
+		case link.Name == "":
+			errs = append(errs, fmt.Errorf("links[%d] name is empty", index))
+		case slices.Contains(linkNames, link.Name):
+			errs = append(errs, fmt.Errorf("links[%d] duplicates link %s", index, link.Name))
+		}
+
+		linkNames = append(linkNames, link.Name)
+	}
+
+	if plugin.FailOverMac < 0 || plugin.FailOverMac > 2 {
+		errs = append(errs, fmt.Errorf("failOverMac %d is not in range 0-2", plugin.FailOverMac))
+	}
+
+	if plugin.Miimon != "" {
+		if miimon, err := strconv.Atoi(plugin.Miimon); err != nil || miimon < 0 {
+			errs = append(errs, fmt.Errorf("miimon %s is not a non-negative integer", plugin.Miimon))
+		}
+	}
+
+	return errs
+}
+
+// validateSRIOVPlugin validates the config of sriov plugin type.
+func validateSRIOVPlugin(plugin *cniPluginConfig) []error {
+	var errs []error
+
+	if plugin.Vlan != nil && (*plugin.Vlan < 0 || *plugin.Vlan > 4094) {
+		errs = append(errs, fmt.Errorf("vlan %d is not in range 0-4094", *plugin.Vlan))
+	}
+
+	if plugin.VlanQoS != nil && *plugin.VlanQoS != 0 {
+		if *plugin.VlanQoS < 0 || *plugin.VlanQoS > 7 {
+			errs = append(errs, fmt.Errorf("vlanQoS %d is not in range 0-7", *plugin.VlanQoS))
+		}
+
+		if plugin.Vlan == nil || *plugin.Vlan == 0 {
+			errs = append(errs, fmt.Errorf("vlanQoS requires a vlan"))
+		}
+	}
+
+	errs = append(errs, validateAllowedValue("spoofchk", plugin.SpoofChk, allowedOnOff)...)
+	errs = append(errs, validateAllowedValue("trust", plugin.Trust, allowedOnOff)...)
+	errs = append(errs, validateAllowedValue("link_state", plugin.LinkState, allowedSRIOVLinkState)...)
+
+	if plugin.MaxTxRate != 0 && plugin.MinTxRate > plugin.MaxTxRate {
+		errs = append(errs, fmt.Errorf("min_tx_rate %d is greater than max_tx_rate %d",
+			plugin.MinTxRate, plugin.MaxTxRate))
+	}
+
+	return errs
+}
+
+// validateOVNKubernetesPlugin validates the config of ovn-k8s-cni-overlay plugin type for the
+// NetworkAttachmentDefinition nadName.
+func validateOVNKubernetesPlugin(plugin *cniPluginConfig, nadName string) []error {
+	var errs []error
+
+	if plugin.Topology == "" {
+		errs = append(errs, fmt.Errorf("topology is empty"))
+	} else {
+		errs = append(errs, validateAllowedValue("topology", plugin.Topology, validOVNKTopology)...)
+	}
+
+	if plugin.NetAttachDefName != nadName {
+		errs = append(errs, fmt.Errorf("netAttachDefName %s does not match NetworkAttachmentDefinition %s",
+			plugin.NetAttachDefName, nadName))
+	}
+
+	if plugin.Ipam != nil {
+		errs = append(errs, fmt.Errorf("ipam is not supported, use subnets instead"))
+	}
+
+	var subnets []*net.IPNet
+
+	for _, subnet := range splitList(plugin.Subnets) {
+		// The layer3 subnets may carry the per node host subnet length, such as 10.128.0.0/16/24.
+		if plugin.Topology == "layer3" && strings.Count(subnet, "/") == 2 {
+			subnet = subnet[:strings.LastIndex(subnet, "/")]
+		}
+
+		_, network, err := net.ParseCIDR(subnet)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("invalid subnet: %w", err))
+
+			continue
+		}
+
+		subnets = append(subnets, network)
+	}
+
+	for _, excludeSubnet := range splitList(plugin.ExcludeSubnets) {
+		_, network, err := net.ParseCIDR(excludeSubnet)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("invalid excludeSubnet: %w", err))
+
+			continue
+		}
+
+		if !isNetworkInAny(network, subnets) {
+			errs = append(errs, fmt.Errorf("excludeSubnet %s is not inside any subnet", excludeSubnet))
+		}
+	}
+
+	if plugin.Topology != "localnet" && plugin.LocalnetVlanID != nil {
+		errs = append(errs, fmt.Errorf("vlanID requires localnet topology"))
+	}
+
+	if plugin.LocalnetVlanID != nil && (*plugin.LocalnetVlanID < 1 || *plugin.LocalnetVlanID > 4094) {
+		errs = append(errs, fmt.Errorf("vlanID %d is not in range 1-4094", *plugin.LocalnetVlanID))
+	}
+
+	if plugin.Topology != "localnet" && plugin.PhysicalNetworkName != "" {
+		errs = append(errs, fmt.Errorf("physicalNetworkName requires localnet topology"))
+	}
+
+	return errs
+}
+
+// validateFirewallPlugin validates the config of firewall plugin type.
+func validateFirewallPlugin(plugin *cniPluginConfig) []error {
+	var errs []error
+
+	errs = append(errs, validateAllowedValue("backend", plugin.Backend, allowedFirewallBackends)...)
+
+	if plugin.FirewalldZone != "" && plugin.Backend != "firewalld" {
+		errs = append(errs, fmt.Errorf("firewalldZone requires firewalld backend"))
+	}
+
+	return errs
+}
+
+// validateIPAM validates the IPAM config of a plugin.
+func validateIPAM(ipam *IPAM) []error {
+	var errs []error
+
+	switch ipam.Type {
+	case "":
+		errs = append(errs, fmt.Errorf("ipam type is empty"))
+	case "whereabouts":
+		errs = append(errs, validateWhereAboutsIPAM(ipam)...)
+	case "host-local":
+		errs = append(errs, validateHostLocalIPAM(ipam)...)
+	}
+
+	errs = append(errs, validateRoutes("ipam routes", ipam.Routes)...)
+
+	if ipam.DNS != nil {
+		for _, nameserver := range ipam.DNS.Nameservers {
+			if net.ParseIP(nameserver) == nil {
+				errs = append(errs, fmt.Errorf("ipam dns nameserver %s is not a valid IP address", nameserver))
+			}
+		}
+	}
+
+	return errs
+}
+
+// validateWhereAboutsIPAM validates the ranges, gateways and exclude list of whereabouts IPAM.
+func validateWhereAboutsIPAM(ipam *IPAM) []error {
+	ipRanges := ipam.IPRanges
+
+	// The top level range is the single range format that predates ipRanges.
+	if ipam.AddrRange != "" {
+		ipRanges = append([]IPRanges{{Range: ipam.AddrRange, Gateway: ipam.Gateway}}, ipRanges...)
+	}
+
+	if len(ipRanges) == 0 {
+		return []error{fmt.Errorf("whereabouts ipam has no range")}
+	}
+
+	var (
+		errs     []error
+		networks []*net.IPNet
+	)
+
+	for index, ipRange := range ipRanges {
+		// The range may be limited to the addresses starting at an IP, such as 192.168.0.10-192.168.0.0/24.
+		cidr := ipRange.Range
+		if _, after, found := strings.Cut(cidr, "-"); found {
+			cidr = after
+		}
+
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("invalid whereabouts range: %w", err))
+
+			continue
+		}
+
+		errs = append(errs, validateAddressInNetwork(network, "gateway", ipRange.Gateway)...)
+
+		// The range_start and range_end only limit the top level range.
+		if index == 0 && ipam.AddrRange != "" {
+			errs = append(errs, validateAddressInNetwork(network, "range_start", ipam.RangeStart)...)
+			errs = append(errs, validateAddressInNetwork(network, "range_end", ipam.RangeEnd)...)
+		}
+
+		networks = append(networks, network)
+	}
+
+	errs = append(errs, validateNoOverlap(networks)...)
+
+	for _, exclude := range ipam.Exclude {
+		_, network, err := net.ParseCIDR(exclude)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("invalid exclude: %w", err))
+
+			continue
+		}
+
+		if !isNetworkInAny(network, networks) {
+			errs = append(errs, fmt.Errorf("exclude %s is not inside any range", exclude))
+		}
+	}
+
+	return errs
+}
+
+// validateHostLocalIPAM validates the ranges of host-local IPAM.
+func validateHostLocalIPAM(ipam *IPAM) []error {
+	if len(ipam.Ranges) == 0 {
+		return []error{fmt.Errorf("host-local ipam has no ranges")}
+	}
+
+	var (
+		errs     []error
+		networks []*net.IPNet
+	)
+
+	for setIndex, rangeSet := range ipam.Ranges {
+		if len(rangeSet) == 0 {
+			errs = append(errs, fmt.Errorf("host-local ranges[%d] is empty", setIndex))
+		}
+
+		for _, addrRange := range rangeSet {
+			_, network, err := net.ParseCIDR(addrRange.Subnet)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("invalid host-local subnet: %w", err))
+
+				continue
+			}
+
+			errs = append(errs, validateAddressInNetwork(network, "gateway", addrRange.Gateway)...)
+			errs = append(errs, validateAddressInNetwork(network, "rangeStart", addrRange.RangeStart)...)
+			errs = append(errs, validateAddressInNetwork(network, "rangeEnd", addrRange.RangeEnd)...)
+			networks = append(networks, network)
+		}
+	}
+
+	return append(errs, validateNoOverlap(networks)...)
+}
+
+// validateAddressInNetwork returns an error if address is set but is not a valid IP address inside network.
+func validateAddressInNetwork(network *net.IPNet, field, address string) []error {
+	if address == "" {
+		return nil
+	}
+
+	ipAddress := net.ParseIP(address)
+	if ipAddress == nil {
+		return []error{fmt.Errorf("%s %s is not a valid IP address", field, address)}
+	}
+
+	if !network.Contains(ipAddress) {
+		return []error{fmt.Errorf("%s %s is not inside %s", field, address, network)}
+	}
+
+	return nil
+}
+
+// validateNoOverlap returns an error for every pair of overlapping networks.
+func validateNoOverlap(networks []*net.IPNet) []error {
+	var errs []error
+
+	for index, network := range networks {
+		for _, otherNetwork := range networks[index+1:] {
+			if network.Contains(otherNetwork.IP) || otherNetwork.Contains(network.IP) {
+				errs = append(errs, fmt.Errorf("range %s overlaps with range %s", network, otherNetwork))
+			}
+		}
+	}
+
+	return errs
+}
+
+// validateRoutes checks that the destinations and gateways of routes are valid.
+func validateRoutes(field string, routes []Routes) []error {
+	var errs []error
+
+	for _, route := range routes {
+		if _, _, err := net.ParseCIDR(route.Dst); err != nil {
+			errs = append(errs, fmt.Errorf("invalid %s destination: %w", field, err))
+		}
+
+		if route.Gw != "" && net.ParseIP(route.Gw) == nil {
+			errs = append(errs, fmt.Errorf("%s gateway %s is not a valid IP address", field, route.Gw))
+		}
+	}
+
+	return errs
+}
+
+// validateAllowedValue returns an error if value is set but is not one of allowedValues.
+func validateAllowedValue(field, value string, allowedValues []string) []error {
+	if value == "" || slices.Contains(allowedValues, value) {
+		return nil
+	}
+
+	return []error{fmt.Errorf("invalid %s %s, allowed values are %v", field, value, allowedValues)}
+}
+
+// isNetworkInAny checks if network is fully contained in any of networks.
+func isNetworkInAny(network *net.IPNet, networks []*net.IPNet) bool {
+	networkOnes, _ := network.Mask.Size()
+
+	for _, otherNetwork := range networks {
+		otherOnes, _ := otherNetwork.Mask.Size()
+
+		if otherNetwork.Contains(network.IP) && networkOnes >= otherOnes {
+			return true
+		}
+	}
+
+	return false
+}
+
+// splitList returns the non-empty elements of the comma separated list.
+func splitList(list string) []string {
+	var elements []string
+
+	for _, element := range strings.Split(list, ",") {
+		if element = strings.TrimSpace(element); element != "" {
+			elements = append(elements, element)
+		}
+	}
+
+	return elements
+}
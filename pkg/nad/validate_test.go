@@ -0,0 +1,161 @@
+package nad
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+//nolint:funlen
+func TestNADValidate(t *testing.T) {
+	testCases := []struct {
+		config         string
+		expectedErrors []string
+	}{
+		{
+			config: mustMasterPluginConfig(t, NewMasterMacVlanPlugin("macvlan").WithMode("bridge").
+				WithMasterInterface("ens1f0").WithIPAM(IPAMWhereAbouts("192.168.0.0/24", "192.168.0.1"))),
+		},
+		{
+			config: mustMasterPluginConfig(t, NewMasterOVNKubernetesPlugin("l2", "nadnamespace/nadtest", "layer2").
+				WithSubnets("10.100.0.0/16").WithExcludeSubnets("10.100.0.0/30")),
+		},
+		{
+			config: `{"cniVersion": "0.4.0", "name": "chain", "plugins": [
+				{"type": "sriov", "vlan": 100, "spoofchk": "on", "ipam": {"type": "host-local",
+					"ranges": [[{"subnet": "10.0.0.0/24", "gateway": "10.0.0.1"}], [{"subnet": "fd00::/64"}]],
+					"routes": [{"dst": "0.0.0.0/0"}], "dns": {"nameservers": ["10.0.0.53"]}}},
+				{"type": "tuning", "sysctl": {"net.ipv4.conf.IFNAME.accept_redirects": "1"}},
+				{"type": "sbr"}]}`,
+		},
+		{
+			config:         "",
+			expectedErrors: []string{"config is empty"},
+		},
+		{
+			config:         "{",
+			expectedErrors: []string{"failed to parse config: unexpected end of JSON input"},
+		},
+		{
+			config:         `{"cniVersion": "0.4.0", "name": "test"}`,
+			expectedErrors: []string{"config has neither type nor plugins"},
+		},
+		{
+			config:         `{"cniVersion": "0.4.0", "name": "test", "plugins": []}`,
+			expectedErrors: []string{"plugins list is empty"},
+		},
+		{
+			config:         mustMasterPluginConfig(t, NewMasterVlanPlugin("vlan", 100)),
+			expectedErrors: []string{"plugin (vlan): master interface is empty"},
+		},
+		{
+			config: `{"cniVersion": "0.3.1", "name": "test", "type": "bridge", "vlan": "100"}`,
+			expectedErrors: []string{"plugin: failed to parse plugin config: json: cannot unmarshal string into Go " +
+				"struct field cniPluginConfig.vlan of type int"},
+		},
+		{
+			config: `{"cniVersion": "0.3.1", "name": "test", "type": "bridge", "vlan": 10,
+				"vlanTrunk": [{"id": 20}]}`,
+			expectedErrors: []string{"plugin (bridge): vlan and vlanTrunk can not be set together"},
+		},
+		{
+			config: `{"cniVersion": "0.3.1", "name": "test", "type": "bond", "mode": "active-backup",
+				"links": [{"name": "net1"}, {"name": "net1"}, {}]}`,
+			expectedErrors: []string{
+				"plugin (bond): links[1] duplicates link net1",
+				"plugin (bond): links[2] name is empty",
+			},
+		},
+		{
+			config: mustMasterPluginConfig(t, NewMasterMacVlanPlugin("macvlan").WithMasterInterface("ens1f0").
+				WithIPAM(WhereAboutsAppendRange(IPAMWhereAbouts("192.168.0.0/24", "192.168.1.1"),
+					"192.168.0.128/25", "192.168.0.129"))),
+			expectedErrors: []string{
+				"plugin (macvlan): gateway 192.168.1.1 is not inside 192.168.0.0/24",
+				"plugin (macvlan): range 192.168.0.0/24 overlaps with range 192.168.0.128/25",
+			},
+		},
+		{
+			config: `{"cniVersion": "0.3.1", "name": "test", "type": "macvlan", "ipam": {"type": "whereabouts",
+				"range": "192.168.0.10-192.168.0.0/24", "exclude": ["192.168.1.0/28", "192.168.0.0"]}}`,
+			expectedErrors: []string{
+				"plugin (macvlan): exclude 192.168.1.0/28 is not inside any range",
+				"plugin (macvlan): invalid exclude: invalid CIDR address: 192.168.0.0",
+			},
+		},
+		{
+			config: `{"cniVersion": "0.3.1", "name": "test", "type": "host-device", "device": "ens1f0",
+				"pciBusID": "0000:3b:00.0", "ipam": {"type": "host-local",
+				"ranges": [[{"subnet": "10.0.0.0/24", "rangeStart": "10.0.1.10"}]]}}`,
+			expectedErrors: []string{
+				"plugin (host-device): exactly one of device, hwaddr, kernelpath and pciBusID must be set, found 2",
+				"plugin (host-device): rangeStart 10.0.1.10 is not inside 10.0.0.0/24",
+			},
+		},
+		{
+			config: `{"cniVersion": "0.3.1", "name": "test", "type": "ovn-k8s-cni-overlay", "topology": "layer2",
+				"netAttachDefName": "other/nadtest", "subnets": "10.100.0.0/16", "excludeSubnets": "10.200.0.0/30",
+				"vlanID": 10, "ipam": {"type": "static"}}`,
+			expectedErrors: []string{
+				"plugin (ovn-k8s-cni-overlay): netAttachDefName other/nadtest does not match " +
+					"NetworkAttachmentDefinition nadnamespace/nadtest",
+				"plugin (ovn-k8s-cni-overlay): ipam is not supported, use subnets instead",
+				"plugin (ovn-k8s-cni-overlay): excludeSubnet 10.200.0.0/30 is not inside any subnet",
+				"plugin (ovn-k8s-cni-overlay): vlanID requires localnet topology",
+			},
+		},
+		{
+			config: `{"cniVersion": "0.4.0", "name": "test", "plugins": [
+				{"type": "tuning"}, {"type": "bridge"}, {"type": "tuning"}, {"type": "firewall", "backend": "nft"}]}`,
+			expectedErrors: []string{
+				"plugins[0] (tuning): tuning plugin can only be chained after a plugin creating the interface",
+				"plugins[2]: duplicate plugin type tuning in chain",
+				"plugins[3] (firewall): invalid backend nft, allowed values are [iptables firewalld]",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidNADNetworkTestBuilder(buildTestClientWithDummyObject())
+		testBuilder.Definition.Spec.Config = testCase.config
+
+		err := testBuilder.Validate()
+
+		if len(testCase.expectedErrors) == 0 {
+			assert.Nil(t, err)
+
+			continue
+		}
+
+		assert.EqualError(t, err, fmt.Sprintf("invalid CNI config of NetworkAttachmentDefinition %s in namespace %s: %s",
+			defaultNetName, defaultNetNsName, strings.Join(testCase.expectedErrors, "\n")))
+	}
+}
+
+func TestNADValidateWithMetaPlugins(t *testing.T) {
+	testBuilder := buildValidNADNetworkTestBuilder(buildTestClientWithDummyObject())
+	testBuilder.metaPluginConfigs = []Plugin{*BandwidthPlugin(1000, 100, 0, 0), {Type: "bridge"}}
+
+	err := testBuilder.Validate()
+	assert.EqualError(t, err, fmt.Sprintf("invalid CNI config of NetworkAttachmentDefinition %s in namespace %s: "+
+		"plugins[0] (bandwidth): bandwidth plugin can only be chained after a plugin creating the interface",
+		defaultNetName, defaultNetNsName))
+
+	testBuilder = buildInvalidNADNetworkTestBuilder(buildTestClientWithDummyObject())
+	assert.EqualError(t, testBuilder.Validate(), "NAD namespace is empty")
+}
+
+// mustMasterPluginConfig returns the rendered config of the master plugin built by pluginBuilder.
+func mustMasterPluginConfig(t *testing.T, pluginBuilder interface {
+	GetMasterPluginConfig() (*MasterPlugin, error)
+}) string {
+	t.Helper()
+
+	masterPlugin, err := pluginBuilder.GetMasterPluginConfig()
+	assert.Nil(t, err)
+
+	return buildValidNADNetworkTestBuilder(buildTestClientWithDummyObject()).
+		WithMasterPlugin(masterPlugin).Definition.Spec.Config
+}
@@ -11,6 +11,12 @@ import (
 
 // List returns namespace inventory.
 func List(apiClient *clients.Settings, options ...metav1.ListOptions) ([]*Builder, error) {
+	return ListWithContext(context.Background(), apiClient, options...)
+}
+
+// ListWithContext returns namespace inventory using the provided context.
+func ListWithContext(
+	ctx context.Context, apiClient *clients.Settings, options ...metav1.ListOptions) ([]*Builder, error) {
 	logMessage := "Listing all namespace resources"
 	passedOptions := metav1.ListOptions{}
 
@@ -27,7 +33,7 @@ func List(apiClient *clients.Settings, options ...metav1.ListOptions) ([]*Builde
 
 	glog.V(100).Infof(logMessage)
 
-	namespacesList, err := apiClient.CoreV1Interface.Namespaces().List(context.TODO(), passedOptions)
+	namespacesList, err := apiClient.CoreV1Interface.Namespaces().List(ctx, passedOptions)
 	if err != nil {
 		glog.V(100).Infof("Failed to list namespaces due to %s", err.Error())
 
@@ -141,19 +141,29 @@ func (builder *Builder) WithOptions(options ...AdditionalOptions) *Builder {
 
 // Create makes a namespace in the cluster and stores the created object in struct.
 func (builder *Builder) Create() (*Builder, error) {
+	return builder.CreateWithContext(context.Background())
+}
+
+// CreateWithContext makes a namespace in the cluster using the provided context and stores the created object in
+// struct.
+func (builder *Builder) CreateWithContext(ctx context.Context) (*Builder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
 
 	glog.V(100).Infof("Creating namespace %s", builder.Definition.Name)
 
-	if builder.Exists() {
+	if err := ctx.Err(); err != nil {
+		return builder, err
+	}
+
+	if builder.ExistsWithContext(ctx) {
 		return builder, nil
 	}
 
 	var err error
 
-	builder.Object, err = builder.apiClient.Namespaces().Create(context.TODO(), builder.Definition, metav1.CreateOptions{})
+	builder.Object, err = builder.apiClient.Namespaces().Create(ctx, builder.Definition, metav1.CreateOptions{})
 	if err != nil {
 		return builder, err
 	}
@@ -163,6 +173,12 @@ func (builder *Builder) Create() (*Builder, error) {
 
 // Update renovates the existing namespace object with the namespace definition in builder.
 func (builder *Builder) Update() (*Builder, error) {
+	return builder.UpdateWithContext(context.Background())
+}
+
+// UpdateWithContext renovates the existing namespace object with the namespace definition in builder using the
+// provided context.
+func (builder *Builder) UpdateWithContext(ctx context.Context) (*Builder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
@@ -171,20 +187,25 @@ func (builder *Builder) Update() (*Builder, error) {
 
 	var err error
 	builder.Object, err = builder.apiClient.Namespaces().Update(
-		context.TODO(), builder.Definition, metav1.UpdateOptions{})
+		ctx, builder.Definition, metav1.UpdateOptions{})
 
 	return builder, err
 }
 
 // Delete removes a namespace.
 func (builder *Builder) Delete() error {
+	return builder.DeleteWithContext(context.Background())
+}
+
+// DeleteWithContext removes a namespace using the provided context.
+func (builder *Builder) DeleteWithContext(ctx context.Context) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
 
 	glog.V(100).Infof("Deleting namespace %s", builder.Definition.Name)
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		glog.V(100).Infof("Namespace %s does not exist", builder.Definition.Name)
 
 		builder.Object = nil
@@ -192,7 +213,7 @@ func (builder *Builder) Delete() error {
 		return nil
 	}
 
-	err := builder.apiClient.Namespaces().Delete(context.TODO(), builder.Definition.Name, metav1.DeleteOptions{})
+	err := builder.apiClient.Namespaces().Delete(ctx, builder.Definition.Name, metav1.DeleteOptions{})
 
 	if err != nil {
 		return err
@@ -205,19 +226,24 @@ func (builder *Builder) Delete() error {
 
 // DeleteAndWait deletes a namespace and waits until it is removed from the cluster.
 func (builder *Builder) DeleteAndWait(timeout time.Duration) error {
+	return builder.DeleteAndWaitWithContext(context.Background(), timeout)
+}
+
+// DeleteAndWaitWithContext deletes a namespace and waits until it is removed from the cluster or ctx is done.
+func (builder *Builder) DeleteAndWaitWithContext(ctx context.Context, timeout time.Duration) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
 
 	glog.V(100).Infof("Deleting namespace %s and waiting for the removal to complete", builder.Definition.Name)
 
-	if err := builder.Delete(); err != nil {
+	if err := builder.DeleteWithContext(ctx); err != nil {
 		return err
 	}
 
 	return wait.PollUntilContextTimeout(
-		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
-			_, err := builder.apiClient.Namespaces().Get(context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+		ctx, time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			_, err := builder.apiClient.Namespaces().Get(ctx, builder.Definition.Name, metav1.GetOptions{})
 			if k8serrors.IsNotFound(err) {
 				return true, nil
 			}
@@ -232,6 +258,11 @@ func (builder *Builder) DeleteAndWait(timeout time.Duration) error {
 
 // Exists checks whether the given namespace exists.
 func (builder *Builder) Exists() bool {
+	return builder.ExistsWithContext(context.Background())
+}
+
+// ExistsWithContext checks whether the given namespace exists using the provided context.
+func (builder *Builder) ExistsWithContext(ctx context.Context) bool {
 	if valid, _ := builder.validate(); !valid {
 		return false
 	}
@@ -240,7 +271,7 @@ func (builder *Builder) Exists() bool {
 
 	var err error
 	builder.Object, err = builder.apiClient.Namespaces().Get(
-		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+		ctx, builder.Definition.Name, metav1.GetOptions{})
 
 	return err == nil || !k8serrors.IsNotFound(err)
 }
@@ -275,6 +306,12 @@ func Pull(apiClient *clients.Settings, nsname string) (*Builder, error) {
 
 // CleanObjects removes given objects from the namespace.
 func (builder *Builder) CleanObjects(cleanTimeout time.Duration, objects ...schema.GroupVersionResource) error {
+	return builder.CleanObjectsWithContext(context.Background(), cleanTimeout, objects...)
+}
+
+// CleanObjectsWithContext removes given objects from the namespace, aborting once ctx is done.
+func (builder *Builder) CleanObjectsWithContext(
+	ctx context.Context, cleanTimeout time.Duration, objects ...schema.GroupVersionResource) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
@@ -286,7 +323,7 @@ func (builder *Builder) CleanObjects(cleanTimeout time.Duration, objects ...sche
 			builder.Definition.Name)
 	}
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		return fmt.Errorf("failed to remove resources from non-existent namespace %s",
 			builder.Definition.Name)
 	}
@@ -296,7 +333,7 @@ func (builder *Builder) CleanObjects(cleanTimeout time.Duration, objects ...sche
 			resource.Resource, builder.Definition.Name)
 
 		err := builder.apiClient.Resource(resource).Namespace(builder.Definition.Name).DeleteCollection(
-			context.TODO(), metav1.DeleteOptions{}, metav1.ListOptions{})
+			ctx, metav1.DeleteOptions{}, metav1.ListOptions{})
 
 		if err != nil {
 			glog.V(100).Infof("Failed to remove resources: %s in namespace: %s",
@@ -306,9 +343,9 @@ func (builder *Builder) CleanObjects(cleanTimeout time.Duration, objects ...sche
 		}
 
 		err = wait.PollUntilContextTimeout(
-			context.TODO(), 3*time.Second, cleanTimeout, true, func(ctx context.Context) (bool, error) {
+			ctx, 3*time.Second, cleanTimeout, true, func(ctx context.Context) (bool, error) {
 				objList, err := builder.apiClient.Resource(resource).Namespace(builder.Definition.Name).List(
-					context.TODO(), metav1.ListOptions{})
+					ctx, metav1.ListOptions{})
 
 				if err != nil || len(objList.Items) > 0 {
 					// avoid timeout due to default automatically created openshift
@@ -0,0 +1,506 @@
+package namespace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/discovery"
+	"k8s.io/utils/strings/slices"
+)
+
+// DefaultSnapshotExcludedResources are the resources left out of namespace snapshots because they are managed by the
+// cluster and change without any action of the tests.
+var DefaultSnapshotExcludedResources = []schema.GroupResource{
+	{Group: "", Resource: "events"},
+	{Group: "events.k8s.io", Resource: "events"},
+	{Group: "", Resource: "endpoints"},
+	{Group: "discovery.k8s.io", Resource: "endpointslices"},
+}
+
+// snapshotRequiredVerbs are the verbs a resource must support to be both captured and reset.
+var snapshotRequiredVerbs = []string{"list", "create", "update", "delete"}
+
+// DriftType is the kind of change of an object since a namespace snapshot.
+type DriftType string
+
+const (
+	// DriftCreated is an object created since the snapshot.
+	DriftCreated DriftType = "created"
+	// DriftModified is an object modified since the snapshot.
+	DriftModified DriftType = "modified"
+	// DriftDeleted is an object deleted since the snapshot.
+	DriftDeleted DriftType = "deleted"
+)
+
+// Drift is a change of an object since a namespace snapshot.
+type Drift struct {
+	Type     DriftType
+	Resource schema.GroupVersionResource
+	Name     string
+	// Fields are the top-level fields of a modified object that changed, metadata labels and annotations being
+	// reported as metadata.labels and metadata.annotations.
+	Fields []string
+}
+
+// String returns a short description of the drift.
+func (drift Drift) String() string {
+	description := fmt.Sprintf("%s %s %s", drift.Type, drift.Resource.GroupResource(), drift.Name)
+
+	if len(drift.Fields) > 0 {
+		description += ": " + strings.Join(drift.Fields, ", ")
+	}
+
+	return description
+}
+
+// Snapshot is the baseline state of the objects of a namespace, captured by Builder.Snapshot. Only the objects not
+// controlled by another object are captured since controlled objects follow their controller.
+type Snapshot struct {
+	Namespace string
+	Time      time.Time
+	// Resources are the namespaced resources captured in the snapshot.
+	Resources []schema.GroupVersionResource
+
+	kinds   map[schema.GroupVersionResource]string
+	objects map[snapshotKey]*unstructured.Unstructured
+}
+
+// snapshotKey identifies an object of a snapshot.
+type snapshotKey struct {
+	resource schema.GroupVersionResource
+	name     string
+}
+
+// Snapshot captures the objects of all the namespaced resources of the namespace, as discovered through the API,
+// except the excludedResources and DefaultSnapshotExcludedResources. The snapshot can later be compared to the
+// namespace with Drift or restored with Reset.
+func (builder *Builder) Snapshot(excludedResources ...schema.GroupResource) (*Snapshot, error) {
+	return builder.SnapshotWithContext(context.Background(), excludedResources...)
+}
+
+// SnapshotWithContext captures a snapshot of the namespace, aborting once ctx is done. See Snapshot.
+func (builder *Builder) SnapshotWithContext(
+	ctx context.Context, excludedResources ...schema.GroupResource) (*Snapshot, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	glog.V(100).Infof("Capturing snapshot of namespace %s", builder.Definition.Name)
+
+	if !builder.ExistsWithContext(ctx) {
+		return nil, fmt.Errorf("failed to snapshot non-existent namespace %s", builder.Definition.Name)
+	}
+
+	kinds, err := builder.discoverNamespacedResources(append(excludedResources, DefaultSnapshotExcludedResources...))
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := &Snapshot{Namespace: builder.Definition.Name, Time: time.Now(), kinds: kinds}
+
+	for resource := range kinds {
+		snapshot.Resources = append(snapshot.Resources, resource)
+	}
+
+	sort.Slice(snapshot.Resources, func(i, j int) bool {
+		return snapshot.Resources[i].String() < snapshot.Resources[j].String()
+	})
+
+	snapshot.objects, err = builder.listSnapshotObjects(ctx, kinds)
+	if err != nil {
+		return nil, err
+	}
+
+	glog.V(100).Infof("Captured %d objects of %d resources in namespace %s",
+		len(snapshot.objects), len(snapshot.Resources), builder.Definition.Name)
+
+	return snapshot, nil
+}
+
+// Drift returns the changes of the namespace since snapshot, sorted by type, resource and name.
+func (builder *Builder) Drift(snapshot *Snapshot) ([]Drift, error) {
+	return builder.DriftWithContext(context.Background(), snapshot)
+}
+
+// DriftWithContext returns the changes of the namespace since snapshot, aborting once ctx is done.
+func (builder *Builder) DriftWithContext(ctx context.Context, snapshot *Snapshot) ([]Drift, error) {
+	if err := builder.validateSnapshot(snapshot); err != nil {
+		return nil, err
+	}
+
+	glog.V(100).Infof("Computing drift of namespace %s since %s", builder.Definition.Name, snapshot.Time)
+
+	current, err := builder.listSnapshotObjects(ctx, snapshot.kinds)
+	if err != nil {
+		return nil, err
+	}
+
+	return computeDrift(snapshot.objects, current), nil
+}
+
+// Reset restores the namespace to snapshot: objects created since are deleted, modified objects are restored and
+// deleted objects are recreated. It returns the drift found before the reset. The status of objects is not restored
+// since it is owned by their controllers.
+func (builder *Builder) Reset(snapshot *Snapshot, timeout time.Duration) ([]Drift, error) {
+	return builder.ResetWithContext(context.Background(), snapshot, timeout)
+}
+
+// ResetWithContext restores the namespace to snapshot within timeout, aborting once ctx is done. See Reset.
+func (builder *Builder) ResetWithContext(
+	ctx context.Context, snapshot *Snapshot, timeout time.Duration) ([]Drift, error) {
+	if err := builder.validateSnapshot(snapshot); err != nil {
+		return nil, err
+	}
+
+	glog.V(100).Infof("Resetting namespace %s to its snapshot from %s", builder.Definition.Name, snapshot.Time)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	current, err := builder.listSnapshotObjects(ctx, snapshot.kinds)
+	if err != nil {
+		return nil, err
+	}
+
+	drifts := computeDrift(snapshot.objects, current)
+
+	var errs []error
+
+	// Created objects are deleted first so that deleted objects can be recreated even if their name was reused.
+	for _, drift := range drifts {
+		if drift.Type == DriftCreated {
+			errs = append(errs, builder.deleteSnapshotObject(ctx, drift))
+		}
+	}
+
+	if err := builder.waitForSnapshotObjectsDeleted(ctx, drifts); err != nil {
+		errs = append(errs, err)
+	}
+
+	for _, drift := range drifts {
+		key := snapshotKey{resource: drift.Resource, name: drift.Name}
+
+		switch drift.Type {
+		case DriftModified:
+			errs = append(errs, builder.restoreSnapshotObject(ctx, drift.Resource, snapshot.objects[key], current[key]))
+		case DriftDeleted:
+			errs = append(errs, builder.recreateSnapshotObject(ctx, drift.Resource, snapshot.objects[key]))
+		case DriftCreated:
+			// Already deleted above.
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return drifts, fmt.Errorf("failed to reset namespace %s: %w", builder.Definition.Name, err)
+	}
+
+	return drifts, nil
+}
+
+// discoverNamespacedResources returns the kind of the preferred version of the namespaced resources of the cluster
+// that can be listed, created, updated and deleted, except the excludedResources.
+func (builder *Builder) discoverNamespacedResources(
+	excludedResources []schema.GroupResource) (map[schema.GroupVersionResource]string, error) {
+	groups, resourceLists, err := builder.apiClient.K8sClient.Discovery().ServerGroupsAndResources()
+	if err != nil {
+		// Resources of unavailable aggregated APIs cannot be listed anyway, so they are skipped.
+		if !discovery.IsGroupDiscoveryFailedError(err) {
+			return nil, fmt.Errorf("failed to discover the namespaced resources: %w", err)
+		}
+
+		glog.V(100).Infof("Skipping resources of unavailable API groups: %v", err)
+	}
+
+	preferredVersions := map[string]string{}
+	for _, group := range groups {
+		preferredVersions[group.Name] = group.PreferredVersion.GroupVersion
+	}
+
+	kinds := map[schema.GroupVersionResource]string{}
+
+	for _, resourceList := range resourceLists {
+		groupVersion, err := schema.ParseGroupVersion(resourceList.GroupVersion)
+		if err != nil || preferredVersions[groupVersion.Group] != resourceList.GroupVersion {
+			continue
+		}
+
+		for _, resource := range resourceList.APIResources {
+			groupResource := schema.GroupResource{Group: groupVersion.Group, Resource: resource.Name}
+
+			// Subresources such as pods/log are named after their resource and a slash.
+			if !resource.Namespaced || strings.Contains(resource.Name, "/") ||
+				!hasAllVerbs(resource.Verbs, snapshotRequiredVerbs) ||
+				containsGroupResource(excludedResources, groupResource) {
+				continue
+			}
+
+			kinds[groupVersion.WithResource(resource.Name)] = resource.Kind
+		}
+	}
+
+	return kinds, nil
+}
+
+// listSnapshotObjects returns the objects of the resources, mapped to their kind, in the namespace that are not
+// controlled by another object.
+func (builder *Builder) listSnapshotObjects(
+	ctx context.Context,
+	kinds map[schema.GroupVersionResource]string) (map[snapshotKey]*unstructured.Unstructured, error) {
+	objects := map[snapshotKey]*unstructured.Unstructured{}
+
+	for resource, kind := range kinds {
+		objectList, err := builder.apiClient.Resource(resource).Namespace(builder.Definition.Name).List(
+			ctx, metav1.ListOptions{})
+		if err != nil {
+			glog.V(100).Infof("Failed to list %s in namespace %s: %v", resource.Resource, builder.Definition.Name, err)
+
+			return nil, fmt.Errorf("failed to list %s in namespace %s: %w", resource.Resource, builder.Definition.Name, err)
+		}
+
+		for index := range objectList.Items {
+			object := &objectList.Items[index]
+
+			if metav1.GetControllerOfNoCopy(object) != nil || object.GetDeletionTimestamp() != nil {
+				continue
+			}
+
+			// The kind is needed to restore the object and list items may omit it.
+			if object.GetKind() == "" {
+				object.SetGroupVersionKind(resource.GroupVersion().WithKind(kind))
+			}
+
+			objects[snapshotKey{resource: resource, name: object.GetName()}] = object
+		}
+	}
+
+	return objects, nil
+}
+
+// deleteSnapshotObject deletes the object created since the snapshot described by drift.
+func (builder *Builder) deleteSnapshotObject(ctx context.Context, drift Drift) error {
+	glog.V(100).Infof("Deleting %s %s created since the snapshot", drift.Resource.Resource, drift.Name)
+
+	propagation := metav1.DeletePropagationBackground
+
+	err := builder.apiClient.Resource(drift.Resource).Namespace(builder.Definition.Name).Delete(
+		ctx, drift.Name, metav1.DeleteOptions{PropagationPolicy: &propagation})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete %s %s: %w", drift.Resource.Resource, drift.Name, err)
+	}
+
+	return nil
+}
+
+// waitForSnapshotObjectsDeleted waits for the objects created since the snapshot to be gone, finalizers included.
+func (builder *Builder) waitForSnapshotObjectsDeleted(ctx context.Context, drifts []Drift) error {
+	return wait.PollUntilContextCancel(ctx, time.Second, true, func(ctx context.Context) (bool, error) {
+		for _, drift := range drifts {
+			if drift.Type != DriftCreated {
+				continue
+			}
+
+			_, err := builder.apiClient.Resource(drift.Resource).Namespace(builder.Definition.Name).Get(
+				ctx, drift.Name, metav1.GetOptions{})
+			if err == nil {
+				return false, nil
+			}
+
+			if !k8serrors.IsNotFound(err) {
+				glog.V(100).Infof("Failed to get %s %s: %v", drift.Resource.Resource, drift.Name, err)
+
+				return false, nil
+			}
+		}
+
+		return true, nil
+	})
+}
+
+// restoreSnapshotObject updates current back to its baseline state.
+func (builder *Builder) restoreSnapshotObject(
+	ctx context.Context, resource schema.GroupVersionResource, baseline, current *unstructured.Unstructured) error {
+	glog.V(100).Infof("Restoring %s %s to its snapshot", resource.Resource, baseline.GetName())
+
+	restored := normalizeSnapshotObject(baseline)
+	restored.SetResourceVersion(current.GetResourceVersion())
+	restored.SetUID(current.GetUID())
+	restored.SetCreationTimestamp(current.GetCreationTimestamp())
+
+	if status, found := current.Object["status"]; found {
+		restored.Object["status"] = status
+	}
+
+	_, err := builder.apiClient.Resource(resource).Namespace(builder.Definition.Name).Update(
+		ctx, restored, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to restore %s %s: %w", resource.Resource, baseline.GetName(), err)
+	}
+
+	return nil
+}
+
+// recreateSnapshotObject creates baseline again after it was deleted.
+func (builder *Builder) recreateSnapshotObject(
+	ctx context.Context, resource schema.GroupVersionResource, baseline *unstructured.Unstructured) error {
+	glog.V(100).Infof("Recreating %s %s deleted since the snapshot", resource.Resource, baseline.GetName())
+
+	_, err := builder.apiClient.Resource(resource).Namespace(builder.Definition.Name).Create(
+		ctx, normalizeSnapshotObject(baseline), metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to recreate %s %s: %w", resource.Resource, baseline.GetName(), err)
+	}
+
+	return nil
+}
+
+// validateSnapshot checks that the builder is valid and that snapshot was captured from its namespace.
+func (builder *Builder) validateSnapshot(snapshot *Snapshot) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	if snapshot == nil {
+		glog.V(100).Infof("The snapshot of namespace %s is nil", builder.Definition.Name)
+
+		return fmt.Errorf("namespace 'snapshot' cannot be nil")
+	}
+
+	if snapshot.Namespace != builder.Definition.Name {
+		glog.V(100).Infof("The snapshot of namespace %s was captured from namespace %s",
+			builder.Definition.Name, snapshot.Namespace)
+
+		return fmt.Errorf("cannot use snapshot of namespace %s for namespace %s",
+			snapshot.Namespace, builder.Definition.Name)
+	}
+
+	return nil
+}
+
+// computeDrift returns the drift from the baseline objects to the current objects, sorted by type, resource and name.
+func computeDrift(baseline, current map[snapshotKey]*unstructured.Unstructured) []Drift {
+	var drifts []Drift
+
+	for key, object := range current {
+		baselineObject, found := baseline[key]
+
+		if !found {
+			drifts = append(drifts, Drift{Type: DriftCreated, Resource: key.resource, Name: key.name})
+
+			continue
+		}
+
+		if fields := changedFields(baselineObject, object); len(fields) > 0 {
+			drifts = append(drifts, Drift{Type: DriftModified, Resource: key.resource, Name: key.name, Fields: fields})
+		}
+	}
+
+	for key := range baseline {
+		if _, found := current[key]; !found {
+			drifts = append(drifts, Drift{Type: DriftDeleted, Resource: key.resource, Name: key.name})
+		}
+	}
+
+	sort.Slice(drifts, func(i, j int) bool {
+		if drifts[i].Type != drifts[j].Type {
+			return drifts[i].Type < drifts[j].Type
+		}
+
+		if drifts[i].Resource != drifts[j].Resource {
+			return drifts[i].Resource.String() < drifts[j].Resource.String()
+		}
+
+		return drifts[i].Name < drifts[j].Name
+	})
+
+	return drifts
+}
+
+// changedFields returns the fields that differ between the normalized baseline and current objects.
+func changedFields(baseline, current *unstructured.Unstructured) []string {
+	baseline = normalizeSnapshotObject(baseline)
+	current = normalizeSnapshotObject(current)
+
+	var fields []string
+
+	for _, field := range []string{"labels", "annotations"} {
+		baselineValue, _, _ := unstructured.NestedFieldNoCopy(baseline.Object, "metadata", field)
+		currentValue, _, _ := unstructured.NestedFieldNoCopy(current.Object, "metadata", field)
+
+		if !equality.Semantic.DeepEqual(baselineValue, currentValue) {
+			fields = append(fields, "metadata."+field)
+		}
+	}
+
+	for field := range mergeKeys(baseline.Object, current.Object) {
+		if field != "metadata" && !equality.Semantic.DeepEqual(baseline.Object[field], current.Object[field]) {
+			fields = append(fields, field)
+		}
+	}
+
+	sort.Strings(fields)
+
+	return fields
+}
+
+// normalizeSnapshotObject returns a copy of object without its status and the metadata set by the API server, so that
+// it can be compared to or created from another version of the object.
+func normalizeSnapshotObject(object *unstructured.Unstructured) *unstructured.Unstructured {
+	normalized := object.DeepCopy()
+
+	delete(normalized.Object, "status")
+
+	for _, field := range []string{
+		"resourceVersion", "uid", "creationTimestamp", "generation", "managedFields", "selfLink"} {
+		unstructured.RemoveNestedField(normalized.Object, "metadata", field)
+	}
+
+	return normalized
+}
+
+// mergeKeys returns the union of the keys of both maps.
+func mergeKeys(first, second map[string]any) map[string]bool {
+	keys := map[string]bool{}
+
+	for key := range first {
+		keys[key] = true
+	}
+
+	for key := range second {
+		keys[key] = true
+	}
+
+	return keys
+}
+
+// hasAllVerbs returns true if verbs contains all of the required verbs.
+func hasAllVerbs(verbs metav1.Verbs, required []string) bool {
+	for _, verb := range required {
+		if !slices.Contains(verbs, verb) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// containsGroupResource returns true if groupResources contains groupResource.
+func containsGroupResource(groupResources []schema.GroupResource, groupResource schema.GroupResource) bool {
+	for _, candidate := range groupResources {
+		if candidate == groupResource {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,261 @@
+package namespace
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	"k8s.io/utils/ptr"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const defaultSnapshotNamespace = "test-namespace"
+
+var (
+	configMapsGVR  = corev1.SchemeGroupVersion.WithResource("configmaps")
+	secretsGVR     = corev1.SchemeGroupVersion.WithResource("secrets")
+	podsGVR        = corev1.SchemeGroupVersion.WithResource("pods")
+	deploymentsGVR = appsv1.SchemeGroupVersion.WithResource("deployments")
+)
+
+func TestNamespaceSnapshot(t *testing.T) {
+	testCases := []struct {
+		namespaceExists   bool
+		excludedResources []schema.GroupResource
+		expectedResources []schema.GroupVersionResource
+		expectedObjects   int
+		expectedError     error
+	}{
+		{
+			namespaceExists:   true,
+			expectedResources: []schema.GroupVersionResource{configMapsGVR, podsGVR, secretsGVR, deploymentsGVR},
+			expectedObjects:   3,
+		},
+		{
+			namespaceExists:   true,
+			excludedResources: []schema.GroupResource{{Group: "apps", Resource: "deployments"}},
+			expectedResources: []schema.GroupVersionResource{configMapsGVR, podsGVR, secretsGVR},
+			expectedObjects:   2,
+		},
+		{
+			namespaceExists: false,
+			expectedError:   fmt.Errorf("failed to snapshot non-existent namespace %s", defaultSnapshotNamespace),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildSnapshotTestBuilder(testCase.namespaceExists)
+
+		snapshot, err := testBuilder.Snapshot(testCase.excludedResources...)
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError != nil {
+			assert.Nil(t, snapshot)
+
+			continue
+		}
+
+		assert.Equal(t, defaultSnapshotNamespace, snapshot.Namespace)
+		assert.Equal(t, testCase.expectedResources, snapshot.Resources)
+		assert.Len(t, snapshot.objects, testCase.expectedObjects)
+	}
+}
+
+//nolint:funlen
+func TestNamespaceDriftAndReset(t *testing.T) {
+	testBuilder := buildSnapshotTestBuilder(true)
+	apiClient := testBuilder.apiClient
+
+	snapshot, err := testBuilder.Snapshot()
+	assert.Nil(t, err)
+
+	drifts, err := testBuilder.Drift(snapshot)
+	assert.Nil(t, err)
+	assert.Empty(t, drifts)
+
+	// Modify the baseline ConfigMap, delete the baseline Secret and create new objects, one of them controlled by
+	// another object and therefore ignored. Status changes are ignored too.
+	configMap := &corev1.ConfigMap{}
+	err = apiClient.Get(t.Context(), runtimeclient.ObjectKey{Name: "baseline", Namespace: defaultSnapshotNamespace},
+		configMap)
+	assert.Nil(t, err)
+
+	configMap.Data["key"] = "changed"
+	configMap.Labels = map[string]string{"test": "true"}
+	assert.Nil(t, apiClient.Update(t.Context(), configMap))
+
+	deployment := &appsv1.Deployment{}
+	err = apiClient.Get(t.Context(), runtimeclient.ObjectKey{Name: "baseline", Namespace: defaultSnapshotNamespace},
+		deployment)
+	assert.Nil(t, err)
+
+	deployment.Status.ReadyReplicas = 3
+	assert.Nil(t, apiClient.Status().Update(t.Context(), deployment))
+
+	assert.Nil(t, apiClient.Delete(t.Context(), buildSnapshotSecret()))
+	assert.Nil(t, apiClient.Create(t.Context(), &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "created", Namespace: defaultSnapshotNamespace}}))
+	assert.Nil(t, apiClient.Create(t.Context(), buildSnapshotControlledPod("created-controlled")))
+
+	expectedDrifts := []string{
+		"created configmaps created",
+		"deleted secrets baseline",
+		"modified configmaps baseline: data, metadata.labels",
+	}
+
+	drifts, err = testBuilder.Drift(snapshot)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedDrifts, driftStrings(drifts))
+
+	drifts, err = testBuilder.Reset(snapshot, 5*time.Second)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedDrifts, driftStrings(drifts))
+
+	drifts, err = testBuilder.Drift(snapshot)
+	assert.Nil(t, err)
+	assert.Empty(t, drifts)
+
+	err = apiClient.Get(t.Context(), runtimeclient.ObjectKey{Name: "baseline", Namespace: defaultSnapshotNamespace},
+		configMap)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"key": "value"}, configMap.Data)
+	assert.Empty(t, configMap.Labels)
+
+	secret := &corev1.Secret{}
+	err = apiClient.Get(t.Context(), runtimeclient.ObjectKeyFromObject(buildSnapshotSecret()), secret)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("secret"), secret.Data["password"])
+
+	err = apiClient.Get(t.Context(), runtimeclient.ObjectKey{Name: "baseline", Namespace: defaultSnapshotNamespace},
+		deployment)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(3), deployment.Status.ReadyReplicas)
+}
+
+func TestNamespaceDriftValidation(t *testing.T) {
+	testCases := []struct {
+		snapshot      *Snapshot
+		expectedError error
+	}{
+		{
+			snapshot:      nil,
+			expectedError: fmt.Errorf("namespace 'snapshot' cannot be nil"),
+		},
+		{
+			snapshot: &Snapshot{Namespace: "other-namespace"},
+			expectedError: fmt.Errorf("cannot use snapshot of namespace other-namespace for namespace %s",
+				defaultSnapshotNamespace),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildSnapshotTestBuilder(true)
+
+		drifts, err := testBuilder.Drift(testCase.snapshot)
+		assert.Equal(t, testCase.expectedError, err)
+		assert.Nil(t, drifts)
+
+		drifts, err = testBuilder.Reset(testCase.snapshot, time.Second)
+		assert.Equal(t, testCase.expectedError, err)
+		assert.Nil(t, drifts)
+	}
+}
+
+func TestChangedFields(t *testing.T) {
+	baseline := &unstructured.Unstructured{Object: map[string]any{
+		"metadata": map[string]any{"name": "test", "resourceVersion": "1", "annotations": map[string]any{"a": "b"}},
+		"spec":     map[string]any{"replicas": int64(1)},
+		"status":   map[string]any{"ready": false},
+	}}
+
+	current := baseline.DeepCopy()
+	current.SetResourceVersion("2")
+	current.Object["status"] = map[string]any{"ready": true}
+	assert.Empty(t, changedFields(baseline, current))
+
+	current.SetAnnotations(nil)
+	current.Object["spec"] = map[string]any{"replicas": int64(2)}
+	current.Object["data"] = map[string]any{"key": "value"}
+	assert.Equal(t, []string{"data", "metadata.annotations", "spec"}, changedFields(baseline, current))
+}
+
+func driftStrings(drifts []Drift) []string {
+	var descriptions []string
+
+	for _, drift := range drifts {
+		descriptions = append(descriptions, drift.String())
+	}
+
+	return descriptions
+}
+
+// buildSnapshotTestBuilder returns a namespace builder whose cluster discovers the core and apps resources and has a
+// ConfigMap, a Secret, a Deployment, a Pod controlled by another object and an event in the namespace.
+func buildSnapshotTestBuilder(namespaceExists bool) *Builder {
+	objects := []runtime.Object{
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "baseline", Namespace: defaultSnapshotNamespace},
+			Data:       map[string]string{"key": "value"},
+		},
+		buildSnapshotSecret(),
+		&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "baseline", Namespace: defaultSnapshotNamespace}},
+		buildSnapshotControlledPod("baseline-controlled"),
+		&corev1.Event{ObjectMeta: metav1.ObjectMeta{Name: "baseline", Namespace: defaultSnapshotNamespace}},
+	}
+
+	if namespaceExists {
+		objects = append(objects, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: defaultSnapshotNamespace}})
+	}
+
+	testSettings := clients.GetTestClients(clients.TestClientParams{K8sMockObjects: objects})
+
+	allVerbs := metav1.Verbs{"create", "delete", "get", "list", "update", "watch"}
+
+	//nolint:forcetypeassert
+	testSettings.K8sClient.Discovery().(*fakediscovery.FakeDiscovery).Resources = []*metav1.APIResourceList{
+		{
+			GroupVersion: "v1",
+			APIResources: []metav1.APIResource{
+				{Name: "configmaps", Kind: "ConfigMap", Namespaced: true, Verbs: allVerbs},
+				{Name: "secrets", Kind: "Secret", Namespaced: true, Verbs: allVerbs},
+				{Name: "pods", Kind: "Pod", Namespaced: true, Verbs: allVerbs},
+				{Name: "pods/log", Namespaced: true, Verbs: metav1.Verbs{"get"}},
+				{Name: "events", Kind: "Event", Namespaced: true, Verbs: allVerbs},
+				{Name: "namespaces", Kind: "Namespace", Namespaced: false, Verbs: allVerbs},
+				{Name: "bindings", Kind: "Binding", Namespaced: true, Verbs: metav1.Verbs{"create"}},
+			},
+		},
+		{
+			GroupVersion: "apps/v1",
+			APIResources: []metav1.APIResource{{
+				Name: "deployments", Kind: "Deployment", Namespaced: true, Verbs: allVerbs}},
+		},
+	}
+
+	return NewBuilder(testSettings, defaultSnapshotNamespace)
+}
+
+func buildSnapshotSecret() *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "baseline", Namespace: defaultSnapshotNamespace},
+		Data:       map[string][]byte{"password": []byte("secret")},
+	}
+}
+
+func buildSnapshotControlledPod(name string) *corev1.Pod {
+	return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name:      name,
+		Namespace: defaultSnapshotNamespace,
+		OwnerReferences: []metav1.OwnerReference{{
+			APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "test", UID: "test", Controller: ptr.To(true)}},
+	}}
+}
@@ -0,0 +1,192 @@
+package nodes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/pod"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	debugContainerName = "container-00"
+	debugHostVolume    = "host"
+	debugHostMountPath = "/host"
+	// debugPodNodeNameMaxLength keeps debug pod names, including their suffix, within the 63 characters of a hostname.
+	debugPodNodeNameMaxLength = 50
+)
+
+// DebugSession runs commands in the host namespaces of a node through a privileged debug pod scheduled on it. The
+// same session can run any number of commands and must be closed once no longer needed to delete the debug pod.
+type DebugSession struct {
+	nodeName   string
+	podBuilder *pod.Builder
+	closed     bool
+	mutex      sync.Mutex
+}
+
+// NewDebugSession creates a privileged debug pod running image on the node in namespace nsname and waits until it is
+// running, up to timeout. The pod uses the host network and PID namespace, tolerates all taints and mounts the root
+// filesystem of the host at /host. The returned session must be closed to delete the pod.
+func (builder *Builder) NewDebugSession(
+	ctx context.Context, nsname, image string, timeout time.Duration) (*DebugSession, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	if nsname == "" {
+		glog.V(100).Infof("The namespace of the debug pod for node %s is empty", builder.Definition.Name)
+
+		return nil, fmt.Errorf("node debug session 'nsname' cannot be empty")
+	}
+
+	if image == "" {
+		glog.V(100).Infof("The image of the debug pod for node %s is empty", builder.Definition.Name)
+
+		return nil, fmt.Errorf("node debug session 'image' cannot be empty")
+	}
+
+	podBuilder, err := builder.newDebugPodBuilder(nsname, image)
+	if err != nil {
+		return nil, err
+	}
+
+	glog.V(100).Infof("Creating debug pod %s in namespace %s on node %s",
+		podBuilder.Definition.Name, nsname, builder.Definition.Name)
+
+	_, err = podBuilder.CreateAndWaitUntilRunningWithContext(ctx, timeout)
+	if err != nil {
+		// The pod is deleted with a fresh context since ctx may be the reason the pod is not running.
+		_, deleteErr := podBuilder.DeleteImmediateWithContext(context.Background())
+
+		return nil, errors.Join(
+			fmt.Errorf("failed to start debug pod on node %s: %w", builder.Definition.Name, err), deleteErr)
+	}
+
+	return &DebugSession{nodeName: builder.Definition.Name, podBuilder: podBuilder}, nil
+}
+
+// ExecOnHost runs command with /bin/sh in the host namespaces of the node and returns its standard output, standard
+// error and exit code. It creates a debug session in namespace nsname using image, runs command and closes the
+// session. Use NewDebugSession instead to run several commands without recreating the debug pod for each of them.
+func (builder *Builder) ExecOnHost(
+	ctx context.Context, nsname, image, command string, timeout time.Duration) (*pod.ExecResult, error) {
+	session, err := builder.NewDebugSession(ctx, nsname, image, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := session.Exec(ctx, command)
+
+	return result, errors.Join(err, session.Close())
+}
+
+// NodeName returns the name of the node the session runs commands on.
+func (session *DebugSession) NodeName() string {
+	return session.nodeName
+}
+
+// PodName returns the name of the debug pod of the session.
+func (session *DebugSession) PodName() string {
+	return session.podBuilder.Definition.Name
+}
+
+// Exec runs command with /bin/sh in the host namespaces of the node and returns its standard output, standard error
+// and exit code. A command exiting with a non-zero code is not an error: the code is reported in the result.
+func (session *DebugSession) Exec(ctx context.Context, command string) (*pod.ExecResult, error) {
+	return session.ExecWithOptions(ctx, command, pod.ExecOptions{})
+}
+
+// ExecWithOptions runs command with /bin/sh in the host namespaces of the node according to options. The container
+// option is ignored since the debug pod has a single container.
+func (session *DebugSession) ExecWithOptions(
+	ctx context.Context, command string, options pod.ExecOptions) (*pod.ExecResult, error) {
+	if command == "" {
+		return nil, fmt.Errorf("node debug session command cannot be empty")
+	}
+
+	session.mutex.Lock()
+	closed := session.closed
+	session.mutex.Unlock()
+
+	if closed {
+		return nil, fmt.Errorf("debug session on node %s is closed", session.nodeName)
+	}
+
+	glog.V(100).Infof("Executing command %q on host of node %s", command, session.nodeName)
+
+	options.Container = debugContainerName
+
+	return session.podBuilder.ExecCommandWithOptions(
+		ctx, []string{"chroot", debugHostMountPath, "/bin/sh", "-c", command}, options)
+}
+
+// Close deletes the debug pod of the session. It is safe to call Close more than once.
+func (session *DebugSession) Close() error {
+	session.mutex.Lock()
+	defer session.mutex.Unlock()
+
+	if session.closed {
+		return nil
+	}
+
+	glog.V(100).Infof("Closing debug session on node %s", session.nodeName)
+
+	_, err := session.podBuilder.DeleteImmediateWithContext(context.Background())
+	if err != nil {
+		return fmt.Errorf("failed to delete debug pod of node %s: %w", session.nodeName, err)
+	}
+
+	session.closed = true
+
+	return nil
+}
+
+// newDebugPodBuilder returns the definition of a privileged debug pod for the node.
+func (builder *Builder) newDebugPodBuilder(nsname, image string) (*pod.Builder, error) {
+	container, err := pod.NewContainerBuilder(debugContainerName, image, []string{"/bin/sh", "-c", "sleep infinity"}).
+		WithSecurityContext(&corev1.SecurityContext{
+			Privileged: ptr.To(true),
+			RunAsUser:  ptr.To(int64(0)),
+		}).
+		WithVolumeMount(corev1.VolumeMount{Name: debugHostVolume, MountPath: debugHostMountPath}).
+		GetContainerCfg()
+	if err != nil {
+		return nil, err
+	}
+
+	podBuilder := pod.NewBuilder(builder.apiClient, getDebugPodName(builder.Definition.Name), nsname, image).
+		RedefineDefaultContainer(*container).
+		DefineOnNode(builder.Definition.Name).
+		WithHostNetwork().
+		WithHostPid(true).
+		WithRestartPolicy(corev1.RestartPolicyNever).
+		WithTerminationGracePeriodSeconds(0).
+		WithToleration(corev1.Toleration{Operator: corev1.TolerationOpExists}).
+		WithVolume(corev1.Volume{
+			Name: debugHostVolume,
+			VolumeSource: corev1.VolumeSource{
+				HostPath: &corev1.HostPathVolumeSource{Path: "/", Type: ptr.To(corev1.HostPathDirectory)},
+			},
+		})
+
+	return podBuilder, nil
+}
+
+// getDebugPodName returns a unique name for a debug pod of the node.
+func getDebugPodName(nodeName string) string {
+	if len(nodeName) > debugPodNodeNameMaxLength {
+		nodeName = nodeName[:debugPodNodeNameMaxLength]
+	}
+
+	nodeName = strings.TrimRight(strings.ReplaceAll(nodeName, ".", "-"), "-")
+
+	return fmt.Sprintf("%s-debug-%s", nodeName, rand.String(5))
+}
@@ -0,0 +1,152 @@
+package nodes
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	defaultDebugNamespace = "test-debug-namespace"
+	defaultDebugImage     = "test-image"
+)
+
+func TestNodeNewDebugSession(t *testing.T) {
+	testCases := []struct {
+		testBuilder   *Builder
+		nsname        string
+		image         string
+		expectedError string
+	}{
+		{
+			testBuilder: buildValidNodeTestBuilder(buildTestClientWithDebugPodSimulator()),
+			nsname:      defaultDebugNamespace,
+			image:       defaultDebugImage,
+		},
+		{
+			testBuilder:   buildValidNodeTestBuilder(buildTestClientWithDebugPodSimulator()),
+			nsname:        "",
+			image:         defaultDebugImage,
+			expectedError: "node debug session 'nsname' cannot be empty",
+		},
+		{
+			testBuilder:   buildValidNodeTestBuilder(buildTestClientWithDebugPodSimulator()),
+			nsname:        defaultDebugNamespace,
+			image:         "",
+			expectedError: "node debug session 'image' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		session, err := testCase.testBuilder.NewDebugSession(
+			t.Context(), testCase.nsname, testCase.image, time.Second)
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+			assert.Nil(t, session)
+
+			continue
+		}
+
+		assert.Nil(t, err)
+		assert.Equal(t, defaultNodeName, session.NodeName())
+		assert.True(t, strings.HasPrefix(session.PodName(), defaultNodeName+"-debug-"))
+
+		debugPod, err := testCase.testBuilder.apiClient.Pods(defaultDebugNamespace).Get(
+			t.Context(), session.PodName(), metav1.GetOptions{})
+		assert.Nil(t, err)
+		assert.Equal(t, defaultNodeName, debugPod.Spec.NodeName)
+		assert.True(t, debugPod.Spec.HostNetwork)
+		assert.True(t, debugPod.Spec.HostPID)
+		assert.Equal(t, []corev1.Toleration{{Operator: corev1.TolerationOpExists}}, debugPod.Spec.Tolerations)
+		assert.Equal(t, "/", debugPod.Spec.Volumes[0].HostPath.Path)
+		assert.Equal(t, debugHostMountPath, debugPod.Spec.Containers[0].VolumeMounts[0].MountPath)
+		assert.True(t, *debugPod.Spec.Containers[0].SecurityContext.Privileged)
+
+		assert.Nil(t, session.Close())
+		assert.Nil(t, session.Close())
+
+		_, err = testCase.testBuilder.apiClient.Pods(defaultDebugNamespace).Get(
+			t.Context(), session.PodName(), metav1.GetOptions{})
+		assert.NotNil(t, err)
+
+		_, err = session.Exec(t.Context(), "hostname")
+		assert.EqualError(t, err, fmt.Sprintf("debug session on node %s is closed", defaultNodeName))
+	}
+}
+
+func TestNodeNewDebugSessionNotRunning(t *testing.T) {
+	testBuilder := buildValidNodeTestBuilder(buildTestClientWithDummyNode())
+
+	session, err := testBuilder.NewDebugSession(t.Context(), defaultDebugNamespace, defaultDebugImage, time.Second)
+	assert.ErrorContains(t, err, fmt.Sprintf("failed to start debug pod on node %s", defaultNodeName))
+	assert.Nil(t, session)
+
+	debugPods, err := testBuilder.apiClient.Pods(defaultDebugNamespace).List(
+		t.Context(), metav1.ListOptions{})
+	assert.Nil(t, err)
+	assert.Empty(t, debugPods.Items)
+}
+
+func TestNodeExecOnHost(t *testing.T) {
+	testBuilder := buildValidNodeTestBuilder(buildTestClientWithDebugPodSimulator())
+
+	result, err := testBuilder.ExecOnHost(t.Context(), defaultDebugNamespace, defaultDebugImage, "", time.Second)
+	assert.EqualError(t, err, "node debug session command cannot be empty")
+	assert.Nil(t, result)
+
+	debugPods, err := testBuilder.apiClient.Pods(defaultDebugNamespace).List(
+		t.Context(), metav1.ListOptions{})
+	assert.Nil(t, err)
+	assert.Empty(t, debugPods.Items)
+}
+
+func TestNodeGetDebugPodName(t *testing.T) {
+	testCases := []struct {
+		nodeName       string
+		expectedPrefix string
+	}{
+		{
+			nodeName:       defaultNodeName,
+			expectedPrefix: "test-node-debug-",
+		},
+		{
+			nodeName:       "worker-0.example.com",
+			expectedPrefix: "worker-0-example-com-debug-",
+		},
+		{
+			nodeName:       strings.Repeat("a", 49) + ".example.com",
+			expectedPrefix: strings.Repeat("a", 49) + "-debug-",
+		},
+	}
+
+	for _, testCase := range testCases {
+		podName := getDebugPodName(testCase.nodeName)
+
+		assert.True(t, strings.HasPrefix(podName, testCase.expectedPrefix))
+		assert.LessOrEqual(t, len(podName), 63)
+	}
+}
+
+// buildTestClientWithDebugPodSimulator returns a client with a dummy node where the debug pods start running as soon
+// as they are created.
+func buildTestClientWithDebugPodSimulator() *clients.Settings {
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: []runtime.Object{buildDummyNode(defaultNodeName)},
+		Simulators: []clients.ControllerSimulator{{
+			Object:    &corev1.Pod{},
+			Namespace: defaultDebugNamespace,
+			Triggers:  []clients.SimulatorTrigger{clients.SimulatorTriggerCreate},
+			Reconcile: func(object runtime.Object, _ int) {
+				object.(*corev1.Pod).Status.Phase = corev1.PodRunning
+			},
+		}},
+	})
+}
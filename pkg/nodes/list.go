@@ -18,6 +18,12 @@ const (
 
 // List returns node inventory.
 func List(apiClient *clients.Settings, options ...metav1.ListOptions) ([]*Builder, error) {
+	return ListWithContext(context.Background(), apiClient, options...)
+}
+
+// ListWithContext returns node inventory using the provided context.
+func ListWithContext(
+	ctx context.Context, apiClient *clients.Settings, options ...metav1.ListOptions) ([]*Builder, error) {
 	if apiClient == nil {
 		glog.V(100).Infof("Nodes 'apiClient' parameter can not be empty")
 
@@ -40,7 +46,7 @@ func List(apiClient *clients.Settings, options ...metav1.ListOptions) ([]*Builde
 
 	glog.V(100).Infof(logMessage)
 
-	nodeList, err := apiClient.CoreV1Interface.Nodes().List(context.TODO(), passedOptions)
+	nodeList, err := apiClient.CoreV1Interface.Nodes().List(ctx, passedOptions)
 	if err != nil {
 		glog.V(100).Infof("Failed to list nodes due to %s", err.Error())
 
@@ -52,9 +58,10 @@ func List(apiClient *clients.Settings, options ...metav1.ListOptions) ([]*Builde
 	for _, runningNode := range nodeList.Items {
 		copiedNode := runningNode
 		nodeBuilder := &Builder{
-			apiClient:  apiClient.K8sClient,
+			apiClient:  apiClient,
 			Object:     &copiedNode,
 			Definition: &copiedNode,
+			base:       copiedNode.DeepCopy(),
 		}
 
 		nodeObjects = append(nodeObjects, nodeBuilder)
@@ -90,6 +97,14 @@ func ListExternalIPv4Networks(apiClient *clients.Settings, options ...metav1.Lis
 
 // WaitForAllNodesAreReady waits for all nodes to be Ready for a time duration up to the timeout.
 func WaitForAllNodesAreReady(apiClient *clients.Settings,
+	timeout time.Duration,
+	options ...metav1.ListOptions) (bool, error) {
+	return WaitForAllNodesAreReadyWithContext(context.Background(), apiClient, timeout, options...)
+}
+
+// WaitForAllNodesAreReadyWithContext waits for all nodes to be Ready for a time duration up to the timeout or until
+// ctx is done.
+func WaitForAllNodesAreReadyWithContext(ctx context.Context, apiClient *clients.Settings,
 	timeout time.Duration,
 	options ...metav1.ListOptions) (bool, error) {
 	glog.V(100).Infof("Waiting for all nodes to be in the Ready state for up to a duration of %v",
@@ -103,7 +118,7 @@ func WaitForAllNodesAreReady(apiClient *clients.Settings,
 	}
 
 	err = wait.PollUntilContextTimeout(
-		context.TODO(), backoff, timeout, true, func(ctx context.Context) (done bool, err error) {
+		ctx, backoff, timeout, true, func(ctx context.Context) (done bool, err error) {
 			for _, node := range nodesList {
 				ready, err := node.IsReady()
 				if err != nil {
@@ -138,6 +153,14 @@ func WaitForAllNodesAreReady(apiClient *clients.Settings,
 
 // WaitForAllNodesToReboot waits for all nodes to start and finish reboot up to the timeout.
 func WaitForAllNodesToReboot(apiClient *clients.Settings,
+	globalRebootTimeout time.Duration,
+	options ...metav1.ListOptions) (bool, error) {
+	return WaitForAllNodesToRebootWithContext(context.Background(), apiClient, globalRebootTimeout, options...)
+}
+
+// WaitForAllNodesToRebootWithContext waits for all nodes to start and finish reboot up to the timeout or until ctx
+// is done.
+func WaitForAllNodesToRebootWithContext(ctx context.Context, apiClient *clients.Settings,
 	globalRebootTimeout time.Duration,
 	options ...metav1.ListOptions) (bool, error) {
 	glog.V(100).Infof("Waiting for all nodes in the list to reboot and return to the Ready condition")
@@ -153,7 +176,7 @@ func WaitForAllNodesToReboot(apiClient *clients.Settings,
 	readyNodes := []string{}
 	rebootedNodes := []string{}
 	err = wait.PollUntilContextTimeout(
-		context.TODO(), backoff, globalRebootTimeout, true, func(ctx context.Context) (done bool, err error) {
+		ctx, backoff, globalRebootTimeout, true, func(ctx context.Context) (done bool, err error) {
 			for _, node := range nodesList {
 				if !slices.Contains(readyNodes, node.Object.Name) {
 					ready, err := node.IsReady()
@@ -8,10 +8,11 @@ import (
 	"time"
 
 	"k8s.io/apimachinery/pkg/util/wait"
-	"k8s.io/client-go/kubernetes"
 
 	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/common"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/waiter"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
@@ -26,9 +27,11 @@ const (
 
 // Builder provides struct for Node object containing connection to the cluster and the list of Node definitions.
 type Builder struct {
-	Definition  *corev1.Node
-	Object      *corev1.Node
-	apiClient   kubernetes.Interface
+	Definition *corev1.Node
+	Object     *corev1.Node
+	// base is the node the definition was last pulled or updated from. Unlike Object, Exists does not refresh it.
+	base        *corev1.Node
+	apiClient   *clients.Settings
 	errorMsg    string
 	drainHelper *drain.Helper
 }
@@ -55,7 +58,7 @@ func (builder *Builder) SetDrainHelper(
 
 	builder.drainHelper = &drain.Helper{
 		Ctx:    context.TODO(),
-		Client: builder.apiClient,
+		Client: builder.apiClient.K8sClient,
 		// Delete pods that do not declare a controller.
 		Force: force,
 		// GracePeriodSeconds is how long to wait for a pod to terminate.
@@ -86,8 +89,30 @@ func (builder *Builder) Drain() error {
 	return drain.RunNodeDrain(builder.drainHelper, builder.Definition.Name)
 }
 
+// DrainWithContext evicts or deletes all pods, aborting the drain once ctx is done. The drain configuration set by
+// SetDrainHelper is kept unchanged.
+func (builder *Builder) DrainWithContext(ctx context.Context) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	builder.ensureDrainHelperIsSet()
+	glog.V(100).Infof("Draining node %s", builder.Definition.Name)
+
+	drainHelper := *builder.drainHelper
+	drainHelper.Ctx = ctx
+
+	return drain.RunNodeDrain(&drainHelper, builder.Definition.Name)
+}
+
 // Cordon marks node as unschedulable.
 func (builder *Builder) Cordon() error {
+	return builder.CordonWithContext(context.Background())
+}
+
+// CordonWithContext marks node as unschedulable using the provided context. The drain configuration set by
+// SetDrainHelper is kept unchanged.
+func (builder *Builder) CordonWithContext(ctx context.Context) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
@@ -95,11 +120,20 @@ func (builder *Builder) Cordon() error {
 	builder.ensureDrainHelperIsSet()
 	glog.V(100).Infof("Cordoning node %s", builder.Definition.Name)
 
-	return drain.RunCordonOrUncordon(builder.drainHelper, builder.Definition, true)
+	drainHelper := *builder.drainHelper
+	drainHelper.Ctx = ctx
+
+	return drain.RunCordonOrUncordon(&drainHelper, builder.Definition, true)
 }
 
 // Uncordon marks node as schedulable.
 func (builder *Builder) Uncordon() error {
+	return builder.UncordonWithContext(context.Background())
+}
+
+// UncordonWithContext marks node as schedulable using the provided context. The drain configuration set by
+// SetDrainHelper is kept unchanged.
+func (builder *Builder) UncordonWithContext(ctx context.Context) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
@@ -107,7 +141,10 @@ func (builder *Builder) Uncordon() error {
 	builder.ensureDrainHelperIsSet()
 	glog.V(100).Infof("Uncordoning node %s", builder.Definition.Name)
 
-	return drain.RunCordonOrUncordon(builder.drainHelper, builder.Definition, false)
+	drainHelper := *builder.drainHelper
+	drainHelper.Ctx = ctx
+
+	return drain.RunCordonOrUncordon(&drainHelper, builder.Definition, false)
 }
 
 // AdditionalOptions additional options for node object.
@@ -124,7 +161,7 @@ func Pull(apiClient *clients.Settings, nodeName string) (*Builder, error) {
 	}
 
 	builder := Builder{
-		apiClient: apiClient.K8sClient,
+		apiClient: apiClient,
 		Definition: &corev1.Node{
 			ObjectMeta: metav1.ObjectMeta{
 				Name: nodeName,
@@ -142,20 +179,27 @@ func Pull(apiClient *clients.Settings, nodeName string) (*Builder, error) {
 		return nil, fmt.Errorf("node object %s does not exist", nodeName)
 	}
 
-	builder.Definition = builder.Object
+	builder.Definition = builder.Object.DeepCopy()
+	builder.base = builder.Object.DeepCopy()
 
 	return &builder, nil
 }
 
 // Update renovates the existing node object with the node definition in builder.
 func (builder *Builder) Update() (*Builder, error) {
+	return builder.UpdateWithContext(context.Background())
+}
+
+// UpdateWithContext renovates the existing node object with the node definition in builder using the provided
+// context.
+func (builder *Builder) UpdateWithContext(ctx context.Context) (*Builder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
 
 	glog.V(100).Infof("Updating configuration of node %s", builder.Definition.Name)
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		return nil, fmt.Errorf("node %s object does not exist", builder.Definition.Name)
 	}
 
@@ -163,14 +207,52 @@ func (builder *Builder) Update() (*Builder, error) {
 	builder.Definition.ResourceVersion = ""
 
 	var err error
-	builder.Object, err = builder.apiClient.CoreV1().Nodes().Update(
-		context.TODO(), builder.Definition, metav1.UpdateOptions{})
+	builder.Object, err = builder.apiClient.CoreV1Interface.Nodes().Update(
+		ctx, builder.Definition, metav1.UpdateOptions{})
+	if err == nil {
+		builder.base = builder.Object.DeepCopy()
+	}
 
 	return builder, err
 }
 
+// UpdateWithRetry updates the node with merge semantics. If the node was modified on the cluster since it was pulled
+// or last updated, the changes made through the builder are re-applied to the latest version and the update is retried
+// according to backoff, for example retry.DefaultRetry.
+func (builder *Builder) UpdateWithRetry(ctx context.Context, backoff wait.Backoff) (*Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	glog.V(100).Infof("Updating configuration of node %s with retry on conflict", builder.Definition.Name)
+
+	nodes := builder.apiClient.CoreV1Interface.Nodes()
+
+	node, err := common.RetryOnConflict(ctx, backoff, builder.base, builder.Definition,
+		func(ctx context.Context) (*corev1.Node, error) {
+			return nodes.Get(ctx, builder.Definition.Name, metav1.GetOptions{})
+		},
+		func(ctx context.Context, node *corev1.Node) (*corev1.Node, error) {
+			return nodes.Update(ctx, node, metav1.UpdateOptions{})
+		})
+	if err != nil {
+		return builder, err
+	}
+
+	builder.Object = node
+	builder.Definition = node.DeepCopy()
+	builder.base = node.DeepCopy()
+
+	return builder, nil
+}
+
 // Exists checks whether the given node exists.
 func (builder *Builder) Exists() bool {
+	return builder.ExistsWithContext(context.Background())
+}
+
+// ExistsWithContext checks whether the given node exists using the provided context.
+func (builder *Builder) ExistsWithContext(ctx context.Context) bool {
 	if valid, _ := builder.validate(); !valid {
 		return false
 	}
@@ -178,21 +260,26 @@ func (builder *Builder) Exists() bool {
 	glog.V(100).Infof("Checking if node %s exists", builder.Definition.Name)
 
 	var err error
-	builder.Object, err = builder.apiClient.CoreV1().Nodes().Get(
-		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+	builder.Object, err = builder.apiClient.CoreV1Interface.Nodes().Get(
+		ctx, builder.Definition.Name, metav1.GetOptions{})
 
 	return err == nil || !k8serrors.IsNotFound(err)
 }
 
 // Delete removes node from the cluster.
 func (builder *Builder) Delete() error {
+	return builder.DeleteWithContext(context.Background())
+}
+
+// DeleteWithContext removes node from the cluster using the provided context.
+func (builder *Builder) DeleteWithContext(ctx context.Context) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
 
 	glog.V(100).Infof("Deleting the node %s", builder.Definition.Name)
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		glog.V(100).Info("Cannot delete node %s if it does not exist", builder.Definition.Name)
 
 		builder.Object = nil
@@ -200,8 +287,8 @@ func (builder *Builder) Delete() error {
 		return nil
 	}
 
-	err := builder.apiClient.CoreV1().Nodes().Delete(
-		context.TODO(),
+	err := builder.apiClient.CoreV1Interface.Nodes().Delete(
+		ctx,
 		builder.Definition.Name,
 		metav1.DeleteOptions{})
 
@@ -341,60 +428,93 @@ func (builder *Builder) IsReady() (bool, error) {
 // WaitUntilConditionTrue waits for timeout duration or until node gets to a specific status.
 func (builder *Builder) WaitUntilConditionTrue(
 	conditionType corev1.NodeConditionType, timeout time.Duration) error {
-	if valid, err := builder.validate(); !valid {
-		return err
-	}
-
-	return wait.PollUntilContextTimeout(
-		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
-			if !builder.Exists() {
-				return false, fmt.Errorf("node %s object does not exist", builder.Definition.Name)
-			}
-
-			for _, condition := range builder.Object.Status.Conditions {
-				if condition.Type == conditionType {
-					return condition.Status == isTrue, nil
-				}
-			}
+	return builder.WaitUntilConditionTrueWithContext(context.Background(), conditionType, timeout)
+}
 
-			return false, fmt.Errorf("the %s condition could not be found for node %s",
-				conditionType, builder.Definition.Name)
-		})
+// WaitUntilConditionTrueWithContext waits for timeout duration, until node gets to a specific status or until ctx is
+// done. On timeout, the returned waiter.WaitTimeoutError includes the last status and the events of the node.
+func (builder *Builder) WaitUntilConditionTrueWithContext(
+	ctx context.Context, conditionType corev1.NodeConditionType, timeout time.Duration) error {
+	return builder.waitForCondition(ctx, conditionType, timeout, "be True", func(status corev1.ConditionStatus) bool {
+		return status == isTrue
+	})
 }
 
 // WaitUntilConditionUnknown waits for timeout duration or until the provided condition type does not have status
 // Unknown.
 func (builder *Builder) WaitUntilConditionUnknown(
 	conditionType corev1.NodeConditionType, timeout time.Duration) error {
+	return builder.WaitUntilConditionUnknownWithContext(context.Background(), conditionType, timeout)
+}
+
+// WaitUntilConditionUnknownWithContext waits for timeout duration, until ctx is done or until the provided condition
+// type does not have status Unknown. On timeout, the returned waiter.WaitTimeoutError includes the last status and the
+// events of the node.
+func (builder *Builder) WaitUntilConditionUnknownWithContext(
+	ctx context.Context, conditionType corev1.NodeConditionType, timeout time.Duration) error {
+	return builder.waitForCondition(ctx, conditionType, timeout, "not be Unknown",
+		func(status corev1.ConditionStatus) bool {
+			return status != corev1.ConditionUnknown
+		})
+}
+
+// WaitUntilReady waits for timeout duration or until node is Ready.
+func (builder *Builder) WaitUntilReady(timeout time.Duration) error {
+	return builder.WaitUntilReadyWithContext(context.Background(), timeout)
+}
+
+// WaitUntilReadyWithContext waits for timeout duration, until ctx is done or until node is Ready.
+func (builder *Builder) WaitUntilReadyWithContext(ctx context.Context, timeout time.Duration) error {
+	return builder.WaitUntilConditionTrueWithContext(ctx, corev1.NodeReady, timeout)
+}
+
+// WaitUntilNotReady waits for timeout duration or until node is NotReady.
+func (builder *Builder) WaitUntilNotReady(timeout time.Duration) error {
+	return builder.WaitUntilNotReadyWithContext(context.Background(), timeout)
+}
+
+// WaitUntilNotReadyWithContext waits for timeout duration, until ctx is done or until node is NotReady.
+func (builder *Builder) WaitUntilNotReadyWithContext(ctx context.Context, timeout time.Duration) error {
+	return builder.WaitUntilConditionUnknownWithContext(ctx, corev1.NodeReady, timeout)
+}
+
+// waitForCondition waits until the status of the conditionType condition of the node satisfies accept, described by
+// expectation, the timeout expires or ctx is done, and updates the builder object. The wait fails immediately if the
+// node does not exist or does not report the condition.
+func (builder *Builder) waitForCondition(ctx context.Context, conditionType corev1.NodeConditionType,
+	timeout time.Duration, expectation string, accept func(status corev1.ConditionStatus) bool) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
 
-	return wait.PollUntilContextTimeout(
-		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
-			if !builder.Exists() {
-				return false, fmt.Errorf("node %s object does not exist", builder.Definition.Name)
-			}
+	if !builder.ExistsWithContext(ctx) {
+		return fmt.Errorf("node %s object does not exist", builder.Definition.Name)
+	}
+
+	source := waiter.WithEvents(
+		waiter.NewTypedSource(builder.apiClient.CoreV1Interface.Nodes(), builder.Definition.Name),
+		builder.apiClient, "Node", "")
+
+	description := fmt.Sprintf("node %s condition %s to %s", builder.Definition.Name, conditionType, expectation)
 
-			for _, condition := range builder.Object.Status.Conditions {
+	node, err := waiter.For(ctx, source, waiter.Options{Description: description, Timeout: timeout},
+		func(node *corev1.Node) (bool, error) {
+			for _, condition := range node.Status.Conditions {
 				if condition.Type == conditionType {
-					return condition.Status != "Unknown", nil
+					return accept(condition.Status), nil
 				}
 			}
 
 			return false, fmt.Errorf("the %s condition could not be found for node %s",
 				conditionType, builder.Definition.Name)
 		})
-}
+	if err != nil {
+		return err
+	}
 
-// WaitUntilReady waits for timeout duration or until node is Ready.
-func (builder *Builder) WaitUntilReady(timeout time.Duration) error {
-	return builder.WaitUntilConditionTrue(corev1.NodeReady, timeout)
-}
+	builder.Object = node
 
-// WaitUntilNotReady waits for timeout duration or until node is NotReady.
-func (builder *Builder) WaitUntilNotReady(timeout time.Duration) error {
-	return builder.WaitUntilConditionUnknown(corev1.NodeReady, timeout)
+	return nil
 }
 
 // validate will check that the builder and builder definition are properly initialized before
@@ -2,15 +2,18 @@ package nodes
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 	"time"
 
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/waiter"
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/retry"
 )
 
 const (
@@ -119,6 +122,55 @@ func TestNodeUpdate(t *testing.T) {
 	}
 }
 
+func TestNodeUpdateWithRetry(t *testing.T) {
+	testCases := []struct {
+		concurrentChange bool
+		refreshed        bool
+	}{
+		{
+			concurrentChange: true,
+			refreshed:        false,
+		},
+		{
+			concurrentChange: true,
+			refreshed:        true,
+		},
+		{
+			concurrentChange: false,
+			refreshed:        false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := buildTestClientWithDummyNode()
+
+		testBuilder, err := Pull(testSettings, defaultNodeName)
+		assert.Nil(t, err)
+
+		if testCase.concurrentChange {
+			concurrentBuilder, err := Pull(testSettings, defaultNodeName)
+			assert.Nil(t, err)
+
+			_, err = concurrentBuilder.WithNewLabel(defaultNodeLabel, "").Update()
+			assert.Nil(t, err)
+		}
+
+		if testCase.refreshed {
+			assert.True(t, testBuilder.Exists())
+		}
+
+		testBuilder.Definition.Spec.Unschedulable = true
+
+		testBuilder, err = testBuilder.UpdateWithRetry(t.Context(), retry.DefaultRetry)
+		assert.Nil(t, err)
+		assert.True(t, testBuilder.Object.Spec.Unschedulable)
+
+		if testCase.concurrentChange {
+			assert.Contains(t, testBuilder.Object.Labels, defaultNodeLabel)
+		}
+	}
+}
+
 func TestNodeExists(t *testing.T) {
 	testCases := []struct {
 		testBuilder *Builder
@@ -519,7 +571,26 @@ func testNodeWaitUntilConditionHelper(
 		testBuilder := buildValidNodeTestBuilder(testSettings)
 
 		err := testedFunc(testBuilder, corev1.NodeReady, time.Second)
-		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Nil(t, err)
+
+			continue
+		}
+
+		if !errors.Is(testCase.expectedError, context.DeadlineExceeded) {
+			assert.ErrorContains(t, err, testCase.expectedError.Error())
+
+			continue
+		}
+
+		var timeoutErr *waiter.WaitTimeoutError
+
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.True(t, errors.As(err, &timeoutErr))
+		assert.Equal(t, []waiter.ConditionTransition{{
+			Time: timeoutErr.Conditions[0].Time, Type: string(corev1.NodeReady), Status: string(corev1.ConditionUnknown),
+		}}, timeoutErr.Conditions)
 	}
 }
 
@@ -563,7 +634,7 @@ func newNodeBuilder(apiClient *clients.Settings, name string) *Builder {
 	}
 
 	builder := Builder{
-		apiClient:  apiClient.K8sClient,
+		apiClient:  apiClient,
 		Definition: buildDummyNode(name),
 	}
 
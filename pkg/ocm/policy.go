@@ -8,6 +8,7 @@ import (
 
 	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/common"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -23,6 +24,8 @@ type PolicyBuilder struct {
 	Definition *policiesv1.Policy
 	// created policy object.
 	Object *policiesv1.Policy
+	// copy of the object the definition was last pulled, created or updated from. It is not refreshed by Exists.
+	base *policiesv1.Policy
 	// api client to interact with the cluster.
 	apiClient runtimeclient.Client
 	// used to store latest error message upon defining or mutating application definition.
@@ -132,7 +135,8 @@ func PullPolicy(apiClient *clients.Settings, name, nsname string) (*PolicyBuilde
 		return nil, fmt.Errorf("policy object %s does not exist in namespace %s", name, nsname)
 	}
 
-	builder.Definition = builder.Object
+	builder.Definition = builder.Object.DeepCopy()
+	builder.base = builder.Object.DeepCopy()
 
 	return builder, nil
 }
@@ -197,6 +201,7 @@ func (builder *PolicyBuilder) Create() (*PolicyBuilder, error) {
 	}
 
 	builder.Object = builder.Definition
+	builder.base = builder.Definition.DeepCopy()
 
 	return builder, nil
 }
@@ -268,6 +273,31 @@ func (builder *PolicyBuilder) Update(force bool) (*PolicyBuilder, error) {
 	}
 
 	builder.Object = builder.Definition
+	builder.base = builder.Definition.DeepCopy()
+
+	return builder, nil
+}
+
+// UpdateWithRetry updates the policy with merge semantics. If the policy was modified on the cluster since it was
+// pulled, created or last updated, the changes made through the builder are re-applied to the latest version and the
+// update is retried according to backoff, for example retry.DefaultRetry. Use Update with force to delete and
+// recreate the policy instead.
+func (builder *PolicyBuilder) UpdateWithRetry(ctx context.Context, backoff wait.Backoff) (*PolicyBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	glog.V(100).Infof("Updating policy %s in namespace %s with retry on conflict",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	policy, err := common.UpdateObjectWithRetry(ctx, builder.apiClient, backoff, builder.base, builder.Definition)
+	if err != nil {
+		return builder, err
+	}
+
+	builder.Object = policy
+	builder.Definition = policy.DeepCopy()
+	builder.base = policy.DeepCopy()
 
 	return builder, nil
 }
@@ -62,6 +62,7 @@ func ListPoliciesInAllNamespaces(apiClient *clients.Settings,
 			apiClient:  apiClient.Client,
 			Object:     &copiedPolicy,
 			Definition: &copiedPolicy,
+			base:       copiedPolicy.DeepCopy(),
 		}
 
 		policyObjects = append(policyObjects, policyBuilder)
@@ -125,6 +125,12 @@ func PullCatalogSource(apiClient *clients.Settings, name, nsname string) (*Catal
 
 // Create makes an CatalogSourceBuilder in cluster and stores the created object in struct.
 func (builder *CatalogSourceBuilder) Create() (*CatalogSourceBuilder, error) {
+	return builder.CreateWithContext(context.Background())
+}
+
+// CreateWithContext makes an CatalogSourceBuilder in cluster using the provided context and stores the created object
+// in struct.
+func (builder *CatalogSourceBuilder) CreateWithContext(ctx context.Context) (*CatalogSourceBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
@@ -132,11 +138,11 @@ func (builder *CatalogSourceBuilder) Create() (*CatalogSourceBuilder, error) {
 	glog.V(100).Infof("Creating the catalogsource %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
-	if builder.Exists() {
+	if builder.ExistsWithContext(ctx) {
 		return builder, nil
 	}
 
-	err := builder.apiClient.Create(context.TODO(), builder.Definition)
+	err := builder.apiClient.Create(ctx, builder.Definition)
 	if err != nil {
 		return builder, err
 	}
@@ -148,6 +154,11 @@ func (builder *CatalogSourceBuilder) Create() (*CatalogSourceBuilder, error) {
 
 // Get returns CatalogSource object if found.
 func (builder *CatalogSourceBuilder) Get() (*oplmV1alpha1.CatalogSource, error) {
+	return builder.GetWithContext(context.Background())
+}
+
+// GetWithContext returns CatalogSource object if found using the provided context.
+func (builder *CatalogSourceBuilder) GetWithContext(ctx context.Context) (*oplmV1alpha1.CatalogSource, error) {
 	if valid, err := builder.validate(); !valid {
 		return nil, err
 	}
@@ -157,7 +168,7 @@ func (builder *CatalogSourceBuilder) Get() (*oplmV1alpha1.CatalogSource, error)
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	catalogSource := &oplmV1alpha1.CatalogSource{}
-	err := builder.apiClient.Get(context.TODO(),
+	err := builder.apiClient.Get(ctx,
 		runtimeClient.ObjectKey{Name: builder.Definition.Name, Namespace: builder.Definition.Namespace},
 		catalogSource)
 
@@ -174,6 +185,12 @@ func (builder *CatalogSourceBuilder) Get() (*oplmV1alpha1.CatalogSource, error)
 
 // Update renovates the existing CatalogSource object with the CatalogSource definition in builder.
 func (builder *CatalogSourceBuilder) Update(force bool) (*CatalogSourceBuilder, error) {
+	return builder.UpdateWithContext(context.Background(), force)
+}
+
+// UpdateWithContext renovates the existing CatalogSource object with the CatalogSource definition in builder using the
+// provided context.
+func (builder *CatalogSourceBuilder) UpdateWithContext(ctx context.Context, force bool) (*CatalogSourceBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
@@ -182,18 +199,18 @@ func (builder *CatalogSourceBuilder) Update(force bool) (*CatalogSourceBuilder,
 		builder.Definition.Name, builder.Definition.Namespace,
 	)
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		return nil, fmt.Errorf("failed to update CatalogSource, object does not exist on cluster")
 	}
 
-	err := builder.apiClient.Update(context.TODO(), builder.Definition)
+	err := builder.apiClient.Update(ctx, builder.Definition)
 
 	if err != nil {
 		if force {
 			glog.V(100).Infof(
 				msg.FailToUpdateNotification("CatalogSource", builder.Definition.Name, builder.Definition.Namespace))
 
-			err := builder.Delete()
+			err := builder.DeleteWithContext(ctx)
 
 			if err != nil {
 				glog.V(100).Infof(
@@ -202,7 +219,7 @@ func (builder *CatalogSourceBuilder) Update(force bool) (*CatalogSourceBuilder,
 				return nil, err
 			}
 
-			return builder.Create()
+			return builder.CreateWithContext(ctx)
 		}
 	}
 
@@ -211,6 +228,11 @@ func (builder *CatalogSourceBuilder) Update(force bool) (*CatalogSourceBuilder,
 
 // Exists checks whether the given catalogsource exists.
 func (builder *CatalogSourceBuilder) Exists() bool {
+	return builder.ExistsWithContext(context.Background())
+}
+
+// ExistsWithContext checks whether the given catalogsource exists using the provided context.
+func (builder *CatalogSourceBuilder) ExistsWithContext(ctx context.Context) bool {
 	if valid, _ := builder.validate(); !valid {
 		return false
 	}
@@ -220,13 +242,18 @@ func (builder *CatalogSourceBuilder) Exists() bool {
 		builder.Definition.Name)
 
 	var err error
-	builder.Object, err = builder.Get()
+	builder.Object, err = builder.GetWithContext(ctx)
 
 	return err == nil || !k8serrors.IsNotFound(err)
 }
 
 // Delete removes a catalogsource.
 func (builder *CatalogSourceBuilder) Delete() error {
+	return builder.DeleteWithContext(context.Background())
+}
+
+// DeleteWithContext removes a catalogsource using the provided context.
+func (builder *CatalogSourceBuilder) DeleteWithContext(ctx context.Context) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
@@ -234,7 +261,7 @@ func (builder *CatalogSourceBuilder) Delete() error {
 	glog.V(100).Infof("Deleting catalogsource %s in namespace %s", builder.Definition.Name,
 		builder.Definition.Namespace)
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		glog.V(100).Infof("catalogsource cannot be deleted because it does not exist")
 
 		builder.Object = nil
@@ -242,7 +269,7 @@ func (builder *CatalogSourceBuilder) Delete() error {
 		return nil
 	}
 
-	err := builder.apiClient.Delete(context.TODO(), builder.Definition)
+	err := builder.apiClient.Delete(ctx, builder.Definition)
 
 	if err != nil {
 		return err
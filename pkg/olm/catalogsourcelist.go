@@ -13,6 +13,15 @@ import (
 
 // ListCatalogSources returns catalogsource inventory in the given namespace.
 func ListCatalogSources(
+	apiClient *clients.Settings,
+	nsname string,
+	options ...client.ListOptions) ([]*CatalogSourceBuilder, error) {
+	return ListCatalogSourcesWithContext(context.Background(), apiClient, nsname, options...)
+}
+
+// ListCatalogSourcesWithContext returns catalogsource inventory in the given namespace using the provided context.
+func ListCatalogSourcesWithContext(
+	ctx context.Context,
 	apiClient *clients.Settings,
 	nsname string,
 	options ...client.ListOptions) ([]*CatalogSourceBuilder, error) {
@@ -55,7 +64,7 @@ func ListCatalogSources(
 	passedOptions.Namespace = nsname
 
 	catalogSourceList := new(oplmV1alpha1.CatalogSourceList)
-	err = apiClient.List(context.TODO(), catalogSourceList, &passedOptions)
+	err = apiClient.List(ctx, catalogSourceList, &passedOptions)
 
 	if err != nil {
 		glog.V(100).Infof("Failed to list catalogsources in the namespace %s due to %s", nsname, err.Error())
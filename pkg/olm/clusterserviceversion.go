@@ -78,6 +78,12 @@ func PullClusterServiceVersion(apiClient *clients.Settings, name, namespace stri
 
 // Get returns ClusterServiceVersion object if found.
 func (builder *ClusterServiceVersionBuilder) Get() (*oplmV1alpha1.ClusterServiceVersion, error) {
+	return builder.GetWithContext(context.Background())
+}
+
+// GetWithContext returns ClusterServiceVersion object if found, using the provided context.
+func (builder *ClusterServiceVersionBuilder) GetWithContext(
+	ctx context.Context) (*oplmV1alpha1.ClusterServiceVersion, error) {
 	if valid, err := builder.validate(); !valid {
 		return nil, err
 	}
@@ -87,7 +93,7 @@ func (builder *ClusterServiceVersionBuilder) Get() (*oplmV1alpha1.ClusterService
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	clusterServiceVersion := &oplmV1alpha1.ClusterServiceVersion{}
-	err := builder.apiClient.Get(context.TODO(),
+	err := builder.apiClient.Get(ctx,
 		runtimeClient.ObjectKey{Name: builder.Definition.Name, Namespace: builder.Definition.Namespace},
 		clusterServiceVersion)
 
@@ -104,6 +110,11 @@ func (builder *ClusterServiceVersionBuilder) Get() (*oplmV1alpha1.ClusterService
 
 // Exists checks whether the given ClusterService exists.
 func (builder *ClusterServiceVersionBuilder) Exists() bool {
+	return builder.ExistsWithContext(context.Background())
+}
+
+// ExistsWithContext checks whether the given ClusterService exists using the provided context.
+func (builder *ClusterServiceVersionBuilder) ExistsWithContext(ctx context.Context) bool {
 	if valid, _ := builder.validate(); !valid {
 		return false
 	}
@@ -113,13 +124,18 @@ func (builder *ClusterServiceVersionBuilder) Exists() bool {
 		builder.Definition.Name)
 
 	var err error
-	builder.Object, err = builder.Get()
+	builder.Object, err = builder.GetWithContext(ctx)
 
 	return err == nil || !k8serrors.IsNotFound(err)
 }
 
 // Delete removes a clusterserviceversion.
 func (builder *ClusterServiceVersionBuilder) Delete() error {
+	return builder.DeleteWithContext(context.Background())
+}
+
+// DeleteWithContext removes a clusterserviceversion using the provided context.
+func (builder *ClusterServiceVersionBuilder) DeleteWithContext(ctx context.Context) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
@@ -127,7 +143,7 @@ func (builder *ClusterServiceVersionBuilder) Delete() error {
 	glog.V(100).Infof("Deleting clusterserviceversion %s in namespace %s", builder.Definition.Name,
 		builder.Definition.Namespace)
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		glog.V(100).Infof("clusterserviceversion %s namespace %s cannot be deleted because it does not exist",
 			builder.Definition.Name, builder.Definition.Namespace)
 
@@ -136,7 +152,7 @@ func (builder *ClusterServiceVersionBuilder) Delete() error {
 		return nil
 	}
 
-	err := builder.apiClient.Delete(context.TODO(), builder.Definition)
+	err := builder.apiClient.Delete(ctx, builder.Definition)
 
 	if err != nil {
 		return err
@@ -149,13 +165,19 @@ func (builder *ClusterServiceVersionBuilder) Delete() error {
 
 // Update checks wether a clusterserviceversion exists and updates it.
 func (builder *ClusterServiceVersionBuilder) Update() (*ClusterServiceVersionBuilder, error) {
+	return builder.UpdateWithContext(context.Background())
+}
+
+// UpdateWithContext checks wether a clusterserviceversion exists and updates it using the provided context.
+func (builder *ClusterServiceVersionBuilder) UpdateWithContext(
+	ctx context.Context) (*ClusterServiceVersionBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return nil, err
 	}
 
 	glog.V(100).Infof("Updating ClusterServiceVersion %s", builder.Definition.Name)
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		glog.V(100).Infof("ClusterServiceVersion %s does not exist", builder.Definition.Name)
 
 		return nil, fmt.Errorf("cannot update non-existent ClusterServiceVersion")
@@ -163,7 +185,7 @@ func (builder *ClusterServiceVersionBuilder) Update() (*ClusterServiceVersionBui
 
 	builder.Definition.ResourceVersion = builder.Object.ResourceVersion
 
-	err := builder.apiClient.Update(context.TODO(), builder.Definition)
+	err := builder.apiClient.Update(ctx, builder.Definition)
 	if err != nil {
 		return nil, err
 	}
@@ -198,6 +220,11 @@ func (builder *ClusterServiceVersionBuilder) GetAlmExamples() (string, error) {
 
 // IsSuccessful checks if the clusterserviceversion is Successful.
 func (builder *ClusterServiceVersionBuilder) IsSuccessful() (bool, error) {
+	return builder.IsSuccessfulWithContext(context.Background())
+}
+
+// IsSuccessfulWithContext checks if the clusterserviceversion is Successful using the provided context.
+func (builder *ClusterServiceVersionBuilder) IsSuccessfulWithContext(ctx context.Context) (bool, error) {
 	if valid, err := builder.validate(); !valid {
 		return false, err
 	}
@@ -205,7 +232,7 @@ func (builder *ClusterServiceVersionBuilder) IsSuccessful() (bool, error) {
 	glog.V(100).Infof("Verify clusterserviceversion %s in namespace %s is Successful",
 		builder.Definition.Name, builder.Definition.Namespace)
 
-	phase, err := builder.GetPhase()
+	phase, err := builder.GetPhaseWithContext(ctx)
 
 	if err != nil {
 		return false, fmt.Errorf("failed to get phase value for %s clusterserviceversion in %s namespace due to %w",
@@ -217,6 +244,12 @@ func (builder *ClusterServiceVersionBuilder) IsSuccessful() (bool, error) {
 
 // GetPhase gets current clusterserviceversion phase.
 func (builder *ClusterServiceVersionBuilder) GetPhase() (oplmV1alpha1.ClusterServiceVersionPhase, error) {
+	return builder.GetPhaseWithContext(context.Background())
+}
+
+// GetPhaseWithContext gets current clusterserviceversion phase using the provided context.
+func (builder *ClusterServiceVersionBuilder) GetPhaseWithContext(
+	ctx context.Context) (oplmV1alpha1.ClusterServiceVersionPhase, error) {
 	if valid, err := builder.validate(); !valid {
 		return "", err
 	}
@@ -224,7 +257,7 @@ func (builder *ClusterServiceVersionBuilder) GetPhase() (oplmV1alpha1.ClusterSer
 	glog.V(100).Infof("Get clusterserviceversion %s phase in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		return "", fmt.Errorf("%s clusterserviceversion not found in %s namespace",
 			builder.Definition.Name, builder.Definition.Namespace)
 	}
@@ -14,6 +14,16 @@ import (
 
 // ListClusterServiceVersion returns clusterserviceversion inventory in the given namespace.
 func ListClusterServiceVersion(
+	apiClient *clients.Settings,
+	nsname string,
+	options ...client.ListOptions) ([]*ClusterServiceVersionBuilder, error) {
+	return ListClusterServiceVersionWithContext(context.Background(), apiClient, nsname, options...)
+}
+
+// ListClusterServiceVersionWithContext returns clusterserviceversion inventory in the given namespace using the
+// provided context.
+func ListClusterServiceVersionWithContext(
+	ctx context.Context,
 	apiClient *clients.Settings,
 	nsname string,
 	options ...client.ListOptions) ([]*ClusterServiceVersionBuilder, error) {
@@ -54,7 +64,7 @@ func ListClusterServiceVersion(
 	glog.V(100).Infof(logMessage)
 
 	csvList := new(oplmV1alpha1.ClusterServiceVersionList)
-	err = apiClient.List(context.TODO(), csvList, &passedOptions)
+	err = apiClient.List(ctx, csvList, &passedOptions)
 
 	if err != nil {
 		glog.V(100).Infof("Failed to list clusterserviceversion in the nsname %s due to %s", nsname, err.Error())
@@ -81,6 +91,18 @@ func ListClusterServiceVersion(
 // ListClusterServiceVersionWithNamePattern returns a cluster-wide clusterserviceversion inventory
 // filtered by the name pattern.
 func ListClusterServiceVersionWithNamePattern(
+	apiClient *clients.Settings,
+	namePattern string,
+	nsname string,
+	options ...client.ListOptions) ([]*ClusterServiceVersionBuilder, error) {
+	return ListClusterServiceVersionWithNamePatternWithContext(
+		context.Background(), apiClient, namePattern, nsname, options...)
+}
+
+// ListClusterServiceVersionWithNamePatternWithContext returns a cluster-wide clusterserviceversion inventory filtered
+// by the name pattern using the provided context.
+func ListClusterServiceVersionWithNamePatternWithContext(
+	ctx context.Context,
 	apiClient *clients.Settings,
 	namePattern string,
 	nsname string,
@@ -102,7 +124,7 @@ func ListClusterServiceVersionWithNamePattern(
 	glog.V(100).Infof("Listing clusterserviceversion filtered by the name pattern %s in %s namespace",
 		namePattern, nsname)
 
-	notFilteredCsvList, err := ListClusterServiceVersion(apiClient, nsname, options...)
+	notFilteredCsvList, err := ListClusterServiceVersionWithContext(ctx, apiClient, nsname, options...)
 
 	if err != nil {
 		glog.V(100).Infof("Failed to list all clusterserviceversions in namespace %s due to %s",
@@ -124,6 +146,15 @@ func ListClusterServiceVersionWithNamePattern(
 
 // ListClusterServiceVersionInAllNamespaces returns cluster-wide clusterserviceversion inventory.
 func ListClusterServiceVersionInAllNamespaces(
+	apiClient *clients.Settings,
+	options ...client.ListOptions) ([]*ClusterServiceVersionBuilder, error) {
+	return ListClusterServiceVersionInAllNamespacesWithContext(context.Background(), apiClient, options...)
+}
+
+// ListClusterServiceVersionInAllNamespacesWithContext returns cluster-wide clusterserviceversion inventory using the
+// provided context.
+func ListClusterServiceVersionInAllNamespacesWithContext(
+	ctx context.Context,
 	apiClient *clients.Settings,
 	options ...client.ListOptions) ([]*ClusterServiceVersionBuilder, error) {
 	if apiClient == nil {
@@ -157,7 +188,7 @@ func ListClusterServiceVersionInAllNamespaces(
 	glog.V(100).Infof(logMessage)
 
 	csvList := new(oplmV1alpha1.ClusterServiceVersionList)
-	err = apiClient.List(context.TODO(), csvList, &passedOptions)
+	err = apiClient.List(ctx, csvList, &passedOptions)
 
 	if err != nil {
 		glog.V(100).Infof("Failed to list CSVs in all namespaces due to %s", err.Error())
@@ -0,0 +1,460 @@
+package olm
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/namespace"
+	operatorsv1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/olm/operators/v1"
+	operatorsV1alpha1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/olm/operators/v1alpha1"
+	pkgmanifestv1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/olm/package-server/operators/v1"
+	"github.com/openshift-kni/eco-goinfra/pkg/waiter"
+	corev1 "k8s.io/api/core/v1"
+	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// DefaultCatalogNamespace is the namespace of the default CatalogSources on OpenShift.
+	DefaultCatalogNamespace = "openshift-marketplace"
+	// DefaultInstallTimeout is the time InstallOperator waits for the operator to be installed by default.
+	DefaultInstallTimeout = 10 * time.Minute
+)
+
+// OperatorInstallSpec describes an operator installed by InstallOperator.
+type OperatorInstallSpec struct {
+	// Package is the name of the operator package in the catalog. Required.
+	Package string
+	// Namespace is the namespace the operator is installed in. Required. It is created if it does not exist.
+	Namespace string
+	// Catalog is the name of the CatalogSource providing the package. Required.
+	Catalog string
+	// CatalogNamespace is the namespace of the CatalogSource. Defaults to DefaultCatalogNamespace.
+	CatalogNamespace string
+	// Channel is the channel to subscribe to. Defaults to the default channel of the PackageManifest.
+	Channel string
+	// TargetNamespaces are the namespaces watched by the operator. The operator watches all namespaces when empty.
+	// They are only used when no OperatorGroup exists in Namespace yet.
+	TargetNamespaces []string
+	// InstallPlanApproval is the approval of the Subscription, Automatic or Manual. Defaults to Automatic. When Manual,
+	// the InstallPlan installing the operator is approved by InstallOperator, later ones are left to the user.
+	InstallPlanApproval operatorsV1alpha1.Approval
+	// StartingCSV is the ClusterServiceVersion to install instead of the head of the channel when set.
+	StartingCSV string
+	// Timeout is how long to wait for the operator to be installed. Defaults to DefaultInstallTimeout.
+	Timeout time.Duration
+}
+
+// InstalledOperator is an operator installed by InstallOperator. It holds builders for the objects of the operator and
+// is used to uninstall it.
+type InstalledOperator struct {
+	// Spec is the spec the operator was installed with, with defaults and the channel resolved.
+	Spec OperatorInstallSpec
+	// OperatorGroup is the OperatorGroup of the operator namespace, created by InstallOperator or already existing.
+	OperatorGroup *OperatorGroupBuilder
+	// Subscription is the Subscription of the operator.
+	Subscription *SubscriptionBuilder
+	// ClusterServiceVersion is the installed ClusterServiceVersion, nil until the install plan is resolved. Uninstall
+	// resolves it from the Subscription when unset.
+	ClusterServiceVersion *ClusterServiceVersionBuilder
+	// createdOperatorGroup is true if OperatorGroup was created by InstallOperator and should be uninstalled.
+	createdOperatorGroup bool
+	apiClient            *clients.Settings
+}
+
+// InstallOperator installs the operator described by spec and waits until its ClusterServiceVersion succeeds. It
+// creates the namespace and OperatorGroup if needed and the Subscription, then approves the InstallPlan if the approval
+// is Manual. If the install fails after the Subscription was created, the partially installed operator is returned
+// along with the error so that it can be uninstalled.
+func InstallOperator(apiClient *clients.Settings, spec OperatorInstallSpec) (*InstalledOperator, error) {
+	return InstallOperatorWithContext(context.Background(), apiClient, spec)
+}
+
+// InstallOperatorWithContext installs the operator described by spec like InstallOperator until the operator is
+// installed, the spec timeout expires or ctx is done.
+//
+//nolint:funlen
+func InstallOperatorWithContext(
+	ctx context.Context, apiClient *clients.Settings, spec OperatorInstallSpec) (*InstalledOperator, error) {
+	if apiClient == nil {
+		glog.V(100).Infof("The apiClient cannot be nil")
+
+		return nil, fmt.Errorf("operator install 'apiClient' cannot be empty")
+	}
+
+	spec, err := validateOperatorInstallSpec(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	glog.V(100).Infof("Installing operator %s from catalog %s in namespace %s",
+		spec.Package, spec.Catalog, spec.Namespace)
+
+	for _, attacher := range []clients.SchemeAttacher{
+		operatorsv1.AddToScheme, operatorsV1alpha1.AddToScheme, pkgmanifestv1.AddToScheme} {
+		if err := apiClient.AttachScheme(attacher); err != nil {
+			glog.V(100).Infof("Failed to add olm schemes to client schemes")
+
+			return nil, err
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, spec.Timeout)
+	defer cancel()
+
+	spec.Channel, err = resolveChannel(ctx, apiClient, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = namespace.NewBuilder(apiClient, spec.Namespace).CreateWithContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create namespace %s for operator %s: %w", spec.Namespace, spec.Package, err)
+	}
+
+	installed := &InstalledOperator{Spec: spec, apiClient: apiClient}
+
+	installed.OperatorGroup, installed.createdOperatorGroup, err = ensureOperatorGroup(ctx, apiClient, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptionBuilder := NewSubscriptionBuilder(
+		apiClient, spec.Package, spec.Namespace, spec.Catalog, spec.CatalogNamespace, spec.Package).
+		WithChannel(spec.Channel).
+		WithInstallPlanApproval(spec.InstallPlanApproval)
+
+	if spec.StartingCSV != "" {
+		subscriptionBuilder.WithStartingCSV(spec.StartingCSV)
+	}
+
+	installed.Subscription, err = subscriptionBuilder.CreateWithContext(ctx)
+	if err != nil {
+		return installed, fmt.Errorf("failed to create subscription for operator %s: %w", spec.Package, err)
+	}
+
+	subscription, err := installed.waitForInstallPlan(ctx)
+	if err != nil {
+		return installed, err
+	}
+
+	csvName := subscription.Status.CurrentCSV
+	installed.setClusterServiceVersion(csvName)
+
+	err = installed.approveInstallPlan(ctx, subscription.Status.InstallPlanRef.Name, csvName)
+	if err != nil {
+		return installed, err
+	}
+
+	err = installed.waitForClusterServiceVersion(ctx)
+	if err != nil {
+		return installed, err
+	}
+
+	glog.V(100).Infof("Operator %s installed with clusterserviceversion %s in namespace %s",
+		spec.Package, csvName, spec.Namespace)
+
+	return installed, nil
+}
+
+// Uninstall removes the Subscription, the ClusterServiceVersion and, if it was created by InstallOperator, the
+// OperatorGroup of the operator. The CRDs owned by the ClusterServiceVersion are also deleted if deleteCRDs is true,
+// which deletes all the custom resources of these kinds. The namespace is left in place.
+func (installed *InstalledOperator) Uninstall(deleteCRDs bool) error {
+	return installed.UninstallWithContext(context.Background(), deleteCRDs)
+}
+
+// UninstallWithContext uninstalls the operator like Uninstall using the provided context.
+func (installed *InstalledOperator) UninstallWithContext(ctx context.Context, deleteCRDs bool) error {
+	if installed == nil || installed.apiClient == nil {
+		glog.V(100).Infof("The installed operator is uninitialized")
+
+		return fmt.Errorf("cannot uninstall an uninitialized operator")
+	}
+
+	glog.V(100).Infof("Uninstalling operator %s from namespace %s", installed.Spec.Package, installed.Spec.Namespace)
+
+	if installed.Subscription != nil {
+		if err := installed.resolveClusterServiceVersion(ctx); err != nil {
+			return err
+		}
+
+		if err := installed.Subscription.DeleteWithContext(ctx); err != nil {
+			return fmt.Errorf("failed to delete subscription of operator %s: %w", installed.Spec.Package, err)
+		}
+	}
+
+	var ownedCRDs []string
+
+	if installed.ClusterServiceVersion != nil {
+		csv, err := installed.ClusterServiceVersion.GetWithContext(ctx)
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return fmt.Errorf("failed to get clusterserviceversion of operator %s: %w", installed.Spec.Package, err)
+		}
+
+		if csv != nil {
+			for _, crd := range csv.Spec.CustomResourceDefinitions.Owned {
+				ownedCRDs = append(ownedCRDs, crd.Name)
+			}
+		}
+
+		if err := installed.ClusterServiceVersion.DeleteWithContext(ctx); err != nil {
+			return fmt.Errorf("failed to delete clusterserviceversion of operator %s: %w", installed.Spec.Package, err)
+		}
+	}
+
+	if installed.OperatorGroup != nil && installed.createdOperatorGroup {
+		if err := installed.OperatorGroup.DeleteWithContext(ctx); err != nil {
+			return fmt.Errorf("failed to delete operatorgroup of operator %s: %w", installed.Spec.Package, err)
+		}
+	}
+
+	if !deleteCRDs {
+		return nil
+	}
+
+	for _, crdName := range ownedCRDs {
+		glog.V(100).Infof("Deleting CRD %s owned by operator %s", crdName, installed.Spec.Package)
+
+		err := installed.apiClient.Client.Delete(ctx,
+			&apiextv1.CustomResourceDefinition{ObjectMeta: metav1.ObjectMeta{Name: crdName}})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete CRD %s of operator %s: %w", crdName, installed.Spec.Package, err)
+		}
+	}
+
+	return nil
+}
+
+// resolveClusterServiceVersion sets the ClusterServiceVersion of the operator from its Subscription if the install
+// failed before it was known, so that it is not left behind once the Subscription is deleted.
+func (installed *InstalledOperator) resolveClusterServiceVersion(ctx context.Context) error {
+	if installed.ClusterServiceVersion != nil {
+		return nil
+	}
+
+	subscription, err := installed.Subscription.GetWithContext(ctx)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil
+		}
+
+		return fmt.Errorf("failed to get subscription of operator %s: %w", installed.Spec.Package, err)
+	}
+
+	csvName := subscription.Status.CurrentCSV
+	if csvName == "" {
+		csvName = subscription.Status.InstalledCSV
+	}
+
+	if csvName != "" {
+		installed.setClusterServiceVersion(csvName)
+	}
+
+	return nil
+}
+
+// setClusterServiceVersion sets the ClusterServiceVersion of the operator to the one named csvName.
+func (installed *InstalledOperator) setClusterServiceVersion(csvName string) {
+	installed.ClusterServiceVersion = &ClusterServiceVersionBuilder{
+		apiClient: installed.apiClient.Client,
+		Definition: &operatorsV1alpha1.ClusterServiceVersion{
+			ObjectMeta: metav1.ObjectMeta{Name: csvName, Namespace: installed.Spec.Namespace},
+		},
+	}
+}
+
+// waitForInstallPlan waits until the Subscription references the InstallPlan of its current ClusterServiceVersion.
+func (installed *InstalledOperator) waitForInstallPlan(ctx context.Context) (*operatorsV1alpha1.Subscription, error) {
+	source := waiter.NewRuntimeSource[operatorsV1alpha1.Subscription](
+		installed.apiClient.Client, installed.Spec.Namespace, installed.Spec.Package)
+
+	return waiter.For(ctx, source, waiter.Options{
+		Description: fmt.Sprintf("subscription %s in namespace %s to reference an installplan",
+			installed.Spec.Package, installed.Spec.Namespace),
+	}, func(subscription *operatorsV1alpha1.Subscription) (bool, error) {
+		resolutionFailed := subscription.Status.GetCondition(operatorsV1alpha1.SubscriptionResolutionFailed)
+		if resolutionFailed.Status == corev1.ConditionTrue {
+			return false, fmt.Errorf("subscription resolution failed: %s", resolutionFailed.Message)
+		}
+
+		return subscription.Status.InstallPlanRef != nil && subscription.Status.CurrentCSV != "", nil
+	})
+}
+
+// approveInstallPlan approves the InstallPlan planName if it requires a manual approval. It must install csvName, so
+// that an unrelated InstallPlan is never approved.
+func (installed *InstalledOperator) approveInstallPlan(ctx context.Context, planName, csvName string) error {
+	installPlan, err := PullInstallPlanWithContext(ctx, installed.apiClient, planName, installed.Spec.Namespace)
+	if err != nil {
+		return fmt.Errorf("failed to get installplan of operator %s: %w", installed.Spec.Package, err)
+	}
+
+	if installPlan.Object.Spec.Approval != operatorsV1alpha1.ApprovalManual || installPlan.Object.Spec.Approved {
+		return nil
+	}
+
+	if !slices.Contains(installPlan.Object.Spec.ClusterServiceVersionNames, csvName) {
+		return fmt.Errorf("installplan %s does not install clusterserviceversion %s", planName, csvName)
+	}
+
+	glog.V(100).Infof("Approving installplan %s of operator %s", planName, installed.Spec.Package)
+
+	installPlan.Definition.Spec.Approved = true
+
+	_, err = installPlan.UpdateWithContext(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to approve installplan %s of operator %s: %w", planName, installed.Spec.Package, err)
+	}
+
+	return nil
+}
+
+// waitForClusterServiceVersion waits until the ClusterServiceVersion of the operator succeeds or fails.
+func (installed *InstalledOperator) waitForClusterServiceVersion(ctx context.Context) error {
+	csvBuilder := installed.ClusterServiceVersion
+
+	csv, err := waitForClusterServiceVersion(
+		ctx, installed.apiClient, csvBuilder.Definition.Name, csvBuilder.Definition.Namespace)
+	if csv != nil {
+		csvBuilder.Object = csv
+		csvBuilder.Definition = csv
+	}
+
+	return err
+}
+
+// waitForClusterServiceVersion waits until the ClusterServiceVersion name in namespace nsname succeeds or fails and
+// returns it. An error is returned if it failed.
+func waitForClusterServiceVersion(ctx context.Context,
+	apiClient *clients.Settings, name, nsname string) (*operatorsV1alpha1.ClusterServiceVersion, error) {
+	source := waiter.WithEvents(
+		waiter.NewRuntimeSource[operatorsV1alpha1.ClusterServiceVersion](apiClient.Client, nsname, name),
+		apiClient, "ClusterServiceVersion", nsname)
+
+	csv, matched, err := waiter.ForAny(ctx, source, waiter.Options{
+		Description: fmt.Sprintf("clusterserviceversion %s in namespace %s to succeed", name, nsname),
+	}, hasCSVPhase(operatorsV1alpha1.CSVPhaseSucceeded), hasCSVPhase(operatorsV1alpha1.CSVPhaseFailed))
+	if err != nil {
+		return nil, err
+	}
+
+	if matched == 1 {
+		return csv, fmt.Errorf("clusterserviceversion %s failed: %s: %s", name, csv.Status.Reason, csv.Status.Message)
+	}
+
+	return csv, nil
+}
+
+// hasCSVPhase returns a condition satisfied when the ClusterServiceVersion is in phase.
+func hasCSVPhase(
+	phase operatorsV1alpha1.ClusterServiceVersionPhase) waiter.Condition[*operatorsV1alpha1.ClusterServiceVersion] {
+	return func(csv *operatorsV1alpha1.ClusterServiceVersion) (bool, error) {
+		return csv.Status.Phase == phase, nil
+	}
+}
+
+// resolveChannel returns the channel of spec, or the default channel of the package if unset. The channel must exist
+// in the PackageManifest of the catalog.
+func resolveChannel(ctx context.Context, apiClient *clients.Settings, spec OperatorInstallSpec) (string, error) {
+	packageManifest, err := PullPackageManifestByCatalogWithContext(
+		ctx, apiClient, spec.Package, spec.CatalogNamespace, spec.Catalog)
+	if err != nil {
+		return "", fmt.Errorf("failed to get packagemanifest of operator %s: %w", spec.Package, err)
+	}
+
+	channel := spec.Channel
+	if channel == "" {
+		channel = packageManifest.Object.GetDefaultChannel()
+	}
+
+	for _, packageChannel := range packageManifest.Object.Status.Channels {
+		if packageChannel.Name == channel {
+			return channel, nil
+		}
+	}
+
+	return "", fmt.Errorf("channel %q not found in packagemanifest of operator %s", channel, spec.Package)
+}
+
+// ensureOperatorGroup returns the OperatorGroup of the operator namespace, creating it if none exists. A namespace
+// must have a single OperatorGroup, so an existing one is reused. The returned bool is true if it was created.
+func ensureOperatorGroup(
+	ctx context.Context, apiClient *clients.Settings, spec OperatorInstallSpec) (*OperatorGroupBuilder, bool, error) {
+	operatorGroups := &operatorsv1.OperatorGroupList{}
+
+	err := apiClient.Client.List(ctx, operatorGroups, runtimeClient.InNamespace(spec.Namespace))
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to list operatorgroups in namespace %s: %w", spec.Namespace, err)
+	}
+
+	if len(operatorGroups.Items) > 0 {
+		existing := operatorGroups.Items[0]
+
+		glog.V(100).Infof("Using existing operatorgroup %s in namespace %s", existing.Name, spec.Namespace)
+
+		return &OperatorGroupBuilder{
+			apiClient:  apiClient.Client,
+			Definition: &existing,
+			Object:     &existing,
+		}, false, nil
+	}
+
+	operatorGroup := NewOperatorGroupBuilder(apiClient, spec.Package, spec.Namespace)
+	operatorGroup.Definition.Spec.TargetNamespaces = spec.TargetNamespaces
+
+	operatorGroup, err = operatorGroup.CreateWithContext(ctx)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to create operatorgroup for operator %s: %w", spec.Package, err)
+	}
+
+	return operatorGroup, true, nil
+}
+
+// validateOperatorInstallSpec returns spec with its defaults set or an error if a required field is missing.
+func validateOperatorInstallSpec(spec OperatorInstallSpec) (OperatorInstallSpec, error) {
+	if spec.Package == "" {
+		glog.V(100).Infof("The package of the operator is empty")
+
+		return spec, fmt.Errorf("operator install 'package' cannot be empty")
+	}
+
+	if spec.Namespace == "" {
+		glog.V(100).Infof("The namespace of the operator is empty")
+
+		return spec, fmt.Errorf("operator install 'namespace' cannot be empty")
+	}
+
+	if spec.Catalog == "" {
+		glog.V(100).Infof("The catalog of the operator is empty")
+
+		return spec, fmt.Errorf("operator install 'catalog' cannot be empty")
+	}
+
+	if spec.InstallPlanApproval == "" {
+		spec.InstallPlanApproval = operatorsV1alpha1.ApprovalAutomatic
+	}
+
+	if spec.InstallPlanApproval != operatorsV1alpha1.ApprovalAutomatic &&
+		spec.InstallPlanApproval != operatorsV1alpha1.ApprovalManual {
+		glog.V(100).Infof("The install plan approval of the operator is invalid: %s", spec.InstallPlanApproval)
+
+		return spec, fmt.Errorf("operator install 'installPlanApproval' must be either \"Automatic\" or \"Manual\"")
+	}
+
+	if spec.CatalogNamespace == "" {
+		spec.CatalogNamespace = DefaultCatalogNamespace
+	}
+
+	if spec.Timeout <= 0 {
+		spec.Timeout = DefaultInstallTimeout
+	}
+
+	return spec, nil
+}
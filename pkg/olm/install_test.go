@@ -0,0 +1,269 @@
+package olm
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	operatorsv1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/olm/operators/v1"
+	operatorsV1alpha1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/olm/operators/v1alpha1"
+	pkgmanifestv1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/olm/package-server/operators/v1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	defaultInstallPackage     = "test-operator"
+	defaultInstallNamespace   = "test-operator-ns"
+	defaultInstallCatalog     = "test-catalog"
+	defaultInstallCSV         = "test-operator.v1.0.0"
+	defaultInstallPlanName    = "install-test"
+	defaultInstallOwnedCRD    = "tests.test.io"
+	defaultInstallGroupExists = "existing-group"
+)
+
+var installTestSchemes = []clients.SchemeAttacher{
+	operatorsv1.AddToScheme,
+	operatorsV1alpha1.AddToScheme,
+	pkgmanifestv1.AddToScheme,
+}
+
+//nolint:funlen
+func TestInstallOperator(t *testing.T) {
+	testCases := []struct {
+		spec                  OperatorInstallSpec
+		client                bool
+		planApproval          operatorsV1alpha1.Approval
+		csvPhase              operatorsV1alpha1.ClusterServiceVersionPhase
+		operatorGroupExists   bool
+		expectedChannel       string
+		expectedGroupCreation bool
+		expectedError         error
+	}{
+		{
+			spec:                  buildValidOperatorInstallSpec(),
+			client:                true,
+			planApproval:          operatorsV1alpha1.ApprovalAutomatic,
+			csvPhase:              operatorsV1alpha1.CSVPhaseSucceeded,
+			expectedChannel:       "stable",
+			expectedGroupCreation: true,
+		},
+		{
+			spec: func() OperatorInstallSpec {
+				spec := buildValidOperatorInstallSpec()
+				spec.Channel = "fast"
+				spec.InstallPlanApproval = operatorsV1alpha1.ApprovalManual
+
+				return spec
+			}(),
+			client:                true,
+			planApproval:          operatorsV1alpha1.ApprovalManual,
+			csvPhase:              operatorsV1alpha1.CSVPhaseSucceeded,
+			expectedChannel:       "fast",
+			expectedGroupCreation: true,
+		},
+		{
+			spec:                buildValidOperatorInstallSpec(),
+			client:              true,
+			planApproval:        operatorsV1alpha1.ApprovalAutomatic,
+			csvPhase:            operatorsV1alpha1.CSVPhaseSucceeded,
+			operatorGroupExists: true,
+			expectedChannel:     "stable",
+		},
+		{
+			spec:         buildValidOperatorInstallSpec(),
+			client:       true,
+			planApproval: operatorsV1alpha1.ApprovalAutomatic,
+			csvPhase:     operatorsV1alpha1.CSVPhaseFailed,
+			expectedError: fmt.Errorf(
+				"clusterserviceversion %s failed: InstallComponentFailed: install strategy failed", defaultInstallCSV),
+		},
+		{
+			spec: func() OperatorInstallSpec {
+				spec := buildValidOperatorInstallSpec()
+				spec.Channel = "beta"
+
+				return spec
+			}(),
+			client:        true,
+			expectedError: fmt.Errorf("channel \"beta\" not found in packagemanifest of operator %s", defaultInstallPackage),
+		},
+		{
+			spec: func() OperatorInstallSpec {
+				spec := buildValidOperatorInstallSpec()
+				spec.Package = ""
+
+				return spec
+			}(),
+			client:        true,
+			expectedError: fmt.Errorf("operator install 'package' cannot be empty"),
+		},
+		{
+			spec: func() OperatorInstallSpec {
+				spec := buildValidOperatorInstallSpec()
+				spec.InstallPlanApproval = "Never"
+
+				return spec
+			}(),
+			client:        true,
+			expectedError: fmt.Errorf("operator install 'installPlanApproval' must be either \"Automatic\" or \"Manual\""),
+		},
+		{
+			spec:          buildValidOperatorInstallSpec(),
+			client:        false,
+			expectedError: fmt.Errorf("operator install 'apiClient' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var testSettings *clients.Settings
+
+		if testCase.client {
+			testSettings = buildInstallTestClient(testCase.planApproval, testCase.csvPhase, testCase.operatorGroupExists)
+		}
+
+		installed, err := InstallOperator(testSettings, testCase.spec)
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError != nil {
+			continue
+		}
+
+		assert.Equal(t, testCase.expectedChannel, installed.Spec.Channel)
+		assert.Equal(t, testCase.expectedChannel, installed.Subscription.Object.Spec.Channel)
+		assert.Equal(t, testCase.expectedGroupCreation, installed.createdOperatorGroup)
+		assert.Equal(t, operatorsV1alpha1.CSVPhaseSucceeded, installed.ClusterServiceVersion.Object.Status.Phase)
+
+		installPlan, err := PullInstallPlan(testSettings, defaultInstallPlanName, defaultInstallNamespace)
+		assert.Nil(t, err)
+		assert.True(t, installPlan.Object.Spec.Approved)
+	}
+}
+
+func TestInstalledOperatorUninstall(t *testing.T) {
+	testCases := []struct {
+		operatorGroupExists bool
+		deleteCRDs          bool
+		csvUnresolved       bool
+	}{
+		{operatorGroupExists: false, deleteCRDs: true},
+		{operatorGroupExists: true, deleteCRDs: false},
+		{operatorGroupExists: false, deleteCRDs: true, csvUnresolved: true},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := buildInstallTestClient(
+			operatorsV1alpha1.ApprovalAutomatic, operatorsV1alpha1.CSVPhaseSucceeded, testCase.operatorGroupExists)
+
+		installed, err := InstallOperator(testSettings, buildValidOperatorInstallSpec())
+		assert.Nil(t, err)
+
+		// An install failing before the install plan is resolved leaves the ClusterServiceVersion unset.
+		if testCase.csvUnresolved {
+			installed.ClusterServiceVersion = nil
+		}
+
+		err = installed.Uninstall(testCase.deleteCRDs)
+		assert.Nil(t, err)
+
+		assert.NotNil(t, installed.ClusterServiceVersion)
+
+		assert.False(t, installed.Subscription.Exists())
+		assert.False(t, installed.ClusterServiceVersion.Exists())
+		assert.Equal(t, testCase.operatorGroupExists, installed.OperatorGroup.Exists())
+
+		err = testSettings.Client.Get(t.Context(),
+			runtimeClient.ObjectKey{Name: defaultInstallOwnedCRD}, &apiextv1.CustomResourceDefinition{})
+		assert.Equal(t, testCase.deleteCRDs, err != nil)
+	}
+
+	var installed *InstalledOperator
+
+	assert.Equal(t, fmt.Errorf("cannot uninstall an uninitialized operator"), installed.Uninstall(false))
+}
+
+func buildValidOperatorInstallSpec() OperatorInstallSpec {
+	return OperatorInstallSpec{
+		Package:   defaultInstallPackage,
+		Namespace: defaultInstallNamespace,
+		Catalog:   defaultInstallCatalog,
+		Timeout:   2 * time.Second,
+	}
+}
+
+// buildInstallTestClient returns a client for a cluster where OLM resolves the subscription of the test operator to
+// an InstallPlan with the given approval and a ClusterServiceVersion in the given phase.
+func buildInstallTestClient(
+	approval operatorsV1alpha1.Approval,
+	phase operatorsV1alpha1.ClusterServiceVersionPhase,
+	operatorGroupExists bool) *clients.Settings {
+	runtimeObjects := []runtime.Object{
+		&pkgmanifestv1.PackageManifest{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      defaultInstallPackage,
+				Namespace: DefaultCatalogNamespace,
+				Labels:    map[string]string{"catalog": defaultInstallCatalog},
+			},
+			Status: pkgmanifestv1.PackageManifestStatus{
+				DefaultChannel: "stable",
+				Channels: []pkgmanifestv1.PackageChannel{
+					{Name: "stable", CurrentCSV: defaultInstallCSV},
+					{Name: "fast", CurrentCSV: defaultInstallCSV},
+				},
+			},
+		},
+		&operatorsV1alpha1.InstallPlan{
+			ObjectMeta: metav1.ObjectMeta{Name: defaultInstallPlanName, Namespace: defaultInstallNamespace},
+			Spec: operatorsV1alpha1.InstallPlanSpec{
+				ClusterServiceVersionNames: []string{defaultInstallCSV},
+				Approval:                   approval,
+				Approved:                   approval == operatorsV1alpha1.ApprovalAutomatic,
+			},
+		},
+		&operatorsV1alpha1.ClusterServiceVersion{
+			ObjectMeta: metav1.ObjectMeta{Name: defaultInstallCSV, Namespace: defaultInstallNamespace},
+			Spec: operatorsV1alpha1.ClusterServiceVersionSpec{
+				CustomResourceDefinitions: operatorsV1alpha1.CustomResourceDefinitions{
+					Owned: []operatorsV1alpha1.CRDDescription{{Name: defaultInstallOwnedCRD}},
+				},
+			},
+			Status: operatorsV1alpha1.ClusterServiceVersionStatus{
+				Phase:   phase,
+				Reason:  "InstallComponentFailed",
+				Message: "install strategy failed",
+			},
+		},
+		&apiextv1.CustomResourceDefinition{ObjectMeta: metav1.ObjectMeta{Name: defaultInstallOwnedCRD}},
+	}
+
+	if operatorGroupExists {
+		runtimeObjects = append(runtimeObjects, &operatorsv1.OperatorGroup{
+			ObjectMeta: metav1.ObjectMeta{Name: defaultInstallGroupExists, Namespace: defaultInstallNamespace},
+		})
+	}
+
+	testSettings, builder := clients.GetModifiableTestClients(clients.TestClientParams{
+		K8sMockObjects:  runtimeObjects,
+		SchemeAttachers: installTestSchemes,
+		Simulators: []clients.ControllerSimulator{{
+			Object:   &operatorsV1alpha1.Subscription{},
+			Triggers: []clients.SimulatorTrigger{clients.SimulatorTriggerCreate},
+			Reconcile: func(object runtime.Object, _ int) {
+				subscription := object.(*operatorsV1alpha1.Subscription)
+				subscription.Status.CurrentCSV = defaultInstallCSV
+				subscription.Status.InstallPlanRef = &corev1.ObjectReference{
+					Name: defaultInstallPlanName, Namespace: defaultInstallNamespace}
+			},
+		}},
+	})
+
+	testSettings.Client = builder.WithIndex(
+		&pkgmanifestv1.PackageManifest{}, "metadata.name", nameMetadataIndexer).Build()
+
+	return testSettings
+}
@@ -76,6 +76,12 @@ func NewInstallPlanBuilder(apiClient *clients.Settings, name, nsname string) *In
 
 // PullInstallPlan loads existing InstallPlan from cluster into the InstallPlanBuilder struct.
 func PullInstallPlan(apiClient *clients.Settings, name, nsName string) (*InstallPlanBuilder, error) {
+	return PullInstallPlanWithContext(context.Background(), apiClient, name, nsName)
+}
+
+// PullInstallPlanWithContext loads an existing InstallPlan like PullInstallPlan using the provided context.
+func PullInstallPlanWithContext(
+	ctx context.Context, apiClient *clients.Settings, name, nsName string) (*InstallPlanBuilder, error) {
 	glog.V(100).Infof("Pulling existing InstallPlan %s from cluster in namespace %s", name, nsName)
 
 	if apiClient == nil {
@@ -113,7 +119,7 @@ func PullInstallPlan(apiClient *clients.Settings, name, nsName string) (*Install
 		return nil, fmt.Errorf("installPlan 'nsName' cannot be empty")
 	}
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		return nil, fmt.Errorf(
 			"installPlan object named %s does not exist in namespace %s", name, nsName)
 	}
@@ -125,6 +131,11 @@ func PullInstallPlan(apiClient *clients.Settings, name, nsName string) (*Install
 
 // Get returns InstallPlan object if found.
 func (builder *InstallPlanBuilder) Get() (*operatorsV1alpha1.InstallPlan, error) {
+	return builder.GetWithContext(context.Background())
+}
+
+// GetWithContext returns InstallPlan object if found, using the provided context.
+func (builder *InstallPlanBuilder) GetWithContext(ctx context.Context) (*operatorsV1alpha1.InstallPlan, error) {
 	if valid, err := builder.validate(); !valid {
 		return nil, err
 	}
@@ -134,7 +145,7 @@ func (builder *InstallPlanBuilder) Get() (*operatorsV1alpha1.InstallPlan, error)
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	installPlan := &operatorsV1alpha1.InstallPlan{}
-	err := builder.apiClient.Get(context.TODO(),
+	err := builder.apiClient.Get(ctx,
 		runtimeClient.ObjectKey{Name: builder.Definition.Name, Namespace: builder.Definition.Namespace},
 		installPlan)
 
@@ -151,6 +162,12 @@ func (builder *InstallPlanBuilder) Get() (*operatorsV1alpha1.InstallPlan, error)
 
 // Create makes an InstallPlanBuilder in cluster and stores the created object in struct.
 func (builder *InstallPlanBuilder) Create() (*InstallPlanBuilder, error) {
+	return builder.CreateWithContext(context.Background())
+}
+
+// CreateWithContext makes an InstallPlanBuilder in cluster using the provided context and stores the created object in
+// struct.
+func (builder *InstallPlanBuilder) CreateWithContext(ctx context.Context) (*InstallPlanBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
@@ -158,11 +175,11 @@ func (builder *InstallPlanBuilder) Create() (*InstallPlanBuilder, error) {
 	glog.V(100).Infof("Creating the InstallPlan %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
-	if builder.Exists() {
+	if builder.ExistsWithContext(ctx) {
 		return builder, nil
 	}
 
-	err := builder.apiClient.Create(context.TODO(), builder.Definition)
+	err := builder.apiClient.Create(ctx, builder.Definition)
 	if err != nil {
 		return builder, err
 	}
@@ -174,6 +191,11 @@ func (builder *InstallPlanBuilder) Create() (*InstallPlanBuilder, error) {
 
 // Exists checks whether the given installplan exists.
 func (builder *InstallPlanBuilder) Exists() bool {
+	return builder.ExistsWithContext(context.Background())
+}
+
+// ExistsWithContext checks whether the given installplan exists using the provided context.
+func (builder *InstallPlanBuilder) ExistsWithContext(ctx context.Context) bool {
 	if valid, _ := builder.validate(); !valid {
 		return false
 	}
@@ -182,13 +204,18 @@ func (builder *InstallPlanBuilder) Exists() bool {
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
-	builder.Object, err = builder.Get()
+	builder.Object, err = builder.GetWithContext(ctx)
 
 	return err == nil || !k8serrors.IsNotFound(err)
 }
 
 // Delete removes an installplan.
 func (builder *InstallPlanBuilder) Delete() error {
+	return builder.DeleteWithContext(context.Background())
+}
+
+// DeleteWithContext removes an installplan using the provided context.
+func (builder *InstallPlanBuilder) DeleteWithContext(ctx context.Context) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
@@ -196,7 +223,7 @@ func (builder *InstallPlanBuilder) Delete() error {
 	glog.V(100).Infof("Deleting installplan %s in namespace %s", builder.Definition.Name,
 		builder.Definition.Namespace)
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		glog.V(100).Infof("InstallPlan object %s does not exist in namespace %s",
 			builder.Definition.Name, builder.Definition.Namespace)
 
@@ -205,7 +232,7 @@ func (builder *InstallPlanBuilder) Delete() error {
 		return nil
 	}
 
-	err := builder.apiClient.Delete(context.TODO(), builder.Definition)
+	err := builder.apiClient.Delete(ctx, builder.Definition)
 
 	if err != nil {
 		return err
@@ -218,6 +245,12 @@ func (builder *InstallPlanBuilder) Delete() error {
 
 // Update modifies the existing InstallPlanBuilder with the InstallPlan definition in InstallPlanBuilder.
 func (builder *InstallPlanBuilder) Update() (*InstallPlanBuilder, error) {
+	return builder.UpdateWithContext(context.Background())
+}
+
+// UpdateWithContext modifies the existing InstallPlan with the InstallPlan definition in InstallPlanBuilder using the
+// provided context.
+func (builder *InstallPlanBuilder) UpdateWithContext(ctx context.Context) (*InstallPlanBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
@@ -225,12 +258,12 @@ func (builder *InstallPlanBuilder) Update() (*InstallPlanBuilder, error) {
 	glog.V(100).Infof("Updating installPlan %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		return nil, fmt.Errorf("installPlan named %s in namespace %s does not exist",
 			builder.Definition.Name, builder.Definition.Namespace)
 	}
 
-	err := builder.apiClient.Update(context.TODO(), builder.Definition)
+	err := builder.apiClient.Update(ctx, builder.Definition)
 
 	if err == nil {
 		builder.Object = builder.Definition
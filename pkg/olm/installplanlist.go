@@ -14,6 +14,15 @@ import (
 // ListInstallPlan returns a list of installplans found for specific namespace.
 func ListInstallPlan(
 	apiClient *clients.Settings, nsname string, options ...client.ListOptions) ([]*InstallPlanBuilder, error) {
+	return ListInstallPlanWithContext(context.Background(), apiClient, nsname, options...)
+}
+
+// ListInstallPlanWithContext returns a list of installplans found for specific namespace using the provided context.
+func ListInstallPlanWithContext(
+	ctx context.Context,
+	apiClient *clients.Settings,
+	nsname string,
+	options ...client.ListOptions) ([]*InstallPlanBuilder, error) {
 	if nsname == "" {
 		glog.V(100).Info("The nsname of the installplan is empty")
 
@@ -51,7 +60,7 @@ func ListInstallPlan(
 	glog.V(100).Infof(logMessage)
 
 	installPlanList := new(oplmV1alpha1.InstallPlanList)
-	err = apiClient.List(context.TODO(), installPlanList, &passedOptions)
+	err = apiClient.List(ctx, installPlanList, &passedOptions)
 
 	if err != nil {
 		glog.V(100).Infof("Failed to list all installplan in namespace %s due to %s",
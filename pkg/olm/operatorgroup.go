@@ -81,6 +81,11 @@ func NewOperatorGroupBuilder(apiClient *clients.Settings, groupName, nsName stri
 
 // Get returns OperatorGroup object if found.
 func (builder *OperatorGroupBuilder) Get() (*operatorsv1.OperatorGroup, error) {
+	return builder.GetWithContext(context.Background())
+}
+
+// GetWithContext returns OperatorGroup object if found, using the provided context.
+func (builder *OperatorGroupBuilder) GetWithContext(ctx context.Context) (*operatorsv1.OperatorGroup, error) {
 	if valid, err := builder.validate(); !valid {
 		return nil, err
 	}
@@ -90,7 +95,7 @@ func (builder *OperatorGroupBuilder) Get() (*operatorsv1.OperatorGroup, error) {
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	operatorGroup := &operatorsv1.OperatorGroup{}
-	err := builder.apiClient.Get(context.TODO(),
+	err := builder.apiClient.Get(ctx,
 		runtimeClient.ObjectKey{Name: builder.Definition.Name, Namespace: builder.Definition.Namespace},
 		operatorGroup)
 
@@ -107,17 +112,23 @@ func (builder *OperatorGroupBuilder) Get() (*operatorsv1.OperatorGroup, error) {
 
 // Create makes an OperatorGroup in cluster and stores the created object in struct.
 func (builder *OperatorGroupBuilder) Create() (*OperatorGroupBuilder, error) {
+	return builder.CreateWithContext(context.Background())
+}
+
+// CreateWithContext makes an OperatorGroup in cluster using the provided context and stores the created object in
+// struct.
+func (builder *OperatorGroupBuilder) CreateWithContext(ctx context.Context) (*OperatorGroupBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
 
 	glog.V(100).Infof("Creating the OperatorGroup %s", builder.Definition.Name)
 
-	if builder.Exists() {
+	if builder.ExistsWithContext(ctx) {
 		return builder, nil
 	}
 
-	err := builder.apiClient.Create(context.TODO(), builder.Definition)
+	err := builder.apiClient.Create(ctx, builder.Definition)
 	if err != nil {
 		return builder, err
 	}
@@ -129,6 +140,11 @@ func (builder *OperatorGroupBuilder) Create() (*OperatorGroupBuilder, error) {
 
 // Exists checks whether the given OperatorGroup exists.
 func (builder *OperatorGroupBuilder) Exists() bool {
+	return builder.ExistsWithContext(context.Background())
+}
+
+// ExistsWithContext checks whether the given OperatorGroup exists using the provided context.
+func (builder *OperatorGroupBuilder) ExistsWithContext(ctx context.Context) bool {
 	if valid, _ := builder.validate(); !valid {
 		return false
 	}
@@ -137,13 +153,18 @@ func (builder *OperatorGroupBuilder) Exists() bool {
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
-	builder.Object, err = builder.Get()
+	builder.Object, err = builder.GetWithContext(ctx)
 
 	return err == nil || !k8serrors.IsNotFound(err)
 }
 
 // Delete removes an OperatorGroup.
 func (builder *OperatorGroupBuilder) Delete() error {
+	return builder.DeleteWithContext(context.Background())
+}
+
+// DeleteWithContext removes an OperatorGroup using the provided context.
+func (builder *OperatorGroupBuilder) DeleteWithContext(ctx context.Context) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
@@ -151,7 +172,7 @@ func (builder *OperatorGroupBuilder) Delete() error {
 	glog.V(100).Infof("Deleting OperatorGroup %s in namespace %s", builder.Definition.Name,
 		builder.Definition.Namespace)
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		glog.V(100).Infof("OperatorGroup %s namespace %s cannot be deleted because it does not exist",
 			builder.Definition.Name, builder.Definition.Namespace)
 
@@ -160,7 +181,7 @@ func (builder *OperatorGroupBuilder) Delete() error {
 		return nil
 	}
 
-	err := builder.apiClient.Delete(context.TODO(), builder.Definition)
+	err := builder.apiClient.Delete(ctx, builder.Definition)
 
 	if err != nil {
 		return err
@@ -173,6 +194,12 @@ func (builder *OperatorGroupBuilder) Delete() error {
 
 // Update modifies the existing OperatorGroup with the OperatorGroup definition in OperatorGroupBuilder.
 func (builder *OperatorGroupBuilder) Update() (*OperatorGroupBuilder, error) {
+	return builder.UpdateWithContext(context.Background())
+}
+
+// UpdateWithContext modifies the existing OperatorGroup with the OperatorGroup definition in OperatorGroupBuilder using
+// the provided context.
+func (builder *OperatorGroupBuilder) UpdateWithContext(ctx context.Context) (*OperatorGroupBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
@@ -180,11 +207,11 @@ func (builder *OperatorGroupBuilder) Update() (*OperatorGroupBuilder, error) {
 	glog.V(100).Infof("Updating OperatorGroup %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		return nil, fmt.Errorf("cannot update non-existent operatorgroup")
 	}
 
-	err := builder.apiClient.Update(context.TODO(), builder.Definition)
+	err := builder.apiClient.Update(ctx, builder.Definition)
 
 	if err == nil {
 		builder.Object = builder.Definition
@@ -30,8 +30,22 @@ type PackageManifestBuilder struct {
 	errorMsg string
 }
 
+// ChannelVersion is a ClusterServiceVersion of a package channel.
+type ChannelVersion struct {
+	// Name is the name of the ClusterServiceVersion.
+	Name string
+	// Version is the version of the operator bundle, if known.
+	Version string
+}
+
 // PullPackageManifest loads an existing PackageManifest into Builder struct.
 func PullPackageManifest(apiClient *clients.Settings, name, nsname string) (*PackageManifestBuilder, error) {
+	return PullPackageManifestWithContext(context.Background(), apiClient, name, nsname)
+}
+
+// PullPackageManifestWithContext loads an existing PackageManifest like PullPackageManifest using the provided context.
+func PullPackageManifestWithContext(
+	ctx context.Context, apiClient *clients.Settings, name, nsname string) (*PackageManifestBuilder, error) {
 	glog.V(100).Infof("Pulling existing PackageManifest name %s in namespace %s", name, nsname)
 
 	if apiClient == nil {
@@ -70,7 +84,7 @@ func PullPackageManifest(apiClient *clients.Settings, name, nsname string) (*Pac
 		return nil, fmt.Errorf("packageManifest 'nsname' cannot be empty")
 	}
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		return nil, fmt.Errorf("packageManifest object %s does not exist in namespace %s", name, nsname)
 	}
 
@@ -82,6 +96,13 @@ func PullPackageManifest(apiClient *clients.Settings, name, nsname string) (*Pac
 // PullPackageManifestByCatalog loads an existing PackageManifest from specified catalog into Builder struct.
 func PullPackageManifestByCatalog(apiClient *clients.Settings, name, nsname,
 	catalog string) (*PackageManifestBuilder, error) {
+	return PullPackageManifestByCatalogWithContext(context.Background(), apiClient, name, nsname, catalog)
+}
+
+// PullPackageManifestByCatalogWithContext loads an existing PackageManifest from specified catalog like
+// PullPackageManifestByCatalog using the provided context.
+func PullPackageManifestByCatalogWithContext(
+	ctx context.Context, apiClient *clients.Settings, name, nsname, catalog string) (*PackageManifestBuilder, error) {
 	glog.V(100).Infof("Pulling existing PackageManifest name %s in namespace %s and from catalog %s",
 		name, nsname, catalog)
 
@@ -113,7 +134,7 @@ func PullPackageManifestByCatalog(apiClient *clients.Settings, name, nsname,
 		return nil, err
 	}
 
-	packageManifests, err := ListPackageManifest(apiClient, nsname, runtimeClient.ListOptions{
+	packageManifests, err := ListPackageManifestWithContext(ctx, apiClient, nsname, runtimeClient.ListOptions{
 		LabelSelector: labelSelector,
 		FieldSelector: fieldSelector,
 	})
@@ -142,6 +163,11 @@ func PullPackageManifestByCatalog(apiClient *clients.Settings, name, nsname,
 
 // Get returns PackageManifest object if found.
 func (builder *PackageManifestBuilder) Get() (*operatorv1.PackageManifest, error) {
+	return builder.GetWithContext(context.Background())
+}
+
+// GetWithContext returns PackageManifest object if found using the provided context.
+func (builder *PackageManifestBuilder) GetWithContext(ctx context.Context) (*operatorv1.PackageManifest, error) {
 	if valid, err := builder.validate(); !valid {
 		return nil, err
 	}
@@ -151,7 +177,7 @@ func (builder *PackageManifestBuilder) Get() (*operatorv1.PackageManifest, error
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	packageManifest := &operatorv1.PackageManifest{}
-	err := builder.apiClient.Get(context.TODO(),
+	err := builder.apiClient.Get(ctx,
 		runtimeClient.ObjectKey{Name: builder.Definition.Name, Namespace: builder.Definition.Namespace},
 		packageManifest)
 
@@ -168,6 +194,11 @@ func (builder *PackageManifestBuilder) Get() (*operatorv1.PackageManifest, error
 
 // Exists checks whether the given PackageManifest exists.
 func (builder *PackageManifestBuilder) Exists() bool {
+	return builder.ExistsWithContext(context.Background())
+}
+
+// ExistsWithContext checks whether the given PackageManifest exists using the provided context.
+func (builder *PackageManifestBuilder) ExistsWithContext(ctx context.Context) bool {
 	if valid, _ := builder.validate(); !valid {
 		return false
 	}
@@ -176,13 +207,93 @@ func (builder *PackageManifestBuilder) Exists() bool {
 		"Checking if PackageManifest %s exists", builder.Definition.Name)
 
 	var err error
-	builder.Object, err = builder.Get()
+	builder.Object, err = builder.GetWithContext(ctx)
 
 	return err == nil || !k8serrors.IsNotFound(err)
 }
 
+// GetUpgradePath returns the ClusterServiceVersions an operator goes through when it is upgraded from the from
+// ClusterServiceVersion to the to ClusterServiceVersion along channel, in upgrade order and excluding from. The path
+// ends at the head of the channel if to is empty. The entries of a channel are listed from its head following the
+// replaces edges, so the path is built from them in reverse order.
+func (builder *PackageManifestBuilder) GetUpgradePath(channel, from, to string) ([]ChannelVersion, error) {
+	return builder.GetUpgradePathWithContext(context.Background(), channel, from, to)
+}
+
+// GetUpgradePathWithContext returns the ClusterServiceVersions an operator goes through when it is upgraded from the
+// from ClusterServiceVersion to the to ClusterServiceVersion along channel, as GetUpgradePath does, using the provided
+// context.
+func (builder *PackageManifestBuilder) GetUpgradePathWithContext(
+	ctx context.Context, channel, from, to string) ([]ChannelVersion, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	glog.V(100).Infof("Getting upgrade path of packageManifest %s in channel %s from %s to %s",
+		builder.Definition.Name, channel, from, to)
+
+	if from == "" {
+		glog.V(100).Infof("The from clusterserviceversion of the upgrade path is empty")
+
+		return nil, fmt.Errorf("upgrade path 'from' cannot be empty")
+	}
+
+	packageManifest, err := builder.GetWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	builder.Object = packageManifest
+
+	for _, packageChannel := range packageManifest.Status.Channels {
+		if packageChannel.Name != channel {
+			continue
+		}
+
+		if to == "" {
+			to = packageChannel.CurrentCSV
+		}
+
+		var (
+			path  []ChannelVersion
+			found bool
+		)
+
+		for index := len(packageChannel.Entries) - 1; index >= 0; index-- {
+			entry := packageChannel.Entries[index]
+
+			if found {
+				path = append(path, ChannelVersion{Name: entry.Name, Version: entry.Version})
+			}
+
+			if entry.Name == from {
+				found = true
+			}
+
+			if found && entry.Name == to {
+				return path, nil
+			}
+		}
+
+		if !found {
+			return nil, fmt.Errorf("clusterserviceversion %s not found in channel %s of packageManifest %s",
+				from, channel, builder.Definition.Name)
+		}
+
+		return nil, fmt.Errorf("clusterserviceversion %s is not an upgrade of %s in channel %s of packageManifest %s",
+			to, from, channel, builder.Definition.Name)
+	}
+
+	return nil, fmt.Errorf("channel %s not found in packageManifest %s", channel, builder.Definition.Name)
+}
+
 // Delete removes a PackageManifest.
 func (builder *PackageManifestBuilder) Delete() error {
+	return builder.DeleteWithContext(context.Background())
+}
+
+// DeleteWithContext removes a PackageManifest using the provided context.
+func (builder *PackageManifestBuilder) DeleteWithContext(ctx context.Context) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
@@ -190,7 +301,7 @@ func (builder *PackageManifestBuilder) Delete() error {
 	glog.V(100).Infof("Deleting PackageManifest %s in namespace %s", builder.Definition.Name,
 		builder.Definition.Namespace)
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		glog.V(100).Infof("PackageManifest object %s does not exist in namespace %s",
 			builder.Definition.Name, builder.Definition.Namespace)
 
@@ -199,7 +310,7 @@ func (builder *PackageManifestBuilder) Delete() error {
 		return nil
 	}
 
-	err := builder.apiClient.Delete(context.TODO(), builder.Definition)
+	err := builder.apiClient.Delete(ctx, builder.Definition)
 
 	if err != nil {
 		return err
@@ -246,6 +246,81 @@ func TestPackageManifestExist(t *testing.T) {
 	}
 }
 
+func TestPackageManifestGetUpgradePath(t *testing.T) {
+	testCases := []struct {
+		channel       string
+		from          string
+		to            string
+		expectedPath  []ChannelVersion
+		expectedError error
+	}{
+		{
+			channel: "stable",
+			from:    "test.v1",
+			to:      "",
+			expectedPath: []ChannelVersion{
+				{Name: "test.v2", Version: "2.0.0"}, {Name: "test.v3", Version: "3.0.0"}},
+		},
+		{
+			channel:      "stable",
+			from:         "test.v1",
+			to:           "test.v2",
+			expectedPath: []ChannelVersion{{Name: "test.v2", Version: "2.0.0"}},
+		},
+		{
+			channel:      "stable",
+			from:         "test.v3",
+			to:           "",
+			expectedPath: nil,
+		},
+		{
+			channel: "stable",
+			from:    "test.v2",
+			to:      "test.v1",
+			expectedError: fmt.Errorf(
+				"clusterserviceversion test.v1 is not an upgrade of test.v2 in channel stable of packageManifest packagemanifest"),
+		},
+		{
+			channel: "stable",
+			from:    "test.v0",
+			to:      "",
+			expectedError: fmt.Errorf(
+				"clusterserviceversion test.v0 not found in channel stable of packageManifest packagemanifest"),
+		},
+		{
+			channel:       "fast",
+			from:          "test.v1",
+			to:            "",
+			expectedError: fmt.Errorf("channel fast not found in packageManifest packagemanifest"),
+		},
+		{
+			channel:       "stable",
+			from:          "",
+			to:            "",
+			expectedError: fmt.Errorf("upgrade path 'from' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		packageManifest := buildPackageManifestDefinition("packagemanifest", "test-namespace")
+		packageManifest.Status.Channels = []operatorsv1.PackageChannel{{
+			Name:       "stable",
+			CurrentCSV: "test.v3",
+			Entries: []operatorsv1.ChannelEntry{
+				{Name: "test.v3", Version: "3.0.0"}, {Name: "test.v2", Version: "2.0.0"}, {Name: "test.v1", Version: "1.0.0"}},
+		}}
+
+		testBuilder := buildValidPackageManifestBuilder(clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects:  []runtime.Object{packageManifest},
+			SchemeAttachers: operatorsv1Scheme,
+		}))
+
+		path, err := testBuilder.GetUpgradePath(testCase.channel, testCase.from, testCase.to)
+		assert.Equal(t, testCase.expectedError, err)
+		assert.Equal(t, testCase.expectedPath, path)
+	}
+}
+
 func buildValidPackageManifestBuilder(apiClient *clients.Settings) *PackageManifestBuilder {
 	return &PackageManifestBuilder{
 		Definition: buildPackageManifestDefinition("packagemanifest", "test-namespace"),
@@ -13,6 +13,15 @@ import (
 
 // ListPackageManifest returns PackageManifest inventory in the given namespace.
 func ListPackageManifest(
+	apiClient *clients.Settings,
+	nsname string,
+	options ...client.ListOptions) ([]*PackageManifestBuilder, error) {
+	return ListPackageManifestWithContext(context.Background(), apiClient, nsname, options...)
+}
+
+// ListPackageManifestWithContext returns PackageManifest inventory in the given namespace using the provided context.
+func ListPackageManifestWithContext(
+	ctx context.Context,
 	apiClient *clients.Settings,
 	nsname string,
 	options ...client.ListOptions) ([]*PackageManifestBuilder, error) {
@@ -53,7 +62,7 @@ func ListPackageManifest(
 	glog.V(100).Infof(logMessage)
 
 	pkgManifestList := new(operatorv1.PackageManifestList)
-	err = apiClient.List(context.TODO(), pkgManifestList, &passedOptions)
+	err = apiClient.List(ctx, pkgManifestList, &passedOptions)
 
 	if err != nil {
 		glog.V(100).Infof("Failed to list PackageManifests in the namespace %s due to %s",
@@ -6,10 +6,12 @@ import (
 
 	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/common"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	operatorsV1alpha1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/olm/operators/v1alpha1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
 	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -20,8 +22,10 @@ type SubscriptionBuilder struct {
 	Definition *operatorsV1alpha1.Subscription
 	// Created Subscription object on the cluster.
 	Object *operatorsV1alpha1.Subscription
+	// Copy of the object the definition was last pulled, created or updated from. It is not refreshed by Exists.
+	base *operatorsV1alpha1.Subscription
 	// api client to interact with the cluster.
-	apiClient runtimeClient.Client
+	apiClient *clients.Settings
 	// errorMsg is processed before Subscription object is created.
 	errorMsg string
 }
@@ -48,7 +52,7 @@ func NewSubscriptionBuilder(apiClient *clients.Settings, subName, subNamespace,
 	}
 
 	builder := &SubscriptionBuilder{
-		apiClient: apiClient.Client,
+		apiClient: apiClient,
 		Definition: &operatorsV1alpha1.Subscription{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      subName,
@@ -170,6 +174,11 @@ func (builder *SubscriptionBuilder) WithInstallPlanApproval(
 
 // Get returns Subscription object if found.
 func (builder *SubscriptionBuilder) Get() (*operatorsV1alpha1.Subscription, error) {
+	return builder.GetWithContext(context.Background())
+}
+
+// GetWithContext returns Subscription object if found, using the provided context.
+func (builder *SubscriptionBuilder) GetWithContext(ctx context.Context) (*operatorsV1alpha1.Subscription, error) {
 	if valid, err := builder.validate(); !valid {
 		return nil, err
 	}
@@ -179,7 +188,7 @@ func (builder *SubscriptionBuilder) Get() (*operatorsV1alpha1.Subscription, erro
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	subscription := &operatorsV1alpha1.Subscription{}
-	err := builder.apiClient.Get(context.TODO(),
+	err := builder.apiClient.Get(ctx,
 		runtimeClient.ObjectKey{Name: builder.Definition.Name, Namespace: builder.Definition.Namespace},
 		subscription)
 
@@ -196,6 +205,12 @@ func (builder *SubscriptionBuilder) Get() (*operatorsV1alpha1.Subscription, erro
 
 // Create makes an Subscription in cluster and stores the created object in struct.
 func (builder *SubscriptionBuilder) Create() (*SubscriptionBuilder, error) {
+	return builder.CreateWithContext(context.Background())
+}
+
+// CreateWithContext makes an Subscription in cluster using the provided context and stores the created object in
+// struct.
+func (builder *SubscriptionBuilder) CreateWithContext(ctx context.Context) (*SubscriptionBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
@@ -203,22 +218,32 @@ func (builder *SubscriptionBuilder) Create() (*SubscriptionBuilder, error) {
 	glog.V(100).Infof("Creating the Subscription %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
-	if builder.Exists() {
+	if err := ctx.Err(); err != nil {
+		return builder, err
+	}
+
+	if builder.ExistsWithContext(ctx) {
 		return builder, nil
 	}
 
-	err := builder.apiClient.Create(context.TODO(), builder.Definition)
+	err := builder.apiClient.Create(ctx, builder.Definition)
 	if err != nil {
 		return builder, err
 	}
 
 	builder.Object = builder.Definition
+	builder.base = builder.Definition.DeepCopy()
 
 	return builder, nil
 }
 
 // Exists checks whether the given Subscription exists.
 func (builder *SubscriptionBuilder) Exists() bool {
+	return builder.ExistsWithContext(context.Background())
+}
+
+// ExistsWithContext checks whether the given Subscription exists using the provided context.
+func (builder *SubscriptionBuilder) ExistsWithContext(ctx context.Context) bool {
 	if valid, _ := builder.validate(); !valid {
 		return false
 	}
@@ -228,13 +253,18 @@ func (builder *SubscriptionBuilder) Exists() bool {
 		builder.Definition.Name)
 
 	var err error
-	builder.Object, err = builder.Get()
+	builder.Object, err = builder.GetWithContext(ctx)
 
 	return err == nil || !k8serrors.IsNotFound(err)
 }
 
 // Delete removes a Subscription.
 func (builder *SubscriptionBuilder) Delete() error {
+	return builder.DeleteWithContext(context.Background())
+}
+
+// DeleteWithContext removes a Subscription using the provided context.
+func (builder *SubscriptionBuilder) DeleteWithContext(ctx context.Context) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
@@ -242,7 +272,7 @@ func (builder *SubscriptionBuilder) Delete() error {
 	glog.V(100).Infof("Deleting Subscription %s in namespace %s", builder.Definition.Name,
 		builder.Definition.Namespace)
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		glog.V(100).Infof("Subscription object %s does not exist in namespace %s",
 			builder.Definition.Name, builder.Definition.Namespace)
 
@@ -251,7 +281,7 @@ func (builder *SubscriptionBuilder) Delete() error {
 		return nil
 	}
 
-	err := builder.apiClient.Delete(context.TODO(), builder.Definition)
+	err := builder.apiClient.Delete(ctx, builder.Definition)
 
 	if err != nil {
 		return err
@@ -264,6 +294,12 @@ func (builder *SubscriptionBuilder) Delete() error {
 
 // Update modifies the existing Subscription with the Subscription definition in SubscriptionBuilder.
 func (builder *SubscriptionBuilder) Update() (*SubscriptionBuilder, error) {
+	return builder.UpdateWithContext(context.Background())
+}
+
+// UpdateWithContext modifies the existing Subscription with the Subscription definition in SubscriptionBuilder using
+// the provided context.
+func (builder *SubscriptionBuilder) UpdateWithContext(ctx context.Context) (*SubscriptionBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
@@ -271,20 +307,45 @@ func (builder *SubscriptionBuilder) Update() (*SubscriptionBuilder, error) {
 	glog.V(100).Infof("Updating Subscription %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		return nil, fmt.Errorf("subscription named %s in namespace %s does not exist",
 			builder.Definition.Name, builder.Definition.Namespace)
 	}
 
-	err := builder.apiClient.Update(context.TODO(), builder.Definition)
+	err := builder.apiClient.Update(ctx, builder.Definition)
 
 	if err == nil {
 		builder.Object = builder.Definition
+		builder.base = builder.Definition.DeepCopy()
 	}
 
 	return builder, err
 }
 
+// UpdateWithRetry updates the subscription with merge semantics. If the subscription was modified on the cluster
+// since it was pulled, created or last updated, the changes made through the builder are re-applied to the latest
+// version and the update is retried according to backoff, for example retry.DefaultRetry.
+func (builder *SubscriptionBuilder) UpdateWithRetry(
+	ctx context.Context, backoff wait.Backoff) (*SubscriptionBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	glog.V(100).Infof("Updating subscription %s in namespace %s with retry on conflict",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	subscription, err := common.UpdateObjectWithRetry(ctx, builder.apiClient, backoff, builder.base, builder.Definition)
+	if err != nil {
+		return builder, err
+	}
+
+	builder.Object = subscription
+	builder.Definition = subscription.DeepCopy()
+	builder.base = subscription.DeepCopy()
+
+	return builder, nil
+}
+
 // PullSubscription loads existing Subscription from cluster into the SubscriptionBuilder struct.
 func PullSubscription(apiClient *clients.Settings, subName, subNamespace string) (*SubscriptionBuilder, error) {
 	glog.V(100).Infof("Pulling existing Subscription %s from cluster in namespace %s",
@@ -304,7 +365,7 @@ func PullSubscription(apiClient *clients.Settings, subName, subNamespace string)
 	}
 
 	builder := &SubscriptionBuilder{
-		apiClient: apiClient.Client,
+		apiClient: apiClient,
 		Definition: &operatorsV1alpha1.Subscription{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      subName,
@@ -330,7 +391,8 @@ func PullSubscription(apiClient *clients.Settings, subName, subNamespace string)
 			"subscription object named %s does not exist in namespace %s", subName, subNamespace)
 	}
 
-	builder.Definition = builder.Object
+	builder.Definition = builder.Object.DeepCopy()
+	builder.base = builder.Object.DeepCopy()
 
 	return builder, nil
 }
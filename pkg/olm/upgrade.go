@@ -0,0 +1,323 @@
+package olm
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	operatorsV1alpha1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/olm/operators/v1alpha1"
+	"github.com/openshift-kni/eco-goinfra/pkg/waiter"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// DefaultUpgradeStepTimeout is the time SubscriptionBuilder.Upgrade waits for each step by default.
+const DefaultUpgradeStepTimeout = 10 * time.Minute
+
+// UpgradeOptions configures SubscriptionBuilder.Upgrade.
+type UpgradeOptions struct {
+	// TargetCSV is the ClusterServiceVersion to upgrade to. Defaults to the head of the Subscription channel.
+	TargetCSV string
+	// Jump allows an InstallPlan to skip intermediate ClusterServiceVersions of the upgrade path, as OLM does for
+	// bundles declaring skips. By default, each InstallPlan must install the next ClusterServiceVersion of the path.
+	Jump bool
+	// StepTimeout bounds the duration of each step. Defaults to DefaultUpgradeStepTimeout.
+	StepTimeout time.Duration
+}
+
+// UpgradeStep is a step of an upgrade, from the approval of an InstallPlan to its ClusterServiceVersion succeeding.
+type UpgradeStep struct {
+	// From and To are the ClusterServiceVersions before and after the step.
+	From string
+	To   string
+	// Version is the version of the To bundle, if known.
+	Version string
+	// InstallPlan is the name of the InstallPlan approved in this step.
+	InstallPlan string
+	// Start is when the step started and Duration how long it took, until it failed if it did.
+	Start    time.Time
+	Duration time.Duration
+	// FailedConditions are the conditions of the InstallPlan that are not true when it failed.
+	FailedConditions []operatorsV1alpha1.InstallPlanCondition
+	// Err is the error that ended the step, nil if it succeeded.
+	Err error
+}
+
+// UpgradeReport is the timeline of an upgrade performed by SubscriptionBuilder.Upgrade.
+type UpgradeReport struct {
+	// Subscription and Channel identify the upgraded Subscription.
+	Subscription string
+	Channel      string
+	// StartCSV and TargetCSV are the ClusterServiceVersions the upgrade started from and went to.
+	StartCSV  string
+	TargetCSV string
+	// Path is the upgrade path from StartCSV to TargetCSV, excluding StartCSV.
+	Path []ChannelVersion
+	// Steps are the steps performed, in order. The last one holds the error if the upgrade failed.
+	Steps []UpgradeStep
+	// Duration is the duration of the whole upgrade.
+	Duration time.Duration
+}
+
+// String returns the timeline of the upgrade, one step per line.
+func (report *UpgradeReport) String() string {
+	if report == nil {
+		return ""
+	}
+
+	lines := []string{fmt.Sprintf("upgrade of subscription %s in channel %s from %s to %s took %s",
+		report.Subscription, report.Channel, report.StartCSV, report.TargetCSV, report.Duration.Round(time.Second))}
+
+	for _, step := range report.Steps {
+		line := fmt.Sprintf("%s %s -> %s (installplan %s) in %s", step.Start.Format(time.RFC3339),
+			step.From, step.To, step.InstallPlan, step.Duration.Round(time.Second))
+
+		if step.Err != nil {
+			line += fmt.Sprintf(": %v", step.Err)
+		}
+
+		for _, condition := range step.FailedConditions {
+			line += fmt.Sprintf("; %s=%s %s: %s", condition.Type, condition.Status, condition.Reason, condition.Message)
+		}
+
+		lines = append(lines, line)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// Upgrade moves the Subscription from its installed ClusterServiceVersion to options.TargetCSV along its channel. The
+// upgrade path is computed from the PackageManifest of the Subscription catalog. Each InstallPlan is approved one at a
+// time and its ClusterServiceVersion must succeed before the next one is approved. The Subscription must have a Manual
+// InstallPlan approval, so that OLM does not upgrade past the target. The returned report holds the timeline of the
+// upgrade, also when it fails.
+func (builder *SubscriptionBuilder) Upgrade(options UpgradeOptions) (*UpgradeReport, error) {
+	return builder.UpgradeWithContext(context.Background(), options)
+}
+
+// UpgradeWithContext upgrades the Subscription like Upgrade until the upgrade is done, a step times out or ctx is done.
+//
+//nolint:funlen
+func (builder *SubscriptionBuilder) UpgradeWithContext(
+	ctx context.Context, options UpgradeOptions) (*UpgradeReport, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	if options.StepTimeout <= 0 {
+		options.StepTimeout = DefaultUpgradeStepTimeout
+	}
+
+	subscription, err := builder.GetWithContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get subscription %s in namespace %s: %w",
+			builder.Definition.Name, builder.Definition.Namespace, err)
+	}
+
+	builder.Object = subscription
+
+	if subscription.Spec.InstallPlanApproval != operatorsV1alpha1.ApprovalManual {
+		return nil, fmt.Errorf("subscription %s must have a Manual installPlanApproval to be upgraded step by step",
+			subscription.Name)
+	}
+
+	if subscription.Status.InstalledCSV == "" {
+		return nil, fmt.Errorf("subscription %s has no installed clusterserviceversion to upgrade from",
+			subscription.Name)
+	}
+
+	packageManifest, err := PullPackageManifestByCatalogWithContext(ctx, builder.apiClient,
+		subscription.Spec.Package, subscription.Spec.CatalogSourceNamespace, subscription.Spec.CatalogSource)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get packagemanifest of subscription %s: %w", subscription.Name, err)
+	}
+
+	path, err := packageManifest.GetUpgradePathWithContext(ctx,
+		subscription.Spec.Channel, subscription.Status.InstalledCSV, options.TargetCSV)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &UpgradeReport{
+		Subscription: subscription.Name,
+		Channel:      subscription.Spec.Channel,
+		StartCSV:     subscription.Status.InstalledCSV,
+		TargetCSV:    subscription.Status.InstalledCSV,
+		Path:         path,
+	}
+
+	if len(path) > 0 {
+		report.TargetCSV = path[len(path)-1].Name
+	}
+
+	glog.V(100).Infof("Upgrading subscription %s from %s to %s through %d steps",
+		subscription.Name, report.StartCSV, report.TargetCSV, len(path))
+
+	start := time.Now()
+	current := report.StartCSV
+
+	for current != report.TargetCSV {
+		step := builder.upgradeStep(ctx, options, current, path)
+		report.Steps = append(report.Steps, step)
+		report.Duration = time.Since(start)
+
+		if step.Err != nil {
+			return report, fmt.Errorf("failed to upgrade subscription %s from %s: %w", subscription.Name, current, step.Err)
+		}
+
+		current = step.To
+	}
+
+	report.Duration = time.Since(start)
+
+	return report, nil
+}
+
+// upgradeStep approves the InstallPlan upgrading the Subscription from the current ClusterServiceVersion and waits
+// for the new ClusterServiceVersion to succeed. The new ClusterServiceVersion must be the next of path, or any later
+// one if options.Jump is set.
+func (builder *SubscriptionBuilder) upgradeStep(
+	ctx context.Context, options UpgradeOptions, current string, path []ChannelVersion) (step UpgradeStep) {
+	step = UpgradeStep{From: current, Start: time.Now()}
+
+	ctx, cancel := context.WithTimeout(ctx, options.StepTimeout)
+	defer cancel()
+
+	defer func() {
+		step.Duration = time.Since(step.Start)
+	}()
+
+	subscription, err := builder.waitForSubscription(ctx,
+		fmt.Sprintf("to have an installplan upgrading from %s", current),
+		func(subscription *operatorsV1alpha1.Subscription) (bool, error) {
+			return subscription.Status.CurrentCSV != current && subscription.Status.InstallPlanRef != nil, nil
+		})
+	if err != nil {
+		step.Err = err
+
+		return step
+	}
+
+	step.InstallPlan = subscription.Status.InstallPlanRef.Name
+
+	installPlan, err := PullInstallPlanWithContext(ctx, builder.apiClient, step.InstallPlan, builder.Definition.Namespace)
+	if err != nil {
+		step.Err = err
+
+		return step
+	}
+
+	nextIndex := slices.IndexFunc(path, func(version ChannelVersion) bool {
+		return slices.Contains(installPlan.Object.Spec.ClusterServiceVersionNames, version.Name)
+	})
+
+	currentIndex := slices.IndexFunc(path, func(version ChannelVersion) bool { return version.Name == current })
+
+	switch {
+	case nextIndex < 0:
+		step.Err = fmt.Errorf("installplan %s installs %v which is not in the upgrade path",
+			step.InstallPlan, installPlan.Object.Spec.ClusterServiceVersionNames)
+	case nextIndex <= currentIndex:
+		step.Err = fmt.Errorf("installplan %s does not upgrade from %s", step.InstallPlan, current)
+	case !options.Jump && nextIndex != currentIndex+1:
+		step.Err = fmt.Errorf("installplan %s installs %s instead of the next version %s",
+			step.InstallPlan, path[nextIndex].Name, path[currentIndex+1].Name)
+	}
+
+	if step.Err != nil {
+		return step
+	}
+
+	step.To = path[nextIndex].Name
+	step.Version = path[nextIndex].Version
+
+	if !installPlan.Object.Spec.Approved {
+		glog.V(100).Infof("Approving installplan %s upgrading subscription %s to %s",
+			step.InstallPlan, builder.Definition.Name, step.To)
+
+		installPlan.Definition.Spec.Approved = true
+
+		if _, err := installPlan.UpdateWithContext(ctx); err != nil {
+			step.Err = fmt.Errorf("failed to approve installplan %s: %w", step.InstallPlan, err)
+
+			return step
+		}
+	}
+
+	step.FailedConditions, step.Err = builder.waitForInstallPlan(ctx, step.InstallPlan)
+	if step.Err != nil {
+		return step
+	}
+
+	_, step.Err = waitForClusterServiceVersion(ctx, builder.apiClient, step.To, builder.Definition.Namespace)
+	if step.Err != nil {
+		return step
+	}
+
+	_, step.Err = builder.waitForSubscription(ctx, fmt.Sprintf("to have %s installed", step.To),
+		func(subscription *operatorsV1alpha1.Subscription) (bool, error) {
+			return subscription.Status.InstalledCSV == step.To, nil
+		})
+
+	return step
+}
+
+// waitForSubscription waits until the Subscription satisfies condition and updates the builder object.
+func (builder *SubscriptionBuilder) waitForSubscription(ctx context.Context, description string,
+	condition waiter.Condition[*operatorsV1alpha1.Subscription]) (*operatorsV1alpha1.Subscription, error) {
+	source := waiter.NewRuntimeSource[operatorsV1alpha1.Subscription](
+		builder.apiClient.Client, builder.Definition.Namespace, builder.Definition.Name)
+
+	subscription, err := waiter.For(ctx, source, waiter.Options{
+		Description: fmt.Sprintf("subscription %s in namespace %s %s",
+			builder.Definition.Name, builder.Definition.Namespace, description),
+	}, condition)
+	if err != nil {
+		return nil, err
+	}
+
+	builder.Object = subscription
+
+	return subscription, nil
+}
+
+// waitForInstallPlan waits until the InstallPlan completes. If it fails, its conditions that are not true are
+// returned with the error.
+func (builder *SubscriptionBuilder) waitForInstallPlan(
+	ctx context.Context, name string) ([]operatorsV1alpha1.InstallPlanCondition, error) {
+	source := waiter.WithEvents(
+		waiter.NewRuntimeSource[operatorsV1alpha1.InstallPlan](
+			builder.apiClient.Client, builder.Definition.Namespace, name),
+		builder.apiClient, "InstallPlan", builder.Definition.Namespace)
+
+	installPlan, matched, err := waiter.ForAny(ctx, source, waiter.Options{
+		Description: fmt.Sprintf("installplan %s in namespace %s to complete", name, builder.Definition.Namespace),
+	}, hasInstallPlanPhase(operatorsV1alpha1.InstallPlanPhaseComplete),
+		hasInstallPlanPhase(operatorsV1alpha1.InstallPlanPhaseFailed))
+	if err != nil {
+		return nil, err
+	}
+
+	if matched == 0 {
+		return nil, nil
+	}
+
+	var failedConditions []operatorsV1alpha1.InstallPlanCondition
+
+	for _, condition := range installPlan.Status.Conditions {
+		if condition.Status != corev1.ConditionTrue {
+			failedConditions = append(failedConditions, condition)
+		}
+	}
+
+	return failedConditions, fmt.Errorf("installplan %s failed: %s", name, installPlan.Status.Message)
+}
+
+// hasInstallPlanPhase returns a condition satisfied when the InstallPlan is in phase.
+func hasInstallPlanPhase(phase operatorsV1alpha1.InstallPlanPhase) waiter.Condition[*operatorsV1alpha1.InstallPlan] {
+	return func(installPlan *operatorsV1alpha1.InstallPlan) (bool, error) {
+		return installPlan.Status.Phase == phase, nil
+	}
+}
@@ -0,0 +1,228 @@
+package olm
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	operatorsV1alpha1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/olm/operators/v1alpha1"
+	pkgmanifestv1 "github.com/openshift-kni/eco-goinfra/pkg/schemes/olm/package-server/operators/v1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+//nolint:funlen
+func TestSubscriptionUpgrade(t *testing.T) {
+	testCases := []struct {
+		options       UpgradeOptions
+		approval      operatorsV1alpha1.Approval
+		nextCSVs      map[string]string
+		failedPlans   []string
+		expectedSteps []string
+		expectedError error
+	}{
+		{
+			approval:      operatorsV1alpha1.ApprovalManual,
+			nextCSVs:      map[string]string{"test.v1": "test.v2", "test.v2": "test.v3"},
+			expectedSteps: []string{"test.v2", "test.v3"},
+		},
+		{
+			options:       UpgradeOptions{TargetCSV: "test.v2"},
+			approval:      operatorsV1alpha1.ApprovalManual,
+			nextCSVs:      map[string]string{"test.v1": "test.v2", "test.v2": "test.v3"},
+			expectedSteps: []string{"test.v2"},
+		},
+		{
+			options:       UpgradeOptions{Jump: true},
+			approval:      operatorsV1alpha1.ApprovalManual,
+			nextCSVs:      map[string]string{"test.v1": "test.v3"},
+			expectedSteps: []string{"test.v3"},
+		},
+		{
+			approval:      operatorsV1alpha1.ApprovalManual,
+			nextCSVs:      map[string]string{"test.v1": "test.v3"},
+			expectedSteps: []string{""},
+			expectedError: fmt.Errorf("failed to upgrade subscription %s from test.v1: installplan plan-test.v3 installs "+
+				"test.v3 instead of the next version test.v2", defaultInstallPackage),
+		},
+		{
+			approval:      operatorsV1alpha1.ApprovalManual,
+			nextCSVs:      map[string]string{"test.v1": "test.v2", "test.v2": "test.v3"},
+			failedPlans:   []string{"plan-test.v3"},
+			expectedSteps: []string{"test.v2", "test.v3"},
+			expectedError: fmt.Errorf("failed to upgrade subscription %s from test.v2: installplan plan-test.v3 failed: "+
+				"bundle unpacking failed", defaultInstallPackage),
+		},
+		{
+			approval: operatorsV1alpha1.ApprovalAutomatic,
+			expectedError: fmt.Errorf("subscription %s must have a Manual installPlanApproval to be upgraded step by step",
+				defaultInstallPackage),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := buildUpgradeTestClient(testCase.approval, testCase.nextCSVs, testCase.failedPlans)
+
+		subscription, err := PullSubscription(testSettings, defaultInstallPackage, defaultInstallNamespace)
+		assert.Nil(t, err)
+
+		testCase.options.StepTimeout = 5 * time.Second
+		report, err := subscription.Upgrade(testCase.options)
+
+		if testCase.expectedError == nil {
+			assert.Nil(t, err)
+		} else {
+			assert.EqualError(t, err, testCase.expectedError.Error())
+		}
+
+		if testCase.expectedSteps == nil {
+			assert.Nil(t, report)
+
+			continue
+		}
+
+		assert.Equal(t, "test.v1", report.StartCSV)
+		assert.Len(t, report.Steps, len(testCase.expectedSteps))
+
+		for index, step := range report.Steps {
+			assert.Equal(t, testCase.expectedSteps[index], step.To)
+			assert.Positive(t, step.Duration)
+		}
+
+		if len(testCase.failedPlans) > 0 {
+			failedStep := report.Steps[len(report.Steps)-1]
+			assert.Len(t, failedStep.FailedConditions, 1)
+			assert.Contains(t, report.String(), "Installed=False InstallComponentFailed: bundle unpacking failed")
+		}
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, report.TargetCSV, subscription.Object.Status.InstalledCSV)
+		}
+	}
+}
+
+// buildUpgradeTestClient returns a client for a cluster where the test operator is installed at test.v1 and OLM
+// proposes upgrades to the nextCSVs of the installed one. Approved InstallPlans complete unless they are failedPlans.
+func buildUpgradeTestClient(
+	approval operatorsV1alpha1.Approval, nextCSVs map[string]string, failedPlans []string) *clients.Settings {
+	var (
+		approvedPlans = map[string]bool{}
+		mutex         sync.Mutex
+	)
+
+	runtimeObjects := []runtime.Object{
+		&pkgmanifestv1.PackageManifest{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      defaultInstallPackage,
+				Namespace: DefaultCatalogNamespace,
+				Labels:    map[string]string{"catalog": defaultInstallCatalog},
+			},
+			Status: pkgmanifestv1.PackageManifestStatus{
+				DefaultChannel: "stable",
+				Channels: []pkgmanifestv1.PackageChannel{{
+					Name:       "stable",
+					CurrentCSV: "test.v3",
+					Entries: []pkgmanifestv1.ChannelEntry{
+						{Name: "test.v3", Version: "3.0.0"},
+						{Name: "test.v2", Version: "2.0.0"},
+						{Name: "test.v1", Version: "1.0.0"}},
+				}},
+			},
+		},
+		&operatorsV1alpha1.Subscription{
+			ObjectMeta: metav1.ObjectMeta{Name: defaultInstallPackage, Namespace: defaultInstallNamespace},
+			Spec: &operatorsV1alpha1.SubscriptionSpec{
+				CatalogSource:          defaultInstallCatalog,
+				CatalogSourceNamespace: DefaultCatalogNamespace,
+				Package:                defaultInstallPackage,
+				Channel:                "stable",
+				InstallPlanApproval:    approval,
+			},
+			Status: operatorsV1alpha1.SubscriptionStatus{
+				CurrentCSV:   "test.v1",
+				InstalledCSV: "test.v1",
+			},
+		},
+	}
+
+	for _, csvName := range []string{"test.v2", "test.v3"} {
+		runtimeObjects = append(runtimeObjects,
+			&operatorsV1alpha1.InstallPlan{
+				ObjectMeta: metav1.ObjectMeta{Name: "plan-" + csvName, Namespace: defaultInstallNamespace},
+				Spec: operatorsV1alpha1.InstallPlanSpec{
+					ClusterServiceVersionNames: []string{csvName},
+					Approval:                   operatorsV1alpha1.ApprovalManual,
+				},
+			},
+			&operatorsV1alpha1.ClusterServiceVersion{
+				ObjectMeta: metav1.ObjectMeta{Name: csvName, Namespace: defaultInstallNamespace},
+				Status:     operatorsV1alpha1.ClusterServiceVersionStatus{Phase: operatorsV1alpha1.CSVPhaseSucceeded},
+			})
+	}
+
+	testSettings, builder := clients.GetModifiableTestClients(clients.TestClientParams{
+		K8sMockObjects:  runtimeObjects,
+		SchemeAttachers: installTestSchemes,
+		Simulators: []clients.ControllerSimulator{
+			{
+				Object:   &operatorsV1alpha1.InstallPlan{},
+				Triggers: []clients.SimulatorTrigger{clients.SimulatorTriggerUpdate},
+				Reconcile: func(object runtime.Object, _ int) {
+					installPlan := object.(*operatorsV1alpha1.InstallPlan)
+					if !installPlan.Spec.Approved {
+						return
+					}
+
+					mutex.Lock()
+					defer mutex.Unlock()
+
+					approvedPlans[installPlan.Name] = true
+					installPlan.Status.Phase = operatorsV1alpha1.InstallPlanPhaseComplete
+
+					for _, failedPlan := range failedPlans {
+						if failedPlan == installPlan.Name {
+							installPlan.Status.Phase = operatorsV1alpha1.InstallPlanPhaseFailed
+							installPlan.Status.Message = "bundle unpacking failed"
+							installPlan.Status.Conditions = []operatorsV1alpha1.InstallPlanCondition{{
+								Type:    operatorsV1alpha1.InstallPlanInstalled,
+								Status:  corev1.ConditionFalse,
+								Reason:  operatorsV1alpha1.InstallPlanReasonComponentFailed,
+								Message: "bundle unpacking failed",
+							}}
+						}
+					}
+				},
+			},
+			{
+				Object:   &operatorsV1alpha1.Subscription{},
+				Triggers: []clients.SimulatorTrigger{clients.SimulatorTriggerRead},
+				Reconcile: func(object runtime.Object, _ int) {
+					subscription := object.(*operatorsV1alpha1.Subscription)
+
+					mutex.Lock()
+					defer mutex.Unlock()
+
+					if subscription.Status.InstallPlanRef != nil && approvedPlans[subscription.Status.InstallPlanRef.Name] {
+						subscription.Status.InstalledCSV = subscription.Status.CurrentCSV
+					}
+
+					next, found := nextCSVs[subscription.Status.InstalledCSV]
+					if found && subscription.Status.InstalledCSV == subscription.Status.CurrentCSV {
+						subscription.Status.CurrentCSV = next
+						subscription.Status.InstallPlanRef = &corev1.ObjectReference{
+							Name: "plan-" + next, Namespace: defaultInstallNamespace}
+					}
+				},
+			},
+		},
+	})
+
+	testSettings.Client = builder.WithIndex(
+		&pkgmanifestv1.PackageManifest{}, "metadata.name", nameMetadataIndexer).Build()
+
+	return testSettings
+}
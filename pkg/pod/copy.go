@@ -0,0 +1,420 @@
+package pod
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/golang/glog"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/httpstream/spdy"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/remotecommand"
+)
+
+// CopyTo uploads the file or directory at localPath to containerPath in the container, creating or replacing it. The
+// contents are streamed as a tar archive, so directories are copied recursively and file permissions are preserved.
+// The container image must provide tar. The number of bytes of the archive sent to the container is returned.
+func (builder *Builder) CopyTo(ctx context.Context, localPath, containerPath, containerName string) (int64, error) {
+	if valid, err := builder.validate(); !valid {
+		return 0, err
+	}
+
+	if localPath == "" || containerPath == "" {
+		return 0, fmt.Errorf("pod copy source and destination paths cannot be empty")
+	}
+
+	if _, err := os.Stat(localPath); err != nil {
+		return 0, fmt.Errorf("failed to copy %s to pod %s: %w", localPath, builder.Definition.Name, err)
+	}
+
+	glog.V(100).Infof("Copying %s to %s in container %s of pod %s in namespace %s",
+		localPath, containerPath, containerName, builder.Definition.Name, builder.Definition.Namespace)
+
+	reader, writer := io.Pipe()
+
+	go func() {
+		_ = writer.CloseWithError(writeTarArchive(writer, localPath, path.Base(containerPath)))
+	}()
+
+	transferred, err := builder.CopyTarTo(ctx, reader, path.Dir(containerPath), containerName)
+
+	_ = reader.Close()
+
+	return transferred, err
+}
+
+// CopyTarTo extracts the tar archive read from archive into containerDir in the container. It allows uploading
+// content that is not on the local filesystem, such as generated scripts or certificates. The number of bytes read
+// from archive is returned.
+func (builder *Builder) CopyTarTo(
+	ctx context.Context, archive io.Reader, containerDir, containerName string) (int64, error) {
+	if valid, err := builder.validate(); !valid {
+		return 0, err
+	}
+
+	if archive == nil {
+		return 0, fmt.Errorf("pod copy archive cannot be nil")
+	}
+
+	if containerDir == "" {
+		return 0, fmt.Errorf("pod copy source and destination paths cannot be empty")
+	}
+
+	counter := &countingReader{reader: archive}
+	err := builder.streamTransfer(
+		ctx, []string{"tar", "xmf", "-", "-C", containerDir}, containerName, counter, io.Discard)
+
+	return counter.count, err
+}
+
+// CopyFrom downloads the file or directory at containerPath in the container to localPath, creating or replacing it.
+// The contents are streamed as a tar archive, so directories are copied recursively and file permissions are
+// preserved. The container image must provide tar. The number of bytes of the archive received from the container
+// is returned.
+func (builder *Builder) CopyFrom(ctx context.Context, containerPath, localPath, containerName string) (int64, error) {
+	if valid, err := builder.validate(); !valid {
+		return 0, err
+	}
+
+	if localPath == "" || containerPath == "" {
+		return 0, fmt.Errorf("pod copy source and destination paths cannot be empty")
+	}
+
+	glog.V(100).Infof("Copying %s in container %s of pod %s in namespace %s to %s",
+		containerPath, containerName, builder.Definition.Name, builder.Definition.Namespace, localPath)
+
+	reader, writer := io.Pipe()
+	extracted := make(chan error, 1)
+
+	go func() {
+		err := extractTarArchive(reader, localPath, path.Base(containerPath))
+		if err == nil {
+			// Consume the padding following the end of the archive so the stream can complete.
+			_, err = io.Copy(io.Discard, reader)
+		}
+
+		_ = reader.CloseWithError(err)
+		extracted <- err
+	}()
+
+	transferred, err := builder.CopyTarFrom(ctx, containerPath, writer, containerName)
+	_ = writer.CloseWithError(err)
+
+	// Either side failing closes the pipe with its error, so the other side may only report the same error again.
+	extractErr := <-extracted
+
+	switch {
+	case extractErr == nil || errors.Is(extractErr, err):
+		return transferred, err
+	case err == nil || errors.Is(err, extractErr):
+		return transferred, fmt.Errorf("failed to extract %s to %s: %w", containerPath, localPath, extractErr)
+	default:
+		return transferred, errors.Join(
+			err, fmt.Errorf("failed to extract %s to %s: %w", containerPath, localPath, extractErr))
+	}
+}
+
+// CopyTarFrom writes a tar archive of the file or directory at containerPath in the container to archive. Entries of
+// the archive are relative to the parent directory of containerPath. The number of bytes written to archive is
+// returned.
+func (builder *Builder) CopyTarFrom(
+	ctx context.Context, containerPath string, archive io.Writer, containerName string) (int64, error) {
+	if valid, err := builder.validate(); !valid {
+		return 0, err
+	}
+
+	if archive == nil {
+		return 0, fmt.Errorf("pod copy archive cannot be nil")
+	}
+
+	if containerPath == "" {
+		return 0, fmt.Errorf("pod copy source and destination paths cannot be empty")
+	}
+
+	counter := &countingWriter{writer: archive}
+	err := builder.streamTransfer(
+		ctx, []string{"tar", "cf", "-", "-C", path.Dir(containerPath), path.Base(containerPath)},
+		containerName, nil, counter)
+
+	return counter.count, err
+}
+
+// streamTransfer runs command in the container with the executor tuned for large transfers, streaming stdin to the
+// command and its standard output to stdout.
+func (builder *Builder) streamTransfer(
+	ctx context.Context, command []string, containerName string, stdin io.Reader, stdout io.Writer) error {
+	pod, err := builder.apiClient.Pods(builder.Definition.Namespace).Get(
+		ctx, builder.Definition.Name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get pod %s in namespace %s to copy files: %w",
+			builder.Definition.Name, builder.Definition.Namespace, err)
+	}
+
+	builder.Object = pod
+
+	containerName, err = getExecContainerName(pod, containerName)
+	if err != nil {
+		return err
+	}
+
+	req := builder.apiClient.CoreV1Interface.RESTClient().
+		Post().
+		Namespace(pod.Namespace).
+		Resource("pods").
+		Name(pod.Name).
+		SubResource("exec").
+		VersionedParams(&corev1.PodExecOptions{
+			Container: containerName,
+			Command:   command,
+			Stdin:     stdin != nil,
+			Stdout:    true,
+			Stderr:    true,
+			TTY:       false,
+		}, scheme.ParameterCodec)
+
+	exec, err := builder.newTransferExecutor(req.URL())
+	if err != nil {
+		return err
+	}
+
+	var stderr bytes.Buffer
+
+	err = exec.StreamWithContext(ctx, remotecommand.StreamOptions{
+		Stdin:  stdin,
+		Stdout: stdout,
+		Stderr: &stderr,
+		Tty:    false,
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to run %v in pod %s in namespace %s: %w: %s",
+			command, pod.Name, pod.Namespace, err, strings.TrimSpace(stderr.String()))
+	}
+
+	return nil
+}
+
+// newTransferExecutor returns a remote command executor for requestURL suited to transferring large amounts of data.
+func (builder *Builder) newTransferExecutor(requestURL *url.URL) (remotecommand.Executor, error) {
+	tlsConfig, err := rest.TLSConfigFor(builder.apiClient.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	proxy := http.ProxyFromEnvironment
+	if builder.apiClient.Config.Proxy != nil {
+		proxy = builder.apiClient.Config.Proxy
+	}
+
+	// More verbose setup of remotecommand executor required in order to tweak PingPeriod.
+	// By default many large files are not copied in their entirety without disabling PingPeriod during the copy.
+	// https://github.com/kubernetes/kubernetes/issues/60140#issuecomment-1411477275
+	upgradeRoundTripper, err := spdy.NewRoundTripperWithConfig(spdy.RoundTripperConfig{
+		TLS:        tlsConfig,
+		Proxier:    proxy,
+		PingPeriod: 0,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	wrapper, err := rest.HTTPWrappersForConfig(builder.apiClient.Config, upgradeRoundTripper)
+	if err != nil {
+		return nil, err
+	}
+
+	return remotecommand.NewSPDYExecutorForTransports(wrapper, upgradeRoundTripper, "POST", requestURL)
+}
+
+// writeTarArchive writes a tar archive of the file or directory at localPath to writer, naming the root entry name.
+func writeTarArchive(writer io.Writer, localPath, name string) error {
+	tarWriter := tar.NewWriter(writer)
+
+	err := filepath.Walk(localPath, func(filePath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		relativePath, err := filepath.Rel(localPath, filePath)
+		if err != nil {
+			return err
+		}
+
+		var link string
+
+		if info.Mode()&os.ModeSymlink != 0 {
+			if link, err = os.Readlink(filePath); err != nil {
+				return err
+			}
+		}
+
+		header, err := tar.FileInfoHeader(info, link)
+		if err != nil {
+			return err
+		}
+
+		header.Name = path.Join(name, filepath.ToSlash(relativePath))
+
+		if err = tarWriter.WriteHeader(header); err != nil {
+			return err
+		}
+
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		file, err := os.Open(filePath)
+		if err != nil {
+			return err
+		}
+
+		defer file.Close()
+
+		_, err = io.Copy(tarWriter, file)
+
+		return err
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return tarWriter.Close()
+}
+
+// extractTarArchive extracts the tar archive read from reader to localPath, mapping the root entry name to localPath.
+// Entries outside of name, as well as links pointing outside of localPath, are rejected.
+func extractTarArchive(reader io.Reader, localPath, name string) error {
+	tarReader := tar.NewReader(reader)
+
+	for {
+		header, err := tarReader.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		entryPath, err := getExtractPath(localPath, name, header.Name)
+		if err != nil {
+			return err
+		}
+
+		mode := os.FileMode(header.Mode).Perm()
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err = os.MkdirAll(entryPath, mode|0o700); err != nil {
+				return err
+			}
+
+			if err = os.Chmod(entryPath, mode); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err = extractTarFile(tarReader, entryPath, mode); err != nil {
+				return err
+			}
+		case tar.TypeSymlink:
+			target := filepath.Join(filepath.Dir(entryPath), header.Linkname)
+			if filepath.IsAbs(header.Linkname) || !isWithin(localPath, target) {
+				glog.V(100).Infof("Skipping link %s pointing outside of %s", header.Name, localPath)
+
+				continue
+			}
+
+			if err = os.Symlink(header.Linkname, entryPath); err != nil {
+				return err
+			}
+		default:
+			glog.V(100).Infof("Skipping unsupported tar entry %s of type %c", header.Name, header.Typeflag)
+		}
+	}
+}
+
+// extractTarFile writes the current entry of tarReader to filePath with the permissions in mode.
+func extractTarFile(tarReader *tar.Reader, filePath string, mode os.FileMode) error {
+	if err := os.MkdirAll(filepath.Dir(filePath), 0o755); err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(filePath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, mode)
+	if err != nil {
+		return err
+	}
+
+	if _, err = io.Copy(file, tarReader); err != nil {
+		_ = file.Close()
+
+		return err
+	}
+
+	if err = file.Close(); err != nil {
+		return err
+	}
+
+	return os.Chmod(filePath, mode)
+}
+
+// getExtractPath returns the local path of the archive entry entryName, whose root entry name maps to localPath.
+func getExtractPath(localPath, name, entryName string) (string, error) {
+	cleanName := path.Clean(strings.TrimPrefix(entryName, "/"))
+	if cleanName != name && !strings.HasPrefix(cleanName, name+"/") {
+		return "", fmt.Errorf("unexpected tar entry %s outside of %s", entryName, name)
+	}
+
+	entryPath := filepath.Join(localPath, filepath.FromSlash(strings.TrimPrefix(cleanName, name)))
+	if !isWithin(localPath, entryPath) {
+		return "", fmt.Errorf("unexpected tar entry %s outside of %s", entryName, name)
+	}
+
+	return entryPath, nil
+}
+
+// isWithin returns true if target is root or is inside root.
+func isWithin(root, target string) bool {
+	relativePath, err := filepath.Rel(filepath.Clean(root), filepath.Clean(target))
+
+	return err == nil && relativePath != ".." && !strings.HasPrefix(relativePath, "../")
+}
+
+// countingReader counts the bytes read from reader.
+type countingReader struct {
+	reader io.Reader
+	count  int64
+}
+
+func (counter *countingReader) Read(data []byte) (int, error) {
+	read, err := counter.reader.Read(data)
+	counter.count += int64(read)
+
+	return read, err
+}
+
+// countingWriter counts the bytes written to writer.
+type countingWriter struct {
+	writer io.Writer
+	count  int64
+}
+
+func (counter *countingWriter) Write(data []byte) (int, error) {
+	written, err := counter.writer.Write(data)
+	counter.count += int64(written)
+
+	return written, err
+}
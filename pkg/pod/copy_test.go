@@ -0,0 +1,149 @@
+package pod
+
+import (
+	"archive/tar"
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPodCopyTo(t *testing.T) {
+	testCases := []struct {
+		testBuilder   *Builder
+		localPath     string
+		containerPath string
+		expectedError string
+	}{
+		{
+			testBuilder:   buildValidPodTestBuilder(buildTestClientWithDummyPod()),
+			localPath:     "",
+			containerPath: "/tmp/test",
+			expectedError: "pod copy source and destination paths cannot be empty",
+		},
+		{
+			testBuilder:   buildValidPodTestBuilder(buildTestClientWithDummyPod()),
+			localPath:     "/does/not/exist",
+			containerPath: "/tmp/test",
+			expectedError: fmt.Sprintf(
+				"failed to copy /does/not/exist to pod %s: stat /does/not/exist: no such file or directory", defaultPodName),
+		},
+		{
+			testBuilder:   buildInvalidPodTestBuilder(buildTestClientWithDummyPod()),
+			localPath:     "/tmp",
+			containerPath: "/tmp/test",
+			expectedError: "pod 'namespace' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		transferred, err := testCase.testBuilder.CopyTo(
+			t.Context(), testCase.localPath, testCase.containerPath, "")
+
+		assert.EqualError(t, err, testCase.expectedError)
+		assert.Equal(t, int64(0), transferred)
+	}
+}
+
+func TestPodCopyFrom(t *testing.T) {
+	testCases := []struct {
+		testBuilder   *Builder
+		localPath     string
+		containerPath string
+		expectedError string
+	}{
+		{
+			testBuilder:   buildValidPodTestBuilder(buildTestClientWithDummyPod()),
+			localPath:     "/tmp/test",
+			containerPath: "",
+			expectedError: "pod copy source and destination paths cannot be empty",
+		},
+		{
+			testBuilder:   buildInvalidPodTestBuilder(buildTestClientWithDummyPod()),
+			localPath:     "/tmp/test",
+			containerPath: "/tmp/test",
+			expectedError: "pod 'namespace' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		transferred, err := testCase.testBuilder.CopyFrom(
+			t.Context(), testCase.containerPath, testCase.localPath, "")
+
+		assert.EqualError(t, err, testCase.expectedError)
+		assert.Equal(t, int64(0), transferred)
+	}
+}
+
+func TestPodTarArchiveRoundTrip(t *testing.T) {
+	sourceDir := filepath.Join(t.TempDir(), "source")
+
+	assert.Nil(t, os.MkdirAll(filepath.Join(sourceDir, "scripts"), 0o755))
+	assert.Nil(t, os.WriteFile(filepath.Join(sourceDir, "scripts", "run.sh"), []byte("#!/bin/sh\n"), 0o755))
+	assert.Nil(t, os.WriteFile(filepath.Join(sourceDir, "tls.key"), []byte("key"), 0o600))
+	assert.Nil(t, os.Symlink("tls.key", filepath.Join(sourceDir, "current.key")))
+
+	var archive bytes.Buffer
+
+	err := writeTarArchive(&archive, sourceDir, "payload")
+	assert.Nil(t, err)
+
+	destinationDir := filepath.Join(t.TempDir(), "destination")
+
+	err = extractTarArchive(&archive, destinationDir, "payload")
+	assert.Nil(t, err)
+
+	script, err := os.Stat(filepath.Join(destinationDir, "scripts", "run.sh"))
+	assert.Nil(t, err)
+	assert.Equal(t, os.FileMode(0o755), script.Mode().Perm())
+
+	key, err := os.Stat(filepath.Join(destinationDir, "tls.key"))
+	assert.Nil(t, err)
+	assert.Equal(t, os.FileMode(0o600), key.Mode().Perm())
+
+	content, err := os.ReadFile(filepath.Join(destinationDir, "current.key"))
+	assert.Nil(t, err)
+	assert.Equal(t, "key", string(content))
+}
+
+func TestPodExtractTarArchiveUnsafeEntries(t *testing.T) {
+	testCases := []struct {
+		header        tar.Header
+		expectedError string
+	}{
+		{
+			header:        tar.Header{Name: "payload/../../escape", Typeflag: tar.TypeReg, Mode: 0o644},
+			expectedError: "unexpected tar entry payload/../../escape outside of payload",
+		},
+		{
+			header:        tar.Header{Name: "other/file", Typeflag: tar.TypeReg, Mode: 0o644},
+			expectedError: "unexpected tar entry other/file outside of payload",
+		},
+		{
+			header: tar.Header{Name: "payload/link", Typeflag: tar.TypeSymlink, Linkname: "../../etc/passwd"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		var archive bytes.Buffer
+
+		tarWriter := tar.NewWriter(&archive)
+		assert.Nil(t, tarWriter.WriteHeader(&testCase.header))
+		assert.Nil(t, tarWriter.Close())
+
+		destinationDir := t.TempDir()
+		err := extractTarArchive(&archive, destinationDir, "payload")
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+		} else {
+			assert.Nil(t, err)
+
+			_, err = os.Lstat(filepath.Join(destinationDir, "link"))
+			assert.True(t, os.IsNotExist(err))
+		}
+	}
+}
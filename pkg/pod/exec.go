@@ -0,0 +1,166 @@
+package pod
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/golang/glog"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/remotecommand"
+	utilexec "k8s.io/client-go/util/exec"
+)
+
+// ExecOptions configures a command executed in a pod container.
+type ExecOptions struct {
+	// Container is the name of the container running the command. Defaults to the first container of the pod.
+	Container string
+	// Stdin is streamed to the standard input of the command when set.
+	Stdin io.Reader
+	// TTY allocates a terminal for the command. A terminal merges the standard error into the standard output.
+	TTY bool
+	// Timeout limits the duration of the command when set.
+	Timeout time.Duration
+}
+
+// ExecResult contains the outputs and the exit code of a command executed in a pod container.
+type ExecResult struct {
+	Stdout   bytes.Buffer
+	Stderr   bytes.Buffer
+	ExitCode int
+}
+
+// ExecCommandWithOptions runs command in the pod according to options and returns its standard output, standard error
+// and exit code. A command exiting with a non-zero code is not an error: the code is reported in the result. Errors
+// are only returned when the command cannot be run to completion.
+func (builder *Builder) ExecCommandWithOptions(
+	ctx context.Context, command []string, options ExecOptions) (*ExecResult, error) {
+	result := &ExecResult{}
+
+	exitCode, err := builder.ExecCommandStream(ctx, command, options, &result.Stdout, &result.Stderr)
+	if err != nil {
+		return result, err
+	}
+
+	result.ExitCode = exitCode
+
+	return result, nil
+}
+
+// ExecCommandStream runs command in the pod according to options, streaming its standard output and standard error
+// to stdout and stderr as they are produced, and returns its exit code. Either writer may be nil to discard the
+// corresponding output. It is intended for long-running commands such as tcpdump, whose output can be processed
+// before the command completes. Cancelling ctx terminates the stream.
+func (builder *Builder) ExecCommandStream(
+	ctx context.Context, command []string, options ExecOptions, stdout, stderr io.Writer) (int, error) {
+	if valid, err := builder.validate(); !valid {
+		return 0, err
+	}
+
+	if len(command) == 0 {
+		glog.V(100).Infof("The command to execute in pod %s in namespace %s is empty",
+			builder.Definition.Name, builder.Definition.Namespace)
+
+		return 0, fmt.Errorf("pod exec command cannot be empty")
+	}
+
+	pod, err := builder.apiClient.Pods(builder.Definition.Namespace).Get(
+		ctx, builder.Definition.Name, metav1.GetOptions{})
+	if err != nil {
+		return 0, fmt.Errorf("failed to get pod %s in namespace %s to execute command: %w",
+			builder.Definition.Name, builder.Definition.Namespace, err)
+	}
+
+	builder.Object = pod
+
+	containerName, err := getExecContainerName(pod, options.Container)
+	if err != nil {
+		return 0, err
+	}
+
+	glog.V(100).Infof("Execute command %v in the pod %s container %s in namespace %s with tty %t",
+		command, pod.Name, containerName, pod.Namespace, options.TTY)
+
+	if options.Timeout > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, options.Timeout)
+		defer cancel()
+	}
+
+	if builder.apiClient.Config == nil {
+		return 0, fmt.Errorf("pod exec requires an apiClient with a rest config")
+	}
+
+	req := builder.apiClient.CoreV1Interface.RESTClient().
+		Post().
+		Namespace(pod.Namespace).
+		Resource("pods").
+		Name(pod.Name).
+		SubResource("exec").
+		VersionedParams(&corev1.PodExecOptions{
+			Container: containerName,
+			Command:   command,
+			Stdin:     options.Stdin != nil,
+			Stdout:    stdout != nil,
+			Stderr:    stderr != nil && !options.TTY,
+			TTY:       options.TTY,
+		}, scheme.ParameterCodec)
+
+	exec, err := remotecommand.NewSPDYExecutor(builder.apiClient.Config, "POST", req.URL())
+	if err != nil {
+		return 0, err
+	}
+
+	streamOptions := remotecommand.StreamOptions{
+		Stdin:  options.Stdin,
+		Stdout: stdout,
+		Tty:    options.TTY,
+	}
+
+	if !options.TTY {
+		streamOptions.Stderr = stderr
+	}
+
+	return getExitCode(exec.StreamWithContext(ctx, streamOptions))
+}
+
+// getExecContainerName returns the name of the container to execute commands in, defaulting to the first container
+// of the pod when containerName is empty.
+func getExecContainerName(pod *corev1.Pod, containerName string) (string, error) {
+	if len(pod.Spec.Containers) == 0 {
+		return "", fmt.Errorf("pod %s in namespace %s has no containers", pod.Name, pod.Namespace)
+	}
+
+	if containerName == "" {
+		return pod.Spec.Containers[0].Name, nil
+	}
+
+	for _, container := range pod.Spec.Containers {
+		if container.Name == containerName {
+			return containerName, nil
+		}
+	}
+
+	return "", fmt.Errorf("container %s not found in pod %s in namespace %s", containerName, pod.Name, pod.Namespace)
+}
+
+// getExitCode converts the error returned by a remote command stream into the exit code of the command. Errors other
+// than a non-zero exit code are returned as is.
+func getExitCode(err error) (int, error) {
+	if err == nil {
+		return 0, nil
+	}
+
+	var exitError utilexec.ExitError
+	if errors.As(err, &exitError) && exitError.Exited() {
+		return exitError.ExitStatus(), nil
+	}
+
+	return 0, err
+}
@@ -0,0 +1,124 @@
+package pod
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	utilexec "k8s.io/client-go/util/exec"
+)
+
+func TestPodExecCommandWithOptions(t *testing.T) {
+	testCases := []struct {
+		testBuilder   *Builder
+		command       []string
+		container     string
+		expectedError string
+	}{
+		{
+			testBuilder:   buildValidPodTestBuilder(buildTestClientWithDummyPod()),
+			command:       nil,
+			expectedError: "pod exec command cannot be empty",
+		},
+		{
+			testBuilder:   buildInvalidPodTestBuilder(buildTestClientWithDummyPod()),
+			command:       []string{"true"},
+			expectedError: "pod 'namespace' cannot be empty",
+		},
+		{
+			testBuilder: buildValidPodTestBuilder(clients.GetTestClients(clients.TestClientParams{})),
+			command:     []string{"true"},
+			expectedError: fmt.Sprintf(
+				"failed to get pod %s in namespace %s to execute command: pods \"%s\" not found",
+				defaultPodName, defaultPodNsName, defaultPodName),
+		},
+		{
+			testBuilder: buildValidPodTestBuilder(buildTestClientWithDummyPod()),
+			command:     []string{"true"},
+			container:   "missing",
+			expectedError: fmt.Sprintf(
+				"container missing not found in pod %s in namespace %s", defaultPodName, defaultPodNsName),
+		},
+	}
+
+	for _, testCase := range testCases {
+		result, err := testCase.testBuilder.ExecCommandWithOptions(
+			t.Context(), testCase.command, ExecOptions{Container: testCase.container})
+
+		assert.EqualError(t, err, testCase.expectedError)
+		assert.NotNil(t, result)
+	}
+}
+
+func TestPodGetExecContainerName(t *testing.T) {
+	testCases := []struct {
+		containers    []corev1.Container
+		containerName string
+		expectedName  string
+		expectedError string
+	}{
+		{
+			containers:   []corev1.Container{{Name: "first"}, {Name: "second"}},
+			expectedName: "first",
+		},
+		{
+			containers:    []corev1.Container{{Name: "first"}, {Name: "second"}},
+			containerName: "second",
+			expectedName:  "second",
+		},
+		{
+			containers:    nil,
+			expectedError: fmt.Sprintf("pod %s in namespace %s has no containers", defaultPodName, defaultPodNsName),
+		},
+	}
+
+	for _, testCase := range testCases {
+		pod := buildDummyPod(defaultPodName, defaultPodNsName, defaultPodImage)
+		pod.Spec.Containers = testCase.containers
+
+		name, err := getExecContainerName(pod, testCase.containerName)
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+		} else {
+			assert.Nil(t, err)
+			assert.Equal(t, testCase.expectedName, name)
+		}
+	}
+}
+
+func TestPodGetExitCode(t *testing.T) {
+	testCases := []struct {
+		err              error
+		expectedExitCode int
+		expectedError    error
+	}{
+		{
+			err:              nil,
+			expectedExitCode: 0,
+		},
+		{
+			err:              utilexec.CodeExitError{Err: fmt.Errorf("command terminated with exit code 2"), Code: 2},
+			expectedExitCode: 2,
+		},
+		{
+			err: fmt.Errorf("stream failed: %w",
+				utilexec.CodeExitError{Err: fmt.Errorf("command terminated with exit code 3"), Code: 3}),
+			expectedExitCode: 3,
+		},
+		{
+			err:           context.DeadlineExceeded,
+			expectedError: context.DeadlineExceeded,
+		},
+	}
+
+	for _, testCase := range testCases {
+		exitCode, err := getExitCode(testCase.err)
+
+		assert.Equal(t, testCase.expectedError, err)
+		assert.Equal(t, testCase.expectedExitCode, exitCode)
+	}
+}
@@ -15,6 +15,12 @@ import (
 
 // List returns pod inventory in the given namespace.
 func List(apiClient *clients.Settings, nsname string, options ...metav1.ListOptions) ([]*Builder, error) {
+	return ListWithContext(context.Background(), apiClient, nsname, options...)
+}
+
+// ListWithContext returns pod inventory in the given namespace using the provided context.
+func ListWithContext(
+	ctx context.Context, apiClient *clients.Settings, nsname string, options ...metav1.ListOptions) ([]*Builder, error) {
 	if apiClient == nil {
 		glog.V(100).Infof("The apiClient is empty")
 
@@ -41,7 +47,7 @@ func List(apiClient *clients.Settings, nsname string, options ...metav1.ListOpti
 
 	glog.V(100).Infof(logMessage)
 
-	podList, err := apiClient.Pods(nsname).List(context.TODO(), passedOptions)
+	podList, err := apiClient.Pods(nsname).List(ctx, passedOptions)
 
 	if err != nil {
 		glog.V(100).Infof("Failed to list pods in the nsname %s due to %s", nsname, err.Error())
@@ -67,6 +73,12 @@ func List(apiClient *clients.Settings, nsname string, options ...metav1.ListOpti
 
 // ListInAllNamespaces returns a cluster-wide pod inventory.
 func ListInAllNamespaces(apiClient *clients.Settings, options ...metav1.ListOptions) ([]*Builder, error) {
+	return ListInAllNamespacesWithContext(context.Background(), apiClient, options...)
+}
+
+// ListInAllNamespacesWithContext returns a cluster-wide pod inventory using the provided context.
+func ListInAllNamespacesWithContext(
+	ctx context.Context, apiClient *clients.Settings, options ...metav1.ListOptions) ([]*Builder, error) {
 	logMessage := "Listing all pods in all namespaces"
 	passedOptions := metav1.ListOptions{}
 
@@ -89,7 +101,7 @@ func ListInAllNamespaces(apiClient *clients.Settings, options ...metav1.ListOpti
 
 	glog.V(100).Infof(logMessage)
 
-	podList, err := apiClient.Pods("").List(context.TODO(), passedOptions)
+	podList, err := apiClient.Pods("").List(ctx, passedOptions)
 
 	if err != nil {
 		glog.V(100).Infof("Failed to list all pods due to %s", err.Error())
@@ -115,6 +127,13 @@ func ListInAllNamespaces(apiClient *clients.Settings, options ...metav1.ListOpti
 
 // ListByNamePattern returns pod inventory in the given namespace filtered by name pattern.
 func ListByNamePattern(apiClient *clients.Settings, namePattern, nsname string) ([]*Builder, error) {
+	return ListByNamePatternWithContext(context.Background(), apiClient, namePattern, nsname)
+}
+
+// ListByNamePatternWithContext returns pod inventory in the given namespace filtered by name pattern using the provided
+// context.
+func ListByNamePatternWithContext(
+	ctx context.Context, apiClient *clients.Settings, namePattern, nsname string) ([]*Builder, error) {
 	glog.V(100).Infof("Listing pods in the nsname %s filtered by the name pattern %s", nsname, namePattern)
 
 	if apiClient == nil {
@@ -129,7 +148,7 @@ func ListByNamePattern(apiClient *clients.Settings, namePattern, nsname string)
 		return nil, fmt.Errorf("failed to list pods, 'nsname' parameter is empty")
 	}
 
-	podList, err := apiClient.Pods(nsname).List(context.TODO(), metav1.ListOptions{})
+	podList, err := apiClient.Pods(nsname).List(ctx, metav1.ListOptions{})
 
 	if err != nil {
 		glog.V(100).Infof("Failed to list pods filtered by the name pattern %s in the nsname %s due to %s",
@@ -158,6 +177,17 @@ func ListByNamePattern(apiClient *clients.Settings, namePattern, nsname string)
 
 // WaitForAllPodsInNamespaceRunning wait until all pods in namespace that match options are in running state.
 func WaitForAllPodsInNamespaceRunning(
+	apiClient *clients.Settings,
+	nsname string,
+	timeout time.Duration,
+	options ...metav1.ListOptions) (bool, error) {
+	return WaitForAllPodsInNamespaceRunningWithContext(context.Background(), apiClient, nsname, timeout, options...)
+}
+
+// WaitForAllPodsInNamespaceRunningWithContext wait until all pods in namespace that match options are in running
+// state. The wait is aborted as soon as ctx is done.
+func WaitForAllPodsInNamespaceRunningWithContext(
+	ctx context.Context,
 	apiClient *clients.Settings,
 	nsname string,
 	timeout time.Duration,
@@ -198,7 +228,7 @@ func WaitForAllPodsInNamespaceRunning(
 	}
 
 	for _, podObj := range podList {
-		err = podObj.WaitUntilRunning(timeout)
+		err = podObj.WaitUntilRunningWithContext(ctx, timeout)
 		if err != nil {
 			glog.V(100).Infof("Timout was reached while waiting for all pods in running state: %s", err.Error())
 
@@ -213,6 +243,17 @@ func WaitForAllPodsInNamespaceRunning(
 // RestartPolicy of Never are ignored. It works by listing pods every 15 seconds until every listed pod is healthy.
 func WaitForPodsInNamespacesHealthy(
 	apiClient *clients.Settings, namespaces []string, timeout time.Duration, options ...metav1.ListOptions) error {
+	return WaitForPodsInNamespacesHealthyWithContext(context.Background(), apiClient, namespaces, timeout, options...)
+}
+
+// WaitForPodsInNamespacesHealthyWithContext waits up to timeout until every pod in namespaces is healthy, stopping
+// early if ctx is done. Failed pods with RestartPolicy of Never are ignored.
+func WaitForPodsInNamespacesHealthyWithContext(
+	ctx context.Context,
+	apiClient *clients.Settings,
+	namespaces []string,
+	timeout time.Duration,
+	options ...metav1.ListOptions) error {
 	logMessage := fmt.Sprintf("Waiting for all pods in namespaces %v to be healthy", namespaces)
 	passedOptions := metav1.ListOptions{}
 
@@ -236,7 +277,7 @@ func WaitForPodsInNamespacesHealthy(
 	glog.V(100).Info(logMessage)
 
 	return wait.PollUntilContextTimeout(
-		context.TODO(), 15*time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+		ctx, 15*time.Second, timeout, true, func(ctx context.Context) (bool, error) {
 			pods, err := listPodsInNamespaces(apiClient, namespaces, passedOptions)
 			if err != nil {
 				return false, nil
@@ -0,0 +1,216 @@
+package pod
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	nadV1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GetNetworkStatuses returns the status of every network attached to the pod, as reported by multus in the
+// k8s.v1.cni.cncf.io/network-status annotation. The status of SR-IOV and host-device networks includes the device
+// info with the PCI address of the device. A pod without the annotation has no statuses.
+func (builder *Builder) GetNetworkStatuses() ([]nadV1.NetworkStatus, error) {
+	return builder.GetNetworkStatusesWithContext(context.Background())
+}
+
+// GetNetworkStatusesWithContext returns the status of every network attached to the pod using the provided context.
+func (builder *Builder) GetNetworkStatusesWithContext(ctx context.Context) ([]nadV1.NetworkStatus, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	glog.V(100).Infof("Getting network statuses of pod %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	pod, err := builder.apiClient.Pods(builder.Definition.Namespace).Get(
+		ctx, builder.Definition.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pod %s in namespace %s: %w",
+			builder.Definition.Name, builder.Definition.Namespace, err)
+	}
+
+	builder.Object = pod
+
+	return parseNetworkStatuses(pod)
+}
+
+// GetNetworkStatus returns the status of the first interface of the pod attached to networkName. The networkName is
+// the name of the NetworkAttachmentDefinition, either in namespace/name format or only the name for a
+// NetworkAttachmentDefinition in the namespace of the pod.
+func (builder *Builder) GetNetworkStatus(networkName string) (*nadV1.NetworkStatus, error) {
+	return builder.GetNetworkStatusWithContext(context.Background(), networkName)
+}
+
+// GetNetworkStatusWithContext returns the status of the first interface of the pod attached to networkName using the
+// provided context.
+func (builder *Builder) GetNetworkStatusWithContext(
+	ctx context.Context, networkName string) (*nadV1.NetworkStatus, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	glog.V(100).Infof("Getting status of network %s of pod %s in namespace %s",
+		networkName, builder.Definition.Name, builder.Definition.Namespace)
+
+	statuses, err := builder.GetNetworkStatusesWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for index := range statuses {
+		if isStatusOfNetwork(statuses[index], networkName, builder.Definition.Namespace) {
+			return &statuses[index], nil
+		}
+	}
+
+	return nil, fmt.Errorf("network %s is not attached to pod %s in namespace %s",
+		networkName, builder.Definition.Name, builder.Definition.Namespace)
+}
+
+// GetNetworkStatusByInterface returns the status of the network attached to the pod as interfaceName.
+func (builder *Builder) GetNetworkStatusByInterface(interfaceName string) (*nadV1.NetworkStatus, error) {
+	return builder.GetNetworkStatusByInterfaceWithContext(context.Background(), interfaceName)
+}
+
+// GetNetworkStatusByInterfaceWithContext returns the status of the network attached to the pod as interfaceName using
+// the provided context.
+func (builder *Builder) GetNetworkStatusByInterfaceWithContext(
+	ctx context.Context, interfaceName string) (*nadV1.NetworkStatus, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	glog.V(100).Infof("Getting status of interface %s of pod %s in namespace %s",
+		interfaceName, builder.Definition.Name, builder.Definition.Namespace)
+
+	statuses, err := builder.GetNetworkStatusesWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for index := range statuses {
+		if statuses[index].Interface == interfaceName {
+			return &statuses[index], nil
+		}
+	}
+
+	return nil, fmt.Errorf("interface %s is not attached to pod %s in namespace %s",
+		interfaceName, builder.Definition.Name, builder.Definition.Namespace)
+}
+
+// GetIPsOnNetwork returns the IP addresses of the pod on networkName, which follows the format of
+// GetNetworkStatus. The addresses of all the interfaces attached to the network are returned.
+func (builder *Builder) GetIPsOnNetwork(networkName string) ([]string, error) {
+	return builder.GetIPsOnNetworkWithContext(context.Background(), networkName)
+}
+
+// GetIPsOnNetworkWithContext returns the IP addresses of the pod on networkName using the provided context.
+func (builder *Builder) GetIPsOnNetworkWithContext(ctx context.Context, networkName string) ([]string, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	glog.V(100).Infof("Getting IP addresses of pod %s in namespace %s on network %s",
+		builder.Definition.Name, builder.Definition.Namespace, networkName)
+
+	statuses, err := builder.GetNetworkStatusesWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		attached bool
+		ips      []string
+	)
+
+	for _, status := range statuses {
+		if isStatusOfNetwork(status, networkName, builder.Definition.Namespace) {
+			attached = true
+			ips = append(ips, status.IPs...)
+		}
+	}
+
+	if !attached {
+		return nil, fmt.Errorf("network %s is not attached to pod %s in namespace %s",
+			networkName, builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	if len(ips) == 0 {
+		return nil, fmt.Errorf("pod %s in namespace %s has no IP addresses on network %s",
+			builder.Definition.Name, builder.Definition.Namespace, networkName)
+	}
+
+	return ips, nil
+}
+
+// WaitUntilNetworkAttached waits for the duration of the defined timeout or until networkName, which follows the
+// format of GetNetworkStatus, is reported as attached to the pod.
+func (builder *Builder) WaitUntilNetworkAttached(networkName string, timeout time.Duration) error {
+	return builder.WaitUntilNetworkAttachedWithContext(context.Background(), networkName, timeout)
+}
+
+// WaitUntilNetworkAttachedWithContext waits until networkName is reported as attached to the pod, the timeout
+// expires or ctx is done.
+func (builder *Builder) WaitUntilNetworkAttachedWithContext(
+	ctx context.Context, networkName string, timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	glog.V(100).Infof("Waiting for the defined period until network %s is attached to pod %s in namespace %s",
+		networkName, builder.Definition.Name, builder.Definition.Namespace)
+
+	if networkName == "" {
+		return fmt.Errorf("pod 'networkName' cannot be empty")
+	}
+
+	return builder.waitFor(ctx, timeout, fmt.Sprintf("network %s to be attached to pod %s in namespace %s",
+		networkName, builder.Definition.Name, builder.Definition.Namespace), func(pod *corev1.Pod) (bool, error) {
+		statuses, err := parseNetworkStatuses(pod)
+		if err != nil {
+			return false, err
+		}
+
+		for _, status := range statuses {
+			if isStatusOfNetwork(status, networkName, builder.Definition.Namespace) {
+				return true, nil
+			}
+		}
+
+		return false, nil
+	})
+}
+
+// parseNetworkStatuses returns the network statuses of the network-status annotation of pod.
+func parseNetworkStatuses(pod *corev1.Pod) ([]nadV1.NetworkStatus, error) {
+	annotation, ok := pod.Annotations[nadV1.NetworkStatusAnnot]
+	if !ok || annotation == "" {
+		return nil, nil
+	}
+
+	var statuses []nadV1.NetworkStatus
+
+	if err := json.Unmarshal([]byte(annotation), &statuses); err != nil {
+		return nil, fmt.Errorf("failed to parse %s annotation of pod %s in namespace %s: %w",
+			nadV1.NetworkStatusAnnot, pod.Name, pod.Namespace, err)
+	}
+
+	return statuses, nil
+}
+
+// isStatusOfNetwork checks if status belongs to networkName, which is either in namespace/name format or only the
+// name of a network in podNamespace.
+func isStatusOfNetwork(status nadV1.NetworkStatus, networkName, podNamespace string) bool {
+	if status.Name == networkName {
+		return true
+	}
+
+	return !strings.Contains(networkName, "/") && status.Name == podNamespace+"/"+networkName
+}
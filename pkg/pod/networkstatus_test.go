@@ -0,0 +1,222 @@
+package pod
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	nadV1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const defaultNetworkStatusAnnotation = `[
+	{"name": "ovn-kubernetes", "interface": "eth0", "ips": ["10.128.0.10"], "mac": "0a:58:0a:80:00:0a",
+		"default": true, "dns": {}},
+	{"name": "test-ns/sriov-net", "interface": "net1", "ips": ["192.168.0.10", "fd00::10"],
+		"mac": "02:00:00:00:00:01", "dns": {}, "device-info": {"type": "pci", "version": "1.1.0",
+		"pci": {"pci-address": "0000:3b:02.1"}}},
+	{"name": "other-ns/bridge-net", "interface": "net2", "mac": "02:00:00:00:00:02", "dns": {}}
+]`
+
+func TestPodGetNetworkStatuses(t *testing.T) {
+	testCases := []struct {
+		annotation       string
+		valid            bool
+		expectedStatuses int
+		expectedError    string
+	}{
+		{
+			annotation:       defaultNetworkStatusAnnotation,
+			valid:            true,
+			expectedStatuses: 3,
+		},
+		{
+			annotation:       "",
+			valid:            true,
+			expectedStatuses: 0,
+		},
+		{
+			annotation: "[",
+			valid:      true,
+			expectedError: fmt.Sprintf("failed to parse %s annotation of pod %s in namespace %s: "+
+				"unexpected end of JSON input", nadV1.NetworkStatusAnnot, defaultPodName, defaultPodNsName),
+		},
+		{
+			annotation:    defaultNetworkStatusAnnotation,
+			valid:         false,
+			expectedError: "pod 'namespace' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildNetworkStatusTestBuilder(testCase.annotation, testCase.valid)
+
+		statuses, err := testBuilder.GetNetworkStatuses()
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.Nil(t, err)
+		assert.Len(t, statuses, testCase.expectedStatuses)
+	}
+}
+
+func TestPodGetNetworkStatus(t *testing.T) {
+	testCases := []struct {
+		networkName       string
+		expectedInterface string
+		expectedError     string
+	}{
+		{
+			networkName:       "sriov-net",
+			expectedInterface: "net1",
+		},
+		{
+			networkName:       "test-ns/sriov-net",
+			expectedInterface: "net1",
+		},
+		{
+			networkName:       "ovn-kubernetes",
+			expectedInterface: "eth0",
+		},
+		{
+			networkName:   "bridge-net",
+			expectedError: "network bridge-net is not attached to pod test-pod in namespace test-ns",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildNetworkStatusTestBuilder(defaultNetworkStatusAnnotation, true)
+
+		status, err := testBuilder.GetNetworkStatus(testCase.networkName)
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+			assert.Nil(t, status)
+
+			continue
+		}
+
+		assert.Nil(t, err)
+		assert.Equal(t, testCase.expectedInterface, status.Interface)
+	}
+
+	status, err := buildNetworkStatusTestBuilder(defaultNetworkStatusAnnotation, true).GetNetworkStatusByInterface("net1")
+	assert.Nil(t, err)
+	assert.Equal(t, "test-ns/sriov-net", status.Name)
+	assert.Equal(t, "0000:3b:02.1", status.DeviceInfo.Pci.PciAddress)
+
+	_, err = buildNetworkStatusTestBuilder(defaultNetworkStatusAnnotation, true).GetNetworkStatusByInterface("net3")
+	assert.EqualError(t, err, "interface net3 is not attached to pod test-pod in namespace test-ns")
+
+	status, err = buildNetworkStatusTestBuilder(defaultNetworkStatusAnnotation, true).
+		GetNetworkStatusByInterfaceWithContext(t.Context(), "net1")
+	assert.Nil(t, err)
+	assert.Equal(t, "test-ns/sriov-net", status.Name)
+}
+
+func TestPodGetIPsOnNetwork(t *testing.T) {
+	testCases := []struct {
+		networkName   string
+		expectedIPs   []string
+		expectedError string
+	}{
+		{
+			networkName: "sriov-net",
+			expectedIPs: []string{"192.168.0.10", "fd00::10"},
+		},
+		{
+			networkName:   "other-ns/bridge-net",
+			expectedError: "pod test-pod in namespace test-ns has no IP addresses on network other-ns/bridge-net",
+		},
+		{
+			networkName:   "macvlan-net",
+			expectedError: "network macvlan-net is not attached to pod test-pod in namespace test-ns",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildNetworkStatusTestBuilder(defaultNetworkStatusAnnotation, true)
+
+		ips, err := testBuilder.GetIPsOnNetwork(testCase.networkName)
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.Nil(t, err)
+		assert.Equal(t, testCase.expectedIPs, ips)
+	}
+}
+
+func TestPodWaitUntilNetworkAttached(t *testing.T) {
+	testCases := []struct {
+		annotation    string
+		networkName   string
+		valid         bool
+		expectedError error
+	}{
+		{
+			annotation:  defaultNetworkStatusAnnotation,
+			networkName: "sriov-net",
+			valid:       true,
+		},
+		{
+			annotation:    defaultNetworkStatusAnnotation,
+			networkName:   "macvlan-net",
+			valid:         true,
+			expectedError: context.DeadlineExceeded,
+		},
+		{
+			annotation:    defaultNetworkStatusAnnotation,
+			networkName:   "",
+			valid:         true,
+			expectedError: fmt.Errorf("pod 'networkName' cannot be empty"),
+		},
+		{
+			annotation:    defaultNetworkStatusAnnotation,
+			networkName:   "sriov-net",
+			valid:         false,
+			expectedError: fmt.Errorf("pod 'namespace' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildNetworkStatusTestBuilder(testCase.annotation, testCase.valid)
+
+		err := testBuilder.WaitUntilNetworkAttached(testCase.networkName, time.Second)
+
+		if errors.Is(testCase.expectedError, context.DeadlineExceeded) {
+			assert.ErrorIs(t, err, testCase.expectedError)
+		} else {
+			assert.Equal(t, testCase.expectedError, err)
+		}
+	}
+}
+
+// buildNetworkStatusTestBuilder returns a pod builder for a pod whose network-status annotation is annotation. If
+// valid is false, the builder is invalid.
+func buildNetworkStatusTestBuilder(annotation string, valid bool) *Builder {
+	pod := buildDummyPod(defaultPodName, defaultPodNsName, defaultPodImage)
+
+	if annotation != "" {
+		pod.Annotations = map[string]string{nadV1.NetworkStatusAnnot: annotation}
+	}
+
+	apiClient := clients.GetTestClients(clients.TestClientParams{K8sMockObjects: []runtime.Object{pod}})
+
+	if !valid {
+		return buildInvalidPodTestBuilder(apiClient)
+	}
+
+	return buildValidPodTestBuilder(apiClient)
+}
@@ -6,7 +6,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
-	"net/http"
 	"os"
 	"time"
 
@@ -17,15 +16,14 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/util/httpstream/spdy"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/kubernetes/scheme"
-	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/remotecommand"
 	"k8s.io/utils/ptr"
 
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/waiter"
 )
 
 // Builder provides a struct for pod object from the cluster and a pod definition.
@@ -170,6 +168,11 @@ func (builder *Builder) DefineOnNode(nodeName string) *Builder {
 
 // Create makes a pod according to the pod definition and stores the created object in the pod builder.
 func (builder *Builder) Create() (*Builder, error) {
+	return builder.CreateWithContext(context.Background())
+}
+
+// CreateWithContext makes a pod according to the pod definition using the provided context for the API calls.
+func (builder *Builder) CreateWithContext(ctx context.Context) (*Builder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
@@ -177,10 +180,15 @@ func (builder *Builder) Create() (*Builder, error) {
 	glog.V(100).Infof("Creating pod %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
+	// Exists treats any error other than NotFound as existing, so a cancelled context must be caught first.
+	if err := ctx.Err(); err != nil {
+		return builder, err
+	}
+
 	var err error
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		builder.Object, err = builder.apiClient.Pods(builder.Definition.Namespace).Create(
-			context.TODO(), builder.Definition, metav1.CreateOptions{})
+			ctx, builder.Definition, metav1.CreateOptions{})
 	}
 
 	return builder, err
@@ -188,6 +196,11 @@ func (builder *Builder) Create() (*Builder, error) {
 
 // Delete removes the pod object and resets the builder object.
 func (builder *Builder) Delete() (*Builder, error) {
+	return builder.DeleteWithContext(context.Background())
+}
+
+// DeleteWithContext removes the pod object using the provided context and resets the builder object.
+func (builder *Builder) DeleteWithContext(ctx context.Context) (*Builder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
@@ -195,7 +208,7 @@ func (builder *Builder) Delete() (*Builder, error) {
 	glog.V(100).Infof("Deleting pod %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		glog.V(100).Infof(
 			"Pod %s in namespace %s cannot be deleted because it does not exist",
 			builder.Definition.Name, builder.Definition.Namespace)
@@ -206,7 +219,7 @@ func (builder *Builder) Delete() (*Builder, error) {
 	}
 
 	err := builder.apiClient.Pods(builder.Definition.Namespace).Delete(
-		context.TODO(), builder.Object.Name, metav1.DeleteOptions{})
+		ctx, builder.Object.Name, metav1.DeleteOptions{})
 
 	if err != nil {
 		return builder, fmt.Errorf("can not delete pod: %w", err)
@@ -219,6 +232,11 @@ func (builder *Builder) Delete() (*Builder, error) {
 
 // DeleteAndWait deletes the pod object and waits until the pod is deleted.
 func (builder *Builder) DeleteAndWait(timeout time.Duration) (*Builder, error) {
+	return builder.DeleteAndWaitWithContext(context.Background(), timeout)
+}
+
+// DeleteAndWaitWithContext deletes the pod object and waits until the pod is deleted or ctx is done.
+func (builder *Builder) DeleteAndWaitWithContext(ctx context.Context, timeout time.Duration) (*Builder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
@@ -226,12 +244,12 @@ func (builder *Builder) DeleteAndWait(timeout time.Duration) (*Builder, error) {
 	glog.V(100).Infof("Deleting pod %s in namespace %s and waiting for the defined period until it is removed",
 		builder.Definition.Name, builder.Definition.Namespace)
 
-	builder, err := builder.Delete()
+	builder, err := builder.DeleteWithContext(ctx)
 	if err != nil {
 		return builder, err
 	}
 
-	err = builder.WaitUntilDeleted(timeout)
+	err = builder.WaitUntilDeletedWithContext(ctx, timeout)
 
 	if err != nil {
 		return builder, err
@@ -242,6 +260,11 @@ func (builder *Builder) DeleteAndWait(timeout time.Duration) (*Builder, error) {
 
 // DeleteImmediate removes the pod immediately and resets the builder object.
 func (builder *Builder) DeleteImmediate() (*Builder, error) {
+	return builder.DeleteImmediateWithContext(context.Background())
+}
+
+// DeleteImmediateWithContext removes the pod immediately using the provided context and resets the builder object.
+func (builder *Builder) DeleteImmediateWithContext(ctx context.Context) (*Builder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
@@ -249,7 +272,7 @@ func (builder *Builder) DeleteImmediate() (*Builder, error) {
 	glog.V(100).Infof("Immediately deleting pod %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		glog.V(100).Infof(
 			"Pod %s in namespace %s cannot be deleted because it does not exist",
 			builder.Definition.Name, builder.Definition.Namespace)
@@ -260,7 +283,7 @@ func (builder *Builder) DeleteImmediate() (*Builder, error) {
 	}
 
 	err := builder.apiClient.Pods(builder.Definition.Namespace).Delete(
-		context.TODO(), builder.Object.Name, metav1.DeleteOptions{GracePeriodSeconds: ptr.To(int64(0))})
+		ctx, builder.Object.Name, metav1.DeleteOptions{GracePeriodSeconds: ptr.To(int64(0))})
 
 	if err != nil {
 		return builder, fmt.Errorf("can not immediately delete pod: %w", err)
@@ -273,6 +296,12 @@ func (builder *Builder) DeleteImmediate() (*Builder, error) {
 
 // CreateAndWaitUntilRunning creates the pod object and waits until the pod is running.
 func (builder *Builder) CreateAndWaitUntilRunning(timeout time.Duration) (*Builder, error) {
+	return builder.CreateAndWaitUntilRunningWithContext(context.Background(), timeout)
+}
+
+// CreateAndWaitUntilRunningWithContext creates the pod object and waits until the pod is running or ctx is done.
+func (builder *Builder) CreateAndWaitUntilRunningWithContext(
+	ctx context.Context, timeout time.Duration) (*Builder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
@@ -280,12 +309,12 @@ func (builder *Builder) CreateAndWaitUntilRunning(timeout time.Duration) (*Build
 	glog.V(100).Infof("Creating pod %s in namespace %s and waiting for the defined period until it is ready",
 		builder.Definition.Name, builder.Definition.Namespace)
 
-	builder, err := builder.Create()
+	builder, err := builder.CreateWithContext(ctx)
 	if err != nil {
 		return builder, err
 	}
 
-	err = builder.WaitUntilRunning(timeout)
+	err = builder.WaitUntilRunningWithContext(ctx, timeout)
 
 	if err != nil {
 		return builder, err
@@ -296,6 +325,11 @@ func (builder *Builder) CreateAndWaitUntilRunning(timeout time.Duration) (*Build
 
 // WaitUntilRunning waits for the duration of the defined timeout or until the pod is running.
 func (builder *Builder) WaitUntilRunning(timeout time.Duration) error {
+	return builder.WaitUntilRunningWithContext(context.Background(), timeout)
+}
+
+// WaitUntilRunningWithContext waits until the pod is running, the timeout expires or ctx is done.
+func (builder *Builder) WaitUntilRunningWithContext(ctx context.Context, timeout time.Duration) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
@@ -303,7 +337,7 @@ func (builder *Builder) WaitUntilRunning(timeout time.Duration) error {
 	glog.V(100).Infof("Waiting for the defined period until pod %s in namespace %s is running",
 		builder.Definition.Name, builder.Definition.Namespace)
 
-	return builder.WaitUntilInStatus(corev1.PodRunning, timeout)
+	return builder.WaitUntilInStatusWithContext(ctx, corev1.PodRunning, timeout)
 }
 
 // IsHealthy returns true if and only if the pod has succeeded or is running and ready. All other cases, such as when
@@ -328,6 +362,12 @@ func (builder *Builder) IsHealthy() bool {
 
 // WaitUntilInStatus waits for the duration of the defined timeout or until the pod gets to a specific status.
 func (builder *Builder) WaitUntilInStatus(status corev1.PodPhase, timeout time.Duration) error {
+	return builder.WaitUntilInStatusWithContext(context.Background(), status, timeout)
+}
+
+// WaitUntilInStatusWithContext waits until the pod gets to a specific status, the timeout expires or ctx is done.
+func (builder *Builder) WaitUntilInStatusWithContext(
+	ctx context.Context, status corev1.PodPhase, timeout time.Duration) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
@@ -335,23 +375,19 @@ func (builder *Builder) WaitUntilInStatus(status corev1.PodPhase, timeout time.D
 	glog.V(100).Infof("Waiting for the defined period until pod %s in namespace %s has status %v",
 		builder.Definition.Name, builder.Definition.Namespace, status)
 
-	return wait.PollUntilContextTimeout(context.TODO(),
-		time.Second, timeout, true, func(ctx context.Context) (bool, error) {
-			updatePod, err := builder.apiClient.Pods(builder.Definition.Namespace).
-				Get(context.TODO(), builder.Definition.Name, metav1.GetOptions{})
-			if err != nil {
-				glog.V(100).Infof("Failed to get pod %s in namespace %s: %v",
-					builder.Definition.Name, builder.Definition.Namespace, err)
-
-				return false, nil
-			}
-
-			return updatePod.Status.Phase == status, nil
-		})
+	return builder.waitFor(ctx, timeout, fmt.Sprintf("pod %s in namespace %s to have status %v",
+		builder.Definition.Name, builder.Definition.Namespace, status), func(pod *corev1.Pod) (bool, error) {
+		return pod.Status.Phase == status, nil
+	})
 }
 
 // WaitUntilDeleted waits for the duration of the defined timeout or until the pod is deleted.
 func (builder *Builder) WaitUntilDeleted(timeout time.Duration) error {
+	return builder.WaitUntilDeletedWithContext(context.Background(), timeout)
+}
+
+// WaitUntilDeletedWithContext waits until the pod is deleted, the timeout expires or ctx is done.
+func (builder *Builder) WaitUntilDeletedWithContext(ctx context.Context, timeout time.Duration) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
@@ -360,9 +396,9 @@ func (builder *Builder) WaitUntilDeleted(timeout time.Duration) error {
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	err := wait.PollUntilContextTimeout(
-		context.TODO(), time.Second, timeout, false, func(ctx context.Context) (bool, error) {
+		ctx, time.Second, timeout, false, func(ctx context.Context) (bool, error) {
 			_, err := builder.apiClient.Pods(builder.Definition.Namespace).Get(
-				context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+				ctx, builder.Definition.Name, metav1.GetOptions{})
 			if err == nil {
 				glog.V(100).Infof("pod %s/%s still present", builder.Definition.Namespace, builder.Definition.Name)
 
@@ -385,6 +421,11 @@ func (builder *Builder) WaitUntilDeleted(timeout time.Duration) error {
 
 // WaitUntilReady waits for the duration of the defined timeout or until the pod reaches the Ready condition.
 func (builder *Builder) WaitUntilReady(timeout time.Duration) error {
+	return builder.WaitUntilReadyWithContext(context.Background(), timeout)
+}
+
+// WaitUntilReadyWithContext waits until the pod reaches the Ready condition, the timeout expires or ctx is done.
+func (builder *Builder) WaitUntilReadyWithContext(ctx context.Context, timeout time.Duration) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
@@ -392,11 +433,17 @@ func (builder *Builder) WaitUntilReady(timeout time.Duration) error {
 	glog.V(100).Infof("Waiting for the defined period until pod %s in namespace %s is Ready",
 		builder.Definition.Name, builder.Definition.Namespace)
 
-	return builder.WaitUntilCondition(corev1.PodReady, timeout)
+	return builder.WaitUntilConditionWithContext(ctx, corev1.PodReady, timeout)
 }
 
 // WaitUntilCondition waits for the duration of the defined timeout or until the pod gets to a specific condition.
 func (builder *Builder) WaitUntilCondition(condition corev1.PodConditionType, timeout time.Duration) error {
+	return builder.WaitUntilConditionWithContext(context.Background(), condition, timeout)
+}
+
+// WaitUntilConditionWithContext waits until the pod gets to a specific condition, the timeout expires or ctx is done.
+func (builder *Builder) WaitUntilConditionWithContext(
+	ctx context.Context, condition corev1.PodConditionType, timeout time.Duration) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
@@ -404,25 +451,38 @@ func (builder *Builder) WaitUntilCondition(condition corev1.PodConditionType, ti
 	glog.V(100).Infof("Waiting for the defined period until pod %s in namespace %s has condition %v",
 		builder.Definition.Name, builder.Definition.Namespace, condition)
 
-	return wait.PollUntilContextTimeout(
-		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
-			updatePod, err := builder.apiClient.Pods(builder.Definition.Namespace).Get(
-				context.TODO(), builder.Definition.Name, metav1.GetOptions{})
-			if err != nil {
-				return false, nil
+	return builder.waitFor(ctx, timeout, fmt.Sprintf("pod %s in namespace %s to have condition %v",
+		builder.Definition.Name, builder.Definition.Namespace, condition), func(pod *corev1.Pod) (bool, error) {
+		for _, cond := range pod.Status.Conditions {
+			if cond.Type == condition && cond.Status == corev1.ConditionTrue {
+				return true, nil
 			}
+		}
 
-			for _, cond := range updatePod.Status.Conditions {
-				if cond.Type == condition && cond.Status == corev1.ConditionTrue {
-					return true, nil
-				}
-			}
+		return false, nil
+	})
+}
 
-			return false, nil
-		})
+// waitFor waits until the pod satisfies condition, the timeout expires or ctx is done, and updates the builder
+// object. On timeout, the returned waiter.WaitTimeoutError includes the last status and the events of the pod.
+func (builder *Builder) waitFor(ctx context.Context, timeout time.Duration, description string,
+	condition waiter.Condition[*corev1.Pod]) error {
+	source := waiter.WithEvents(
+		waiter.NewTypedSource(builder.apiClient.Pods(builder.Definition.Namespace), builder.Definition.Name),
+		builder.apiClient, "Pod", builder.Definition.Namespace)
+
+	pod, err := waiter.For(ctx, source, waiter.Options{Description: description, Timeout: timeout}, condition)
+	if err != nil {
+		return err
+	}
+
+	builder.Object = pod
+
+	return nil
 }
 
-// ExecCommand runs command in the pod and returns the buffer output.
+// ExecCommand runs command in the pod and returns the buffer output. The command runs with a TTY, so its standard
+// error is merged into the output and its exit code is not available; use ExecCommandWithOptions to get them.
 func (builder *Builder) ExecCommand(command []string, containerName ...string) (bytes.Buffer, error) {
 	if valid, err := builder.validate(); !valid {
 		return bytes.Buffer{}, err
@@ -463,7 +523,7 @@ func (builder *Builder) ExecCommand(command []string, containerName ...string) (
 		return buffer, err
 	}
 
-	err = exec.StreamWithContext(context.TODO(), remotecommand.StreamOptions{
+	err = exec.StreamWithContext(context.Background(), remotecommand.StreamOptions{
 		Stdin:  os.Stdin,
 		Stdout: &buffer,
 		Stderr: os.Stderr,
@@ -519,41 +579,12 @@ func (builder *Builder) Copy(path, containerName string, tar bool) (bytes.Buffer
 			TTY:       false,
 		}, scheme.ParameterCodec)
 
-	tlsConfig, err := rest.TLSConfigFor(builder.apiClient.Config)
-	if err != nil {
-		return bytes.Buffer{}, err
-	}
-
-	proxy := http.ProxyFromEnvironment
-	if builder.apiClient.Config.Proxy != nil {
-		proxy = builder.apiClient.Config.Proxy
-	}
-
-	// More verbose setup of remotecommand executor required in order to tweak PingPeriod.
-	// By default many large files are not copied in their entirety without disabling PingPeriod during the copy.
-	// https://github.com/kubernetes/kubernetes/issues/60140#issuecomment-1411477275
-	upgradeRoundTripper, err := spdy.NewRoundTripperWithConfig(spdy.RoundTripperConfig{
-		TLS:        tlsConfig,
-		Proxier:    proxy,
-		PingPeriod: 0,
-	})
-
-	if err != nil {
-		return bytes.Buffer{}, err
-	}
-
-	wrapper, err := rest.HTTPWrappersForConfig(builder.apiClient.Config, upgradeRoundTripper)
-	if err != nil {
-		return bytes.Buffer{}, err
-	}
-
-	exec, err := remotecommand.NewSPDYExecutorForTransports(wrapper, upgradeRoundTripper, "POST", req.URL())
-
+	exec, err := builder.newTransferExecutor(req.URL())
 	if err != nil {
 		return buffer, err
 	}
 
-	err = exec.StreamWithContext(context.TODO(), remotecommand.StreamOptions{
+	err = exec.StreamWithContext(context.Background(), remotecommand.StreamOptions{
 		Stdin:  os.Stdin,
 		Stdout: &buffer,
 		Stderr: os.Stderr,
@@ -569,6 +600,11 @@ func (builder *Builder) Copy(path, containerName string, tar bool) (bytes.Buffer
 
 // Exists checks whether the given pod exists.
 func (builder *Builder) Exists() bool {
+	return builder.ExistsWithContext(context.Background())
+}
+
+// ExistsWithContext checks whether the given pod exists using the provided context.
+func (builder *Builder) ExistsWithContext(ctx context.Context) bool {
 	if valid, _ := builder.validate(); !valid {
 		return false
 	}
@@ -578,7 +614,7 @@ func (builder *Builder) Exists() bool {
 
 	var err error
 	builder.Object, err = builder.apiClient.Pods(builder.Definition.Namespace).Get(
-		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+		ctx, builder.Definition.Name, metav1.GetOptions{})
 
 	return err == nil || !k8serrors.IsNotFound(err)
 }
@@ -1014,6 +1050,11 @@ func (builder *Builder) WithSecurityContext(securityContext *corev1.PodSecurityC
 
 // PullImage pulls image for given pod's container and removes it.
 func (builder *Builder) PullImage(timeout time.Duration, testCmd []string) error {
+	return builder.PullImageWithContext(context.Background(), timeout, testCmd)
+}
+
+// PullImageWithContext pulls image for given pod's container and removes it, aborting once ctx is done.
+func (builder *Builder) PullImageWithContext(ctx context.Context, timeout time.Duration, testCmd []string) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
@@ -1024,7 +1065,7 @@ func (builder *Builder) PullImage(timeout time.Duration, testCmd []string) error
 
 	builder.WithRestartPolicy(corev1.RestartPolicyNever)
 	builder.RedefineDefaultCMD(testCmd)
-	_, err := builder.Create()
+	_, err := builder.CreateWithContext(ctx)
 
 	if err != nil {
 		glog.V(100).Infof(
@@ -1035,7 +1076,7 @@ func (builder *Builder) PullImage(timeout time.Duration, testCmd []string) error
 		return err
 	}
 
-	statusErr := builder.WaitUntilInStatus(corev1.PodSucceeded, timeout)
+	statusErr := builder.WaitUntilInStatusWithContext(ctx, corev1.PodSucceeded, timeout)
 
 	if statusErr != nil {
 		glog.V(100).Infof(
@@ -1044,7 +1085,7 @@ func (builder *Builder) PullImage(timeout time.Duration, testCmd []string) error
 			builder.Definition.Name, builder.Definition.Namespace, builder.Definition.Spec.Containers[0].Image,
 			builder.Definition.Spec.NodeName)
 
-		_, err = builder.Delete()
+		_, err = builder.DeleteWithContext(ctx)
 
 		if err != nil {
 			glog.V(100).Infof(
@@ -1057,7 +1098,7 @@ func (builder *Builder) PullImage(timeout time.Duration, testCmd []string) error
 		return statusErr
 	}
 
-	_, err = builder.Delete()
+	_, err = builder.DeleteWithContext(ctx)
 
 	return err
 }
@@ -1181,13 +1222,20 @@ func (builder *Builder) GetFullLog(containerName string) (string, error) {
 // GetLogsWithOptions retrieves logs from a pod using the provided options. No validation is performed on the provided
 // options. The options may be nil.
 func (builder *Builder) GetLogsWithOptions(options *corev1.PodLogOptions) ([]byte, error) {
+	return builder.GetLogsWithOptionsWithContext(context.Background(), options)
+}
+
+// GetLogsWithOptionsWithContext retrieves logs from a pod using the provided options and context. The options may be
+// nil.
+func (builder *Builder) GetLogsWithOptionsWithContext(
+	ctx context.Context, options *corev1.PodLogOptions) ([]byte, error) {
 	if valid, err := builder.validate(); !valid {
 		return nil, err
 	}
 
 	logReader, err := builder.apiClient.Pods(builder.Definition.Namespace).
 		GetLogs(builder.Definition.Name, options).
-		Stream(context.TODO())
+		Stream(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -2,11 +2,13 @@ package pod
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 	"time"
 
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/waiter"
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -237,6 +239,37 @@ func TestPodCreate(t *testing.T) {
 	}
 }
 
+func TestPodCreateWithContext(t *testing.T) {
+	testCases := []struct {
+		cancelled     bool
+		expectedError error
+	}{
+		{
+			cancelled:     false,
+			expectedError: nil,
+		},
+		{
+			cancelled:     true,
+			expectedError: context.Canceled,
+		},
+	}
+
+	for _, testCase := range testCases {
+		ctx, cancel := context.WithCancel(t.Context())
+		if testCase.cancelled {
+			cancel()
+		}
+
+		testBuilder := buildValidPodTestBuilder(clients.GetTestClients(clients.TestClientParams{}))
+
+		_, err := testBuilder.CreateWithContext(ctx)
+		assert.Equal(t, testCase.expectedError, err)
+		assert.Equal(t, testCase.expectedError == nil, testBuilder.Exists())
+
+		cancel()
+	}
+}
+
 func TestPodDelete(t *testing.T) {
 	testPodDeleteHelper(t, func(builder *Builder) (*Builder, error) {
 		return builder.Delete()
@@ -292,6 +325,80 @@ func TestPodWaitUntilCondition(t *testing.T) {
 	})
 }
 
+func TestPodWaitUntilConditionWithContext(t *testing.T) {
+	pod := buildDummyPodWithPhaseAndCondition(corev1.PodRunning, corev1.PodReady, false)
+	pod.Status.Conditions[0].Status = corev1.ConditionFalse
+
+	testBuilder := buildValidPodTestBuilder(clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: []runtime.Object{pod},
+	}))
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	err := testBuilder.WaitUntilConditionWithContext(ctx, corev1.PodReady, time.Minute)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestPodWaitUntilRunning(t *testing.T) {
+	testCases := []struct {
+		phase         corev1.PodPhase
+		valid         bool
+		expectedError error
+	}{
+		{
+			phase:         corev1.PodRunning,
+			valid:         true,
+			expectedError: nil,
+		},
+		{
+			phase:         corev1.PodPending,
+			valid:         true,
+			expectedError: context.DeadlineExceeded,
+		},
+		{
+			phase:         corev1.PodRunning,
+			valid:         false,
+			expectedError: fmt.Errorf("pod 'namespace' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		pod := buildDummyPodWithPhaseAndCondition(testCase.phase, corev1.PodScheduled, false)
+		event := &corev1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: "test-event", Namespace: defaultPodNsName},
+			InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: defaultPodName, Namespace: defaultPodNsName},
+			Type:           corev1.EventTypeWarning,
+			Reason:         "FailedScheduling",
+			Message:        "0/3 nodes are available",
+		}
+		testSettings := clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects: []runtime.Object{pod, event},
+		})
+
+		testBuilder := buildValidPodTestBuilder(testSettings)
+		if !testCase.valid {
+			testBuilder = buildInvalidPodTestBuilder(testSettings)
+		}
+
+		err := testBuilder.WaitUntilRunning(500 * time.Millisecond)
+
+		if !errors.Is(testCase.expectedError, context.DeadlineExceeded) {
+			assert.Equal(t, testCase.expectedError, err)
+
+			continue
+		}
+
+		var timeoutErr *waiter.WaitTimeoutError
+
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.True(t, errors.As(err, &timeoutErr))
+		assert.Equal(t, string(corev1.PodPending), timeoutErr.Status["phase"])
+		assert.Len(t, timeoutErr.Events, 1)
+		assert.Contains(t, err.Error(), "Warning FailedScheduling: 0/3 nodes are available")
+	}
+}
+
 func TestPodExists(t *testing.T) {
 	testCases := []struct {
 		testBuilder *Builder
@@ -725,7 +832,12 @@ func testPodWaitUntilConditionHelper(t *testing.T, waitFunc func(builder *Builde
 		}
 
 		err := waitFunc(testBuilder)
-		assert.Equal(t, testCase.expectedError, err)
+
+		if errors.Is(testCase.expectedError, context.DeadlineExceeded) {
+			assert.ErrorIs(t, err, testCase.expectedError)
+		} else {
+			assert.Equal(t, testCase.expectedError, err)
+		}
 	}
 }
 
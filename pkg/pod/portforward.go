@@ -0,0 +1,168 @@
+package pod
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/golang/glog"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/portforward"
+	"k8s.io/client-go/transport/spdy"
+)
+
+// PortForwarder forwards a local port to a port of a pod until it is closed.
+type PortForwarder struct {
+	localPort  uint16
+	remotePort uint16
+	stopChan   chan struct{}
+	doneChan   chan struct{}
+	closeOnce  sync.Once
+	errOut     bytes.Buffer
+	err        error
+}
+
+// LocalAddress returns the local address, host and port, forwarded to the pod.
+func (forwarder *PortForwarder) LocalAddress() string {
+	return net.JoinHostPort("127.0.0.1", strconv.Itoa(int(forwarder.localPort)))
+}
+
+// LocalPort returns the local port forwarded to the pod.
+func (forwarder *PortForwarder) LocalPort() uint16 {
+	return forwarder.localPort
+}
+
+// RemotePort returns the port of the pod the local port is forwarded to.
+func (forwarder *PortForwarder) RemotePort() uint16 {
+	return forwarder.remotePort
+}
+
+// Close stops forwarding the port and returns the error that interrupted forwarding, if any. It is safe to call Close
+// more than once.
+func (forwarder *PortForwarder) Close() error {
+	forwarder.closeOnce.Do(func() {
+		close(forwarder.stopChan)
+	})
+
+	<-forwarder.doneChan
+
+	return forwarder.err
+}
+
+// PortForward forwards localPort on the loopback interface to remotePort of the pod. If localPort is 0, a free local
+// port is chosen and can be retrieved from the returned PortForwarder, which must be closed once no longer needed.
+func (builder *Builder) PortForward(localPort, remotePort uint16) (*PortForwarder, error) {
+	return builder.PortForwardWithContext(context.Background(), localPort, remotePort)
+}
+
+// PortForwardWithContext forwards localPort on the loopback interface to remotePort of the pod. Forwarding stops when
+// the returned PortForwarder is closed or ctx is done.
+func (builder *Builder) PortForwardWithContext(
+	ctx context.Context, localPort, remotePort uint16) (*PortForwarder, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	if remotePort == 0 {
+		glog.V(100).Infof("The remote port to forward to pod %s in namespace %s is 0",
+			builder.Definition.Name, builder.Definition.Namespace)
+
+		return nil, fmt.Errorf("pod port-forward remotePort cannot be 0")
+	}
+
+	if builder.apiClient.Config == nil {
+		return nil, fmt.Errorf("pod port-forward requires an apiClient with a rest config")
+	}
+
+	pod, err := builder.apiClient.Pods(builder.Definition.Namespace).Get(
+		ctx, builder.Definition.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pod %s in namespace %s to forward port: %w",
+			builder.Definition.Name, builder.Definition.Namespace, err)
+	}
+
+	builder.Object = pod
+
+	if pod.Status.Phase != corev1.PodRunning {
+		return nil, fmt.Errorf("cannot forward port to pod %s in namespace %s in phase %s",
+			pod.Name, pod.Namespace, pod.Status.Phase)
+	}
+
+	glog.V(100).Infof("Forwarding local port %d to port %d of pod %s in namespace %s",
+		localPort, remotePort, pod.Name, pod.Namespace)
+
+	transport, upgrader, err := spdy.RoundTripperFor(builder.apiClient.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	req := builder.apiClient.CoreV1Interface.RESTClient().
+		Post().
+		Namespace(pod.Namespace).
+		Resource("pods").
+		Name(pod.Name).
+		SubResource("portforward")
+
+	dialer := spdy.NewDialer(upgrader, &http.Client{Transport: transport}, http.MethodPost, req.URL())
+
+	forwarder := &PortForwarder{
+		remotePort: remotePort,
+		stopChan:   make(chan struct{}),
+		doneChan:   make(chan struct{}),
+	}
+	readyChan := make(chan struct{})
+
+	portForwarder, err := portforward.NewOnAddresses(dialer, []string{"127.0.0.1"},
+		[]string{fmt.Sprintf("%d:%d", localPort, remotePort)}, forwarder.stopChan, readyChan, io.Discard,
+		&forwarder.errOut)
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		defer close(forwarder.doneChan)
+
+		forwarder.err = portForwarder.ForwardPorts()
+	}()
+
+	select {
+	case <-readyChan:
+	case <-forwarder.doneChan:
+		return nil, fmt.Errorf("failed to forward port %d of pod %s in namespace %s: %w: %s",
+			remotePort, pod.Name, pod.Namespace, forwarder.err, strings.TrimSpace(forwarder.errOut.String()))
+	case <-ctx.Done():
+		_ = forwarder.Close()
+
+		return nil, ctx.Err()
+	}
+
+	ports, err := portForwarder.GetPorts()
+	if err == nil && len(ports) == 0 {
+		err = fmt.Errorf("no port forwarded")
+	}
+
+	if err != nil {
+		_ = forwarder.Close()
+
+		return nil, fmt.Errorf("failed to get forwarded port of pod %s in namespace %s: %w", pod.Name, pod.Namespace, err)
+	}
+
+	forwarder.localPort = ports[0].Local
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			_ = forwarder.Close()
+		case <-forwarder.doneChan:
+		}
+	}()
+
+	return forwarder, nil
+}
@@ -0,0 +1,64 @@
+package pod
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+)
+
+func TestPodPortForward(t *testing.T) {
+	testCases := []struct {
+		testBuilder   *Builder
+		remotePort    uint16
+		config        *rest.Config
+		expectedError string
+	}{
+		{
+			testBuilder:   buildValidPodTestBuilder(buildTestClientWithDummyPod()),
+			remotePort:    0,
+			expectedError: "pod port-forward remotePort cannot be 0",
+		},
+		{
+			testBuilder:   buildValidPodTestBuilder(buildTestClientWithDummyPod()),
+			remotePort:    8080,
+			expectedError: "pod port-forward requires an apiClient with a rest config",
+		},
+		{
+			testBuilder:   buildInvalidPodTestBuilder(buildTestClientWithDummyPod()),
+			remotePort:    8080,
+			expectedError: "pod 'namespace' cannot be empty",
+		},
+		{
+			testBuilder: buildValidPodTestBuilder(clients.GetTestClients(clients.TestClientParams{
+				K8sMockObjects: []runtime.Object{buildDummyPod(defaultPodName, defaultPodNsName, defaultPodImage)},
+			})),
+			remotePort: 8080,
+			config:     &rest.Config{},
+			expectedError: fmt.Sprintf(
+				"cannot forward port to pod %s in namespace %s in phase ", defaultPodName, defaultPodNsName),
+		},
+	}
+
+	for _, testCase := range testCases {
+		if testCase.config != nil {
+			testCase.testBuilder.apiClient.Config = testCase.config
+		}
+
+		forwarder, err := testCase.testBuilder.PortForward(0, testCase.remotePort)
+
+		assert.EqualError(t, err, testCase.expectedError)
+		assert.Nil(t, forwarder)
+	}
+}
+
+func TestPodPortForwarderLocalAddress(t *testing.T) {
+	forwarder := &PortForwarder{localPort: 18080, remotePort: 8080}
+
+	assert.Equal(t, "127.0.0.1:18080", forwarder.LocalAddress())
+	assert.Equal(t, uint16(18080), forwarder.LocalPort())
+	assert.Equal(t, uint16(8080), forwarder.RemotePort())
+}
@@ -264,7 +264,13 @@ func TestClusterRoleUpdate(t *testing.T) {
 
 		if testCase.expectedError == nil {
 			assert.Equal(t, map[string]string{"test": "test"}, testCase.testClusterRole.Object.Labels)
-			assert.Equal(t, roleBuilder.Definition, roleBuilder.Object)
+
+			// The resource version is incremented by the fake cluster on update.
+			updatedClusterRole := roleBuilder.Object.DeepCopy()
+			assert.Equal(t, "1000", updatedClusterRole.ResourceVersion)
+
+			updatedClusterRole.ResourceVersion = roleBuilder.Definition.ResourceVersion
+			assert.Equal(t, roleBuilder.Definition, updatedClusterRole)
 		}
 	}
 }
@@ -296,7 +296,13 @@ func TestRoleUpdate(t *testing.T) {
 
 		if testCase.expectedError == nil {
 			assert.Equal(t, map[string]string{"test": "test"}, testCase.testRole.Object.Labels)
-			assert.Equal(t, roleBuilder.Definition, roleBuilder.Object)
+
+			// The resource version is incremented by the fake cluster on update.
+			updatedRole := roleBuilder.Object.DeepCopy()
+			assert.Equal(t, "1000", updatedRole.ResourceVersion)
+
+			updatedRole.ResourceVersion = roleBuilder.Definition.ResourceVersion
+			assert.Equal(t, roleBuilder.Definition, updatedRole)
 		}
 	}
 }
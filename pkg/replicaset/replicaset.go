@@ -251,6 +251,11 @@ func (builder *Builder) WithAdditionalContainerSpecs(specs []corev1.Container) *
 
 // Exists checks whether the given replicaset exists.
 func (builder *Builder) Exists() bool {
+	return builder.ExistsWithContext(context.Background())
+}
+
+// ExistsWithContext checks whether the given replicaset exists using the provided context.
+func (builder *Builder) ExistsWithContext(ctx context.Context) bool {
 	if valid, _ := builder.validate(); !valid {
 		return false
 	}
@@ -260,13 +265,19 @@ func (builder *Builder) Exists() bool {
 
 	var err error
 	builder.Object, err = builder.apiClient.ReplicaSets(builder.Definition.Namespace).Get(
-		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+		ctx, builder.Definition.Name, metav1.GetOptions{})
 
 	return err == nil || !k8serrors.IsNotFound(err)
 }
 
 // Create builds replicaset in the cluster and stores the created object in struct.
 func (builder *Builder) Create() (*Builder, error) {
+	return builder.CreateWithContext(context.Background())
+}
+
+// CreateWithContext builds replicaset in the cluster using the provided context and stores the created object in
+// struct.
+func (builder *Builder) CreateWithContext(ctx context.Context) (*Builder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
@@ -275,9 +286,9 @@ func (builder *Builder) Create() (*Builder, error) {
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		builder.Object, err = builder.apiClient.ReplicaSets(builder.Definition.Namespace).Create(
-			context.TODO(), builder.Definition, metav1.CreateOptions{})
+			ctx, builder.Definition, metav1.CreateOptions{})
 	}
 
 	return builder, err
@@ -285,6 +296,12 @@ func (builder *Builder) Create() (*Builder, error) {
 
 // Update renovates the existing replicaset object with replicaset definition in builder.
 func (builder *Builder) Update() (*Builder, error) {
+	return builder.UpdateWithContext(context.Background())
+}
+
+// UpdateWithContext renovates the existing replicaset object with replicaset definition in builder using the provided
+// context.
+func (builder *Builder) UpdateWithContext(ctx context.Context) (*Builder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
@@ -294,13 +311,18 @@ func (builder *Builder) Update() (*Builder, error) {
 
 	var err error
 	builder.Object, err = builder.apiClient.ReplicaSets(builder.Definition.Namespace).Update(
-		context.TODO(), builder.Definition, metav1.UpdateOptions{})
+		ctx, builder.Definition, metav1.UpdateOptions{})
 
 	return builder, err
 }
 
 // Delete removes the replicaset.
 func (builder *Builder) Delete() error {
+	return builder.DeleteWithContext(context.Background())
+}
+
+// DeleteWithContext removes the replicaset using the provided context.
+func (builder *Builder) DeleteWithContext(ctx context.Context) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
@@ -308,7 +330,7 @@ func (builder *Builder) Delete() error {
 	glog.V(100).Infof("Deleting replicaset %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		glog.V(100).Infof("ReplicaSet %s in namespaces %s does not exist",
 			builder.Definition.Name, builder.Definition.Namespace)
 
@@ -318,7 +340,7 @@ func (builder *Builder) Delete() error {
 	}
 
 	err := builder.apiClient.ReplicaSets(builder.Definition.Namespace).Delete(
-		context.TODO(), builder.Definition.Name, metav1.DeleteOptions{})
+		ctx, builder.Definition.Name, metav1.DeleteOptions{})
 
 	if err != nil {
 		return err
@@ -331,6 +353,13 @@ func (builder *Builder) Delete() error {
 
 // CreateAndWaitUntilReady creates a replicaset in the cluster and waits until the replicaset is available.
 func (builder *Builder) CreateAndWaitUntilReady(timeout time.Duration) (*Builder, error) {
+	return builder.CreateAndWaitUntilReadyWithContext(context.Background(), timeout)
+}
+
+// CreateAndWaitUntilReadyWithContext creates a replicaset in the cluster and waits until the replicaset is available or
+// ctx is done.
+func (builder *Builder) CreateAndWaitUntilReadyWithContext(
+	ctx context.Context, timeout time.Duration) (*Builder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
@@ -339,7 +368,7 @@ func (builder *Builder) CreateAndWaitUntilReady(timeout time.Duration) (*Builder
 		"until it is ready",
 		builder.Definition.Name, builder.Definition.Namespace)
 
-	_, err := builder.Create()
+	_, err := builder.CreateWithContext(ctx)
 	if err != nil {
 		glog.V(100).Infof("Failed to create replicaset. Error is: '%s'", err.Error())
 
@@ -348,9 +377,9 @@ func (builder *Builder) CreateAndWaitUntilReady(timeout time.Duration) (*Builder
 
 	// Polls every retryInterval to determine if replicaset is available.
 	err = wait.PollUntilContextTimeout(
-		context.TODO(), retryInterval, timeout, true, func(ctx context.Context) (bool, error) {
+		ctx, retryInterval, timeout, true, func(ctx context.Context) (bool, error) {
 			builder.Object, err = builder.apiClient.ReplicaSets(builder.Definition.Namespace).Get(
-				context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+				ctx, builder.Definition.Name, metav1.GetOptions{})
 
 			if err != nil {
 				return false, nil
@@ -372,6 +401,11 @@ func (builder *Builder) CreateAndWaitUntilReady(timeout time.Duration) (*Builder
 
 // DeleteAndWait deletes a replicaset and waits until it is removed from the cluster.
 func (builder *Builder) DeleteAndWait(timeout time.Duration) error {
+	return builder.DeleteAndWaitWithContext(context.Background(), timeout)
+}
+
+// DeleteAndWaitWithContext deletes a replicaset and waits until it is removed from the cluster or ctx is done.
+func (builder *Builder) DeleteAndWaitWithContext(ctx context.Context, timeout time.Duration) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
@@ -380,15 +414,15 @@ func (builder *Builder) DeleteAndWait(timeout time.Duration) error {
 		"until it is removed",
 		builder.Definition.Name, builder.Definition.Namespace)
 
-	if err := builder.Delete(); err != nil {
+	if err := builder.DeleteWithContext(ctx); err != nil {
 		return err
 	}
 
 	// Polls the replicaset every retryInterval until it is removed.
 	return wait.PollUntilContextTimeout(
-		context.TODO(), retryInterval, timeout, true, func(ctx context.Context) (bool, error) {
+		ctx, retryInterval, timeout, true, func(ctx context.Context) (bool, error) {
 			_, err := builder.apiClient.ReplicaSets(builder.Definition.Namespace).Get(
-				context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+				ctx, builder.Definition.Name, metav1.GetOptions{})
 			if k8serrors.IsNotFound(err) {
 				return true, nil
 			}
@@ -399,6 +433,11 @@ func (builder *Builder) DeleteAndWait(timeout time.Duration) error {
 
 // IsReady waits for the replicaset to reach expected number of pods in Ready state.
 func (builder *Builder) IsReady(timeout time.Duration) bool {
+	return builder.IsReadyWithContext(context.Background(), timeout)
+}
+
+// IsReadyWithContext waits for the replicaset to reach expected number of pods in Ready state or for ctx to be done.
+func (builder *Builder) IsReadyWithContext(ctx context.Context, timeout time.Duration) bool {
 	if valid, _ := builder.validate(); !valid {
 		return false
 	}
@@ -408,14 +447,14 @@ func (builder *Builder) IsReady(timeout time.Duration) bool {
 
 	// Polls every retryInterval to determine if replicaset is available.
 	err := wait.PollUntilContextTimeout(
-		context.TODO(), retryInterval, timeout, true, func(ctx context.Context) (bool, error) {
-			if !builder.Exists() {
+		ctx, retryInterval, timeout, true, func(ctx context.Context) (bool, error) {
+			if !builder.ExistsWithContext(ctx) {
 				return false, fmt.Errorf("replicaset %s is not present on cluster", builder.Object.Name)
 			}
 
 			var err error
 			builder.Object, err = builder.apiClient.ReplicaSets(builder.Definition.Namespace).Get(
-				context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+				ctx, builder.Definition.Name, metav1.GetOptions{})
 
 			if err != nil {
 				glog.V(100).Infof("Failed to get replicaset from cluster. Error is: '%s'", err.Error())
@@ -118,6 +118,14 @@ func SetProperty(propertyKey, propertyValue string) ginkgo.Labels {
 	return ginkgo.Label(fmt.Sprintf("%s-%s:%s", config.ParameterTag, propertyKey, propertyValue))
 }
 
+// AddProperty attaches a property to the running test case, for example the path of the diagnostics collected when
+// it failed. Unlike SetProperty, the value may be computed while the spec runs, so it must be called from one of its
+// nodes such as JustAfterEach or AfterEach.
+func AddProperty(propertyKey, propertyValue string) {
+	ginkgo.AddReportEntry(
+		fmt.Sprintf("%s-%s", config.ParameterTag, propertyKey), propertyValue, ginkgo.ReportEntryVisibilityNever)
+}
+
 func newConfig() (*settings, error) {
 	var setting settings
 
@@ -166,23 +174,30 @@ func setTestID(testReport types.SpecReport, projectTag string) *Property {
 }
 
 func setProperty(testReport types.SpecReport) []*Property {
-	if len(testReport.Labels()) > 0 {
-		var tcProperties []*Property
-
-		for _, label := range testReport.Labels() {
-			if strings.Contains(label, config.ParameterTag) {
-				tcProperties = append(tcProperties, &Property{
-					Name:  strings.Split(label, ":")[0],
-					Value: strings.Split(label, ":")[1],
-				})
-			}
+	var tcProperties []*Property
+
+	for _, label := range testReport.Labels() {
+		if strings.Contains(label, config.ParameterTag) {
+			tcProperties = append(tcProperties, &Property{
+				Name:  strings.Split(label, ":")[0],
+				Value: strings.Split(label, ":")[1],
+			})
 		}
+	}
 
-		if len(tcProperties) > 0 {
-			return tcProperties
+	for _, entry := range testReport.ReportEntries {
+		if strings.HasPrefix(entry.Name, config.ParameterTag+"-") {
+			tcProperties = append(tcProperties, &Property{
+				Name:  entry.Name,
+				Value: entry.StringRepresentation(),
+			})
 		}
 	}
 
+	if len(tcProperties) > 0 {
+		return tcProperties
+	}
+
 	return nil
 }
 
@@ -192,13 +192,21 @@ func TestSetTestID(t *testing.T) {
 
 func TestReportSetProperty(t *testing.T) {
 	testCases := []struct {
-		labels [][]string
-		valid  bool
+		labels  [][]string
+		entries types.ReportEntries
+		valid   bool
 	}{
 		{
 			labels: [][]string{{"parameter-id:1111"}},
 			valid:  true,
 		},
+		{
+			entries: types.ReportEntries{
+				{Name: "parameter-diagnostics", Value: types.WrapEntryValue("/tmp/diagnostics.tar.gz")},
+				{Name: "id", Value: types.WrapEntryValue("1111")},
+			},
+			valid: true,
+		},
 		{
 			labels: [][]string{{"id:1111"}},
 			valid:  false,
@@ -210,11 +218,13 @@ func TestReportSetProperty(t *testing.T) {
 	}
 	for _, testCase := range testCases {
 		report := ginkgo.SpecReport{
-			ContainerHierarchyLabels: testCase.labels}
+			ContainerHierarchyLabels: testCase.labels,
+			ReportEntries:            testCase.entries,
+		}
 
 		properties := setProperty(report)
 		if testCase.valid {
-			assert.NotNil(t, properties)
+			assert.Len(t, properties, 1)
 		} else {
 			assert.Nil(t, properties)
 		}
@@ -3,29 +3,20 @@ package route
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
-	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/common"
 	routev1 "github.com/openshift/api/route/v1"
-	k8serrors "k8s.io/apimachinery/pkg/api/errors"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/utils/strings/slices"
-
-	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // Builder provides struct for route object containing connection to the cluster and the route definitions.
 type Builder struct {
-	// Route definition. Used to create a route object
-	Definition *routev1.Route
-	// Created route object
-	Object *routev1.Route
-	// Used in functions that define or mutate the route definition.
-	// errorMsg is processed before the route object is created
-	errorMsg  string
-	apiClient goclient.Client
+	common.EmbeddableBuilder[routev1.Route, *routev1.Route]
 }
 
 // NewBuilder creates a new instance of Builder.
@@ -34,48 +25,24 @@ func NewBuilder(apiClient *clients.Settings, name, nsname, serviceName string) *
 		"Initializing new route structure with the following params: name: %s, namespace: %s, serviceName: %s",
 		name, nsname, serviceName)
 
-	if apiClient == nil {
-		glog.V(100).Infof("The apiClient is nil")
-
+	builder := common.NewNamespacedBuilder[routev1.Route, Builder](apiClient, routev1.Install, name, nsname)
+	if builder == nil {
 		return nil
 	}
 
-	builder := &Builder{
-		apiClient: apiClient.Client,
-		Definition: &routev1.Route{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      name,
-				Namespace: nsname,
-			},
-			Spec: routev1.RouteSpec{
-				To: routev1.RouteTargetReference{
-					Kind: "Service",
-					Name: serviceName,
-				},
-			},
-		},
-	}
-
-	if name == "" {
-		glog.V(100).Infof("The name of the route is empty")
-
-		builder.errorMsg = "route 'name' cannot be empty"
-
-		return builder
+	builder.Definition.Spec.To = routev1.RouteTargetReference{
+		Kind: "Service",
+		Name: serviceName,
 	}
 
-	if nsname == "" {
-		glog.V(100).Infof("The namespace of the route is empty")
-
-		builder.errorMsg = "route 'nsname' cannot be empty"
-
+	if builder.GetErrorMessage() != "" {
 		return builder
 	}
 
 	if serviceName == "" {
 		glog.V(100).Infof("The serviceName of the route is empty")
 
-		builder.errorMsg = "route 'serviceName' cannot be empty"
+		builder.SetErrorMessage("route 'serviceName' cannot be empty")
 
 		return builder
 	}
@@ -85,43 +52,14 @@ func NewBuilder(apiClient *clients.Settings, name, nsname, serviceName string) *
 
 // Pull loads existing route from cluster.
 func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
-	glog.V(100).Infof("Pulling existing route name %s under namespace %s from cluster", name, nsname)
-
-	if apiClient == nil {
-		glog.V(100).Infof("The apiClient is nil")
-
-		return nil, fmt.Errorf("the apiClient cannot be nil")
-	}
-
-	builder := &Builder{
-		apiClient: apiClient.Client,
-		Definition: &routev1.Route{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      name,
-				Namespace: nsname,
-			},
-		},
-	}
-
-	if name == "" {
-		glog.V(100).Infof("The name of the route is empty")
-
-		return nil, fmt.Errorf("route 'name' cannot be empty")
-	}
-
-	if nsname == "" {
-		glog.V(100).Infof("The namespace of the route is empty")
-
-		return nil, fmt.Errorf("route 'namespace' cannot be empty")
-	}
-
-	if !builder.Exists() {
-		return nil, fmt.Errorf("route object %s does not exist in namespace %s", name, nsname)
-	}
+	return PullWithContext(context.Background(), apiClient, name, nsname)
+}
 
-	builder.Definition = builder.Object
+// PullWithContext loads existing route from cluster using the provided context.
+func PullWithContext(ctx context.Context, apiClient *clients.Settings, name, nsname string) (*Builder, error) {
+	glog.V(100).Infof("Pulling existing route name %s under namespace %s from cluster", name, nsname)
 
-	return builder, nil
+	return common.PullNamespacedBuilderWithContext[routev1.Route, Builder](ctx, apiClient, routev1.Install, name, nsname)
 }
 
 // WithTargetPortNumber adds a target port to the route by number.
@@ -154,10 +92,10 @@ func (builder *Builder) WithTargetPortName(portName string) *Builder {
 	if portName == "" {
 		glog.V(100).Infof("Received empty route portName")
 
-		builder.errorMsg = "route target port name cannot be empty string"
+		builder.SetErrorMessage("route target port name cannot be empty string")
 	}
 
-	if builder.errorMsg != "" {
+	if builder.GetErrorMessage() != "" {
 		return builder
 	}
 
@@ -182,7 +120,7 @@ func (builder *Builder) WithHostDomain(hostDomain string) *Builder {
 	if hostDomain == "" {
 		glog.V(100).Infof("Received empty route hostDomain")
 
-		builder.errorMsg = "route host domain cannot be empty string"
+		builder.SetErrorMessage("route host domain cannot be empty string")
 
 		return builder
 	}
@@ -204,8 +142,8 @@ func (builder *Builder) WithWildCardPolicy(wildcardPolicy string) *Builder {
 	if !slices.Contains(supportedWildCardPolicies(), wildcardPolicy) {
 		glog.V(100).Infof("Received unsupported route wildcardPolicy, supported policies: %v", supportedWildCardPolicies())
 
-		builder.errorMsg = fmt.Sprintf("received unsupported route wildcardPolicy: supported policies %v",
-			supportedWildCardPolicies())
+		builder.SetErrorMessage(fmt.Sprintf("received unsupported route wildcardPolicy: supported policies %v",
+			supportedWildCardPolicies()))
 
 		return builder
 	}
@@ -217,123 +155,106 @@ func (builder *Builder) WithWildCardPolicy(wildcardPolicy string) *Builder {
 
 // Exists checks whether the given route exists.
 func (builder *Builder) Exists() bool {
-	if valid, _ := builder.validate(); !valid {
-		return false
-	}
-
-	glog.V(100).Infof(
-		"Checking if route %s exists in namespace %s",
-		builder.Definition.Name, builder.Definition.Namespace)
-
-	var err error
-	builder.Object, err = builder.Get()
+	return builder.ExistsWithContext(context.Background())
+}
 
-	return err == nil || !k8serrors.IsNotFound(err)
+// ExistsWithContext checks whether the given route exists using the provided context for the API call.
+func (builder *Builder) ExistsWithContext(ctx context.Context) bool {
+	return common.Exists(ctx, builder)
 }
 
 // Get returns route object if found.
 func (builder *Builder) Get() (*routev1.Route, error) {
-	if valid, err := builder.validate(); !valid {
-		return nil, err
-	}
-
-	glog.V(100).Infof(
-		"Getting route %s in namespace %s",
-		builder.Definition.Name, builder.Definition.Namespace)
+	return builder.GetWithContext(context.Background())
+}
 
-	route := &routev1.Route{}
+// GetWithContext returns route object if found using the provided context for the API call.
+func (builder *Builder) GetWithContext(ctx context.Context) (*routev1.Route, error) {
+	return common.Get(ctx, builder)
+}
 
-	err := builder.apiClient.Get(context.TODO(), goclient.ObjectKey{
-		Name:      builder.Definition.Name,
-		Namespace: builder.Definition.Namespace,
-	}, route)
+// Create makes a route according to the route definition and stores the created object in the route builder.
+func (builder *Builder) Create() (*Builder, error) {
+	return builder.CreateWithContext(context.Background())
+}
 
-	if err != nil {
-		return nil, err
-	}
+// CreateWithContext makes a route according to the route definition using the provided context for the API calls.
+func (builder *Builder) CreateWithContext(ctx context.Context) (*Builder, error) {
+	return builder, common.Create(ctx, builder)
+}
 
-	return route, nil
+// Update renews the existing route object with the route definition in the builder. Conflicts with concurrent
+// modifications are returned and can be checked with k8serrors.IsConflict.
+func (builder *Builder) Update() (*Builder, error) {
+	return builder.UpdateWithContext(context.Background())
 }
 
-// Create makes a route according to the route definition and stores the created object in the route builder.
-func (builder *Builder) Create() (*Builder, error) {
-	if valid, err := builder.validate(); !valid {
-		return builder, err
-	}
+// UpdateWithContext renews the existing route object with the route definition using the provided context for the API
+// call.
+func (builder *Builder) UpdateWithContext(ctx context.Context) (*Builder, error) {
+	return builder, common.Update(ctx, builder)
+}
 
-	glog.V(100).Infof("Creating the route %s in namespace %s",
-		builder.Definition.Name, builder.Definition.Namespace)
+// Apply uses server-side apply to make the route on the cluster match the route definition, creating it if needed.
+// Fields owned by other field managers cause a *clients.ApplyConflictError unless force is true.
+func (builder *Builder) Apply(fieldManager string, force bool) (*Builder, error) {
+	return builder.ApplyWithContext(context.Background(), fieldManager, force)
+}
 
-	var err error
-	if !builder.Exists() {
-		err = builder.apiClient.Create(context.TODO(), builder.Definition)
-		if err == nil {
-			builder.Object = builder.Definition
-		}
-	}
+// ApplyWithContext applies the route definition using the provided context for the API call.
+func (builder *Builder) ApplyWithContext(ctx context.Context, fieldManager string, force bool) (*Builder, error) {
+	return builder, common.Apply(ctx, builder, fieldManager, force)
+}
 
-	return builder, err
+// UpdateWithRetry updates the route with merge semantics. If the route was modified on the cluster since it was
+// last read, the changes made through the builder are re-applied to the latest version and the update is retried
+// according to backoff, for example retry.DefaultRetry.
+func (builder *Builder) UpdateWithRetry(ctx context.Context, backoff wait.Backoff) (*Builder, error) {
+	return builder, common.UpdateWithRetry(ctx, builder, backoff)
 }
 
 // Delete removes the route object and resets the builder object.
 func (builder *Builder) Delete() (*Builder, error) {
-	if valid, err := builder.validate(); !valid {
-		return builder, err
-	}
-
-	glog.V(100).Infof("Deleting the route %s in namespace %s",
-		builder.Definition.Name, builder.Definition.Namespace)
-
-	if !builder.Exists() {
-		glog.V(100).Infof("Route %s does not exist in namespace %s",
-			builder.Definition.Name, builder.Definition.Namespace)
+	return builder.DeleteWithContext(context.Background())
+}
 
-		builder.Object = nil
+// DeleteWithContext removes the route object using the provided context for the API calls.
+func (builder *Builder) DeleteWithContext(ctx context.Context) (*Builder, error) {
+	return builder, common.Delete(ctx, builder)
+}
 
-		return builder, nil
-	}
+// DeleteAndWait removes the route object and waits until it is deleted from the cluster.
+func (builder *Builder) DeleteAndWait(timeout time.Duration) error {
+	return builder.DeleteAndWaitWithContext(context.Background(), timeout)
+}
 
-	err := builder.apiClient.Delete(context.TODO(), builder.Definition)
+// DeleteAndWaitWithContext removes the route object and waits until it is deleted from the cluster or ctx is done.
+func (builder *Builder) DeleteAndWaitWithContext(ctx context.Context, timeout time.Duration) error {
+	return common.DeleteAndWait(ctx, builder, timeout)
+}
 
-	if err != nil {
-		return builder, fmt.Errorf("cannot delete route: %w", err)
-	}
+// WaitUntilDeleted waits for the duration of the defined timeout or until the route is deleted.
+func (builder *Builder) WaitUntilDeleted(timeout time.Duration) error {
+	return builder.WaitUntilDeletedWithContext(context.Background(), timeout)
+}
 
-	builder.Object = nil
+// WaitUntilDeletedWithContext waits for the duration of the defined timeout, until ctx is done or until the route is
+// deleted.
+func (builder *Builder) WaitUntilDeletedWithContext(ctx context.Context, timeout time.Duration) error {
+	return common.WaitUntilDeleted(ctx, builder, timeout)
+}
 
-	return builder, nil
+// GetKind returns the kind of the resource managed by the builder. It does not dereference the builder.
+func (builder *Builder) GetKind() string {
+	return "Route"
 }
 
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *Builder) validate() (bool, error) {
-	resourceCRD := "Route"
-
-	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
-
-		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
-	}
-
-	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
-
-		return false, fmt.Errorf("%s", msg.UndefinedCrdObjectErrString(resourceCRD))
-	}
-
-	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
-
-		return false, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD)
-	}
-
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
-
-		return false, fmt.Errorf("%s", builder.errorMsg)
-	}
+	err := common.Validate(builder)
 
-	return true, nil
+	return err == nil, err
 }
 
 func supportedWildCardPolicies() []string {
@@ -48,7 +48,7 @@ func TestNewBuilder(t *testing.T) {
 	for _, test := range testcases {
 		testBuilder := NewBuilder(clients.GetTestClients(clients.TestClientParams{}),
 			test.name, test.namespace, test.targetService)
-		assert.Equal(t, test.expectedErrMsg, testBuilder.errorMsg)
+		assert.Equal(t, test.expectedErrMsg, testBuilder.GetErrorMessage())
 	}
 }
 
@@ -173,7 +173,7 @@ func TestWithTargetPortName(t *testing.T) {
 		testBuilder := buildValidTestBuilder()
 		testBuilder.WithTargetPortName(test.name)
 
-		assert.Equal(t, test.expectedErrMsg, testBuilder.errorMsg)
+		assert.Equal(t, test.expectedErrMsg, testBuilder.GetErrorMessage())
 	}
 }
 
@@ -196,7 +196,7 @@ func TestWithHostDomain(t *testing.T) {
 		testBuilder := buildValidTestBuilder()
 		testBuilder.WithHostDomain(test.hostDomain)
 
-		assert.Equal(t, test.expectedErrMsg, testBuilder.errorMsg)
+		assert.Equal(t, test.expectedErrMsg, testBuilder.GetErrorMessage())
 	}
 }
 
@@ -227,6 +227,6 @@ func TestWithWildCardPolicy(t *testing.T) {
 		testBuilder := buildValidTestBuilder()
 		testBuilder.WithWildCardPolicy(test.policy)
 
-		assert.Equal(t, test.expectedErrMsg, testBuilder.errorMsg)
+		assert.Equal(t, test.expectedErrMsg, testBuilder.GetErrorMessage())
 	}
 }
@@ -2,16 +2,14 @@ package scc
 
 import (
 	"context"
-	"fmt"
+	"time"
 
 	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
-	"github.com/openshift-kni/eco-goinfra/pkg/msg"
+	"github.com/openshift-kni/eco-goinfra/pkg/internal/common"
 	securityV1 "github.com/openshift/api/security/v1"
 	corev1 "k8s.io/api/core/v1"
-	k8serrors "k8s.io/apimachinery/pkg/api/errors"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	goclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"k8s.io/apimachinery/pkg/util/wait"
 )
 
 const redefiningMsg = "Redefining SecurityContextConstraints"
@@ -19,14 +17,7 @@ const redefiningMsg = "Redefining SecurityContextConstraints"
 // Builder provides struct for SecurityContextConstraints object containing connection
 // to the cluster SecurityContextConstraints definition.
 type Builder struct {
-	// SecurityContextConstraints definition. Used to create SecurityContextConstraints object
-	Definition *securityV1.SecurityContextConstraints
-	// Created SecurityContextConstraints object
-	Object *securityV1.SecurityContextConstraints
-	// Used in functions that define or mutate SecurityContextConstraints definition. errorMsg is processed
-	// before the SecurityContextConstraints object is created
-	errorMsg  string
-	apiClient *clients.Settings
+	common.EmbeddableBuilder[securityV1.SecurityContextConstraints, *securityV1.SecurityContextConstraints]
 }
 
 // SecurityContextConstraintsAdditionalOptions additional options for SecurityContextConstraints object.
@@ -38,46 +29,23 @@ func NewBuilder(apiClient *clients.Settings, name, runAsUser, selinuxContext str
 		"Initializing new SecurityContextConstraints structure with the following params: "+
 			"name: %s, runAsUser type: %s, selinuxContext type: %s", name, runAsUser, selinuxContext)
 
-	if apiClient == nil {
-		glog.V(100).Infof("The apiClient cannot be nil")
-
+	builder := common.NewClusterScopedBuilder[securityV1.SecurityContextConstraints, Builder](
+		apiClient, securityV1.Install, name)
+	if builder == nil {
 		return nil
 	}
 
-	err := apiClient.AttachScheme(securityV1.Install)
-	if err != nil {
-		glog.V(100).Infof("Failed to add security v1 scheme to client schemes")
-
-		return nil
-	}
-
-	builder := &Builder{
-		apiClient: apiClient,
-		Definition: &securityV1.SecurityContextConstraints{
-			ObjectMeta: metav1.ObjectMeta{
-				Name: name,
-			},
-			RunAsUser: securityV1.RunAsUserStrategyOptions{
-				Type: securityV1.RunAsUserStrategyType(runAsUser),
-			},
-			SELinuxContext: securityV1.SELinuxContextStrategyOptions{
-				Type: securityV1.SELinuxContextStrategyType(selinuxContext),
-			},
-		},
-	}
-
-	if name == "" {
-		glog.V(100).Infof("The name of the SecurityContextConstraints is empty")
-
-		builder.errorMsg = "securityContextConstraints 'name' cannot be empty"
+	builder.Definition.RunAsUser.Type = securityV1.RunAsUserStrategyType(runAsUser)
+	builder.Definition.SELinuxContext.Type = securityV1.SELinuxContextStrategyType(selinuxContext)
 
+	if builder.GetErrorMessage() != "" {
 		return builder
 	}
 
 	if runAsUser == "" {
 		glog.V(100).Infof("The runAsUser of the SecurityContextConstraints is empty")
 
-		builder.errorMsg = "securityContextConstraints 'runAsUser' cannot be empty"
+		builder.SetErrorMessage("securityContextConstraints 'runAsUser' cannot be empty")
 
 		return builder
 	}
@@ -85,7 +53,7 @@ func NewBuilder(apiClient *clients.Settings, name, runAsUser, selinuxContext str
 	if selinuxContext == "" {
 		glog.V(100).Infof("The selinuxContext of the SecurityContextConstraints is empty")
 
-		builder.errorMsg = "securityContextConstraints 'selinuxContext' cannot be empty"
+		builder.SetErrorMessage("securityContextConstraints 'selinuxContext' cannot be empty")
 
 		return builder
 	}
@@ -95,43 +63,15 @@ func NewBuilder(apiClient *clients.Settings, name, runAsUser, selinuxContext str
 
 // Pull pulls existing SecurityContextConstraints from cluster.
 func Pull(apiClient *clients.Settings, name string) (*Builder, error) {
-	glog.V(100).Infof("Pulling existing SecurityContextConstraints object name %s from cluster", name)
-
-	if apiClient == nil {
-		glog.V(100).Infof("The apiClient cannot be nil")
-
-		return nil, fmt.Errorf("the apiClient cannot be nil")
-	}
-
-	err := apiClient.AttachScheme(securityV1.Install)
-	if err != nil {
-		glog.V(100).Infof("Failed to add security v1 scheme to client schemes")
-
-		return nil, fmt.Errorf("failed to add security v1 scheme to client schemes")
-	}
-
-	builder := &Builder{
-		apiClient: apiClient,
-		Definition: &securityV1.SecurityContextConstraints{
-			ObjectMeta: metav1.ObjectMeta{
-				Name: name,
-			},
-		},
-	}
-
-	if name == "" {
-		glog.V(100).Infof("The name of the SecurityContextConstraints is empty")
-
-		return nil, fmt.Errorf("securityContextConstraints 'name' cannot be empty")
-	}
-
-	if !builder.Exists() {
-		return nil, fmt.Errorf("securityContextConstraints object %s does not exist", name)
-	}
+	return PullWithContext(context.Background(), apiClient, name)
+}
 
-	builder.Definition = builder.Object
+// PullWithContext pulls existing SecurityContextConstraints from cluster using the provided context.
+func PullWithContext(ctx context.Context, apiClient *clients.Settings, name string) (*Builder, error) {
+	glog.V(100).Infof("Pulling existing SecurityContextConstraints object name %s from cluster", name)
 
-	return builder, nil
+	return common.PullClusterScopedBuilderWithContext[securityV1.SecurityContextConstraints, Builder](
+		ctx, apiClient, securityV1.Install, name)
 }
 
 // WithPrivilegedContainer adds bool flag to the allowPrivilegedContainer of SecurityContextConstraints.
@@ -257,7 +197,7 @@ func (builder *Builder) WithDropCapabilities(requiredDropCapabilities []corev1.C
 	if len(requiredDropCapabilities) == 0 {
 		glog.V(100).Infof("SecurityContextConstraints 'requiredDropCapabilities' argument cannot be empty")
 
-		builder.errorMsg = "securityContextConstraints 'requiredDropCapabilities' cannot be empty list"
+		builder.SetErrorMessage("securityContextConstraints 'requiredDropCapabilities' cannot be empty list")
 
 		return builder
 	}
@@ -286,7 +226,7 @@ func (builder *Builder) WithAllowCapabilities(allowCapabilities []corev1.Capabil
 	if len(allowCapabilities) == 0 {
 		glog.V(100).Infof("SecurityContextConstraints 'allowCapabilities' argument cannot be empty")
 
-		builder.errorMsg = "securityContextConstraints 'allowCapabilities' cannot be empty list"
+		builder.SetErrorMessage("securityContextConstraints 'allowCapabilities' cannot be empty list")
 
 		return builder
 	}
@@ -314,7 +254,7 @@ func (builder *Builder) WithDefaultAddCapabilities(defaultAddCapabilities []core
 	if len(defaultAddCapabilities) == 0 {
 		glog.V(100).Infof("SecurityContextConstraints 'defaultAddCapabilities' argument cannot be empty")
 
-		builder.errorMsg = "securityContextConstraints 'defaultAddCapabilities' cannot be empty list"
+		builder.SetErrorMessage("securityContextConstraints 'defaultAddCapabilities' cannot be empty list")
 
 		return builder
 	}
@@ -355,7 +295,7 @@ func (builder *Builder) WithFSGroup(fsGroup string) *Builder {
 	if fsGroup == "" {
 		glog.V(100).Infof("SecurityContextConstraints 'fsGroup' argument cannot be empty")
 
-		builder.errorMsg = "securityContextConstraints 'fsGroup' cannot be empty string"
+		builder.SetErrorMessage("securityContextConstraints 'fsGroup' cannot be empty string")
 
 		return builder
 	}
@@ -377,7 +317,7 @@ func (builder *Builder) WithFSGroupRange(fsGroupMin, fsGroupMax int64) *Builder
 	if fsGroupMin > fsGroupMax {
 		glog.V(100).Infof("SecurityContextConstraints 'fsGroupMin' argument can not be greater than fsGroupMax")
 
-		builder.errorMsg = "securityContextConstraints 'fsGroupMin' argument can not be greater than fsGroupMax"
+		builder.SetErrorMessage("securityContextConstraints 'fsGroupMin' argument can not be greater than fsGroupMax")
 
 		return builder
 	}
@@ -405,7 +345,7 @@ func (builder *Builder) WithGroups(groups []string) *Builder {
 	if len(groups) == 0 {
 		glog.V(100).Infof("SecurityContextConstraints 'groups' argument cannot be empty")
 
-		builder.errorMsg = "securityContextConstraints 'fsGroupType' cannot be empty string"
+		builder.SetErrorMessage("securityContextConstraints 'fsGroupType' cannot be empty string")
 
 		return builder
 	}
@@ -433,7 +373,7 @@ func (builder *Builder) WithSeccompProfiles(seccompProfiles []string) *Builder {
 	if len(seccompProfiles) == 0 {
 		glog.V(100).Infof("SecurityContextConstraints 'seccompProfiles' argument cannot be empty")
 
-		builder.errorMsg = "securityContextConstraints 'seccompProfiles' cannot be empty list"
+		builder.SetErrorMessage("securityContextConstraints 'seccompProfiles' cannot be empty list")
 
 		return builder
 	}
@@ -461,7 +401,7 @@ func (builder *Builder) WithSupplementalGroups(supplementalGroupsType string) *B
 	if supplementalGroupsType == "" {
 		glog.V(100).Infof("SecurityContextConstraints 'SupplementalGroups' argument cannot be empty")
 
-		builder.errorMsg = "securityContextConstraints 'SupplementalGroups' cannot be empty string"
+		builder.SetErrorMessage("securityContextConstraints 'SupplementalGroups' cannot be empty string")
 
 		return builder
 	}
@@ -482,7 +422,7 @@ func (builder *Builder) WithUsers(users []string) *Builder {
 	if len(users) == 0 {
 		glog.V(100).Infof("SecurityContextConstraints 'users' argument cannot be empty")
 
-		builder.errorMsg = "securityContextConstraints 'users' cannot be empty list"
+		builder.SetErrorMessage("securityContextConstraints 'users' cannot be empty list")
 
 		return builder
 	}
@@ -509,7 +449,7 @@ func (builder *Builder) WithVolumes(volumes []securityV1.FSType) *Builder {
 	if len(volumes) == 0 {
 		glog.V(100).Infof("SecurityContextConstraints 'volumes' argument cannot be empty")
 
-		builder.errorMsg = "securityContextConstraints 'volumes' cannot be empty list"
+		builder.SetErrorMessage("securityContextConstraints 'volumes' cannot be empty list")
 
 		return builder
 	}
@@ -527,137 +467,113 @@ func (builder *Builder) WithVolumes(volumes []securityV1.FSType) *Builder {
 
 // Create generates a SecurityContextConstraints and stores the created object in struct.
 func (builder *Builder) Create() (*Builder, error) {
-	if valid, err := builder.validate(); !valid {
-		return builder, err
-	}
-
-	glog.V(100).Infof("Creating SecurityContextConstraints %s", builder.Definition.Name)
-
-	var err error
-	if !builder.Exists() {
-		err = builder.apiClient.Create(context.TODO(), builder.Definition)
-
-		if err != nil {
-			glog.V(100).Infof("Failed to create SecurityContextConstraints")
+	return builder.CreateWithContext(context.Background())
+}
 
-			return nil, err
-		}
+// CreateWithContext generates a SecurityContextConstraints using the provided context for the API calls.
+func (builder *Builder) CreateWithContext(ctx context.Context) (*Builder, error) {
+	if err := common.Create(ctx, builder); err != nil {
+		return nil, err
 	}
 
-	builder.Object = builder.Definition
+	return builder, nil
+}
+
+// Apply uses server-side apply to make the SecurityContextConstraints on the cluster match the definition, creating
+// it if needed. Fields owned by other field managers cause a *clients.ApplyConflictError unless force is true.
+func (builder *Builder) Apply(fieldManager string, force bool) (*Builder, error) {
+	return builder.ApplyWithContext(context.Background(), fieldManager, force)
+}
 
-	return builder, err
+// ApplyWithContext applies the SecurityContextConstraints definition using the provided context for the API call.
+func (builder *Builder) ApplyWithContext(ctx context.Context, fieldManager string, force bool) (*Builder, error) {
+	return builder, common.Apply(ctx, builder, fieldManager, force)
 }
 
 // Delete removes a SecurityContextConstraints.
 func (builder *Builder) Delete() error {
-	if valid, err := builder.validate(); !valid {
-		return err
-	}
-
-	glog.V(100).Infof("Removing SecurityContextConstraints %s", builder.Definition.Name)
-
-	if !builder.Exists() {
-		glog.V(100).Infof("SecurityContextConstraints %s does not exist", builder.Definition.Name)
+	return builder.DeleteWithContext(context.Background())
+}
 
-		builder.Object = nil
+// DeleteWithContext removes a SecurityContextConstraints using the provided context for the API calls.
+func (builder *Builder) DeleteWithContext(ctx context.Context) error {
+	return common.Delete(ctx, builder)
+}
 
-		return nil
-	}
+// DeleteAndWait removes a SecurityContextConstraints and waits until it is deleted from the cluster.
+func (builder *Builder) DeleteAndWait(timeout time.Duration) error {
+	return builder.DeleteAndWaitWithContext(context.Background(), timeout)
+}
 
-	err := builder.apiClient.Delete(context.TODO(), builder.Definition)
-	if err != nil {
-		return err
-	}
+// DeleteAndWaitWithContext removes a SecurityContextConstraints and waits until it is deleted from the cluster or ctx
+// is done.
+func (builder *Builder) DeleteAndWaitWithContext(ctx context.Context, timeout time.Duration) error {
+	return common.DeleteAndWait(ctx, builder, timeout)
+}
 
-	builder.Object = nil
+// WaitUntilDeleted waits for the duration of the defined timeout or until the SecurityContextConstraints is deleted.
+func (builder *Builder) WaitUntilDeleted(timeout time.Duration) error {
+	return builder.WaitUntilDeletedWithContext(context.Background(), timeout)
+}
 
-	return nil
+// WaitUntilDeletedWithContext waits for the duration of the defined timeout, until ctx is done or until the
+// SecurityContextConstraints is deleted.
+func (builder *Builder) WaitUntilDeletedWithContext(ctx context.Context, timeout time.Duration) error {
+	return common.WaitUntilDeleted(ctx, builder, timeout)
 }
 
 // Update modifies an existing SecurityContextConstraints in the cluster.
 func (builder *Builder) Update() (*Builder, error) {
-	if valid, err := builder.validate(); !valid {
-		return builder, err
-	}
-
-	glog.V(100).Infof("Updating SecurityContextConstraints %s ", builder.Definition.Name)
-
-	if !builder.Exists() {
-		return nil, fmt.Errorf("failed to update SecurityContextConstraints, object does not exist on cluster")
-	}
+	return builder.UpdateWithContext(context.Background())
+}
 
-	err := builder.apiClient.Update(context.TODO(), builder.Definition)
+// UpdateWithContext modifies an existing SecurityContextConstraints using the provided context for the API calls.
+// Conflicts with concurrent modifications are returned and can be checked with k8serrors.IsConflict.
+func (builder *Builder) UpdateWithContext(ctx context.Context) (*Builder, error) {
+	return builder, common.Update(ctx, builder)
+}
 
-	return builder, err
+// UpdateWithRetry updates the SecurityContextConstraints with merge semantics. If the SecurityContextConstraints was
+// modified on the cluster since it was last read, the changes made through the builder are re-applied to the latest
+// version and the update is retried according to backoff, for example retry.DefaultRetry.
+func (builder *Builder) UpdateWithRetry(ctx context.Context, backoff wait.Backoff) (*Builder, error) {
+	return builder, common.UpdateWithRetry(ctx, builder, backoff)
 }
 
-// Get returns NMState object if found.
+// Get returns SecurityContextConstraints object if found.
 func (builder *Builder) Get() (*securityV1.SecurityContextConstraints, error) {
-	if valid, err := builder.validate(); !valid {
-		return nil, err
-	}
-
-	glog.V(100).Infof("Collecting SecurityContextConstraints object %s", builder.Definition.Name)
-
-	scc := &securityV1.SecurityContextConstraints{}
-	err := builder.apiClient.Get(context.TODO(), goclient.ObjectKey{Name: builder.Definition.Name}, scc)
-
-	if err != nil {
-		glog.V(100).Infof("SecurityContextConstraints object %s does not exist", builder.Definition.Name)
-
-		return nil, err
-	}
+	return builder.GetWithContext(context.Background())
+}
 
-	return scc, nil
+// GetWithContext returns SecurityContextConstraints object if found using the provided context.
+func (builder *Builder) GetWithContext(ctx context.Context) (*securityV1.SecurityContextConstraints, error) {
+	return common.Get(ctx, builder)
 }
 
 // Exists checks whether the given SecurityContextConstraints exists.
 func (builder *Builder) Exists() bool {
-	if valid, _ := builder.validate(); !valid {
-		return false
-	}
-
-	glog.V(100).Infof("Checking if SecurityContextConstraints %s exists", builder.Definition.Name)
+	return builder.ExistsWithContext(context.Background())
+}
 
-	var err error
-	builder.Object, err = builder.Get()
+// ExistsWithContext checks whether the given SecurityContextConstraints exists using the provided context.
+func (builder *Builder) ExistsWithContext(ctx context.Context) bool {
+	return common.Exists(ctx, builder)
+}
 
-	if err != nil {
-		glog.V(100).Infof("Failed to collect SecurityContextConstraints object due to %s", err.Error())
-	}
+// WithOptions creates SecurityContextConstraints with generic mutation options.
+func (builder *Builder) WithOptions(options ...SecurityContextConstraintsAdditionalOptions) *Builder {
+	return common.WithOptions(builder, options...)
+}
 
-	return err == nil || !k8serrors.IsNotFound(err)
+// GetKind returns the kind of the resource managed by the builder. It does not dereference the builder.
+func (builder *Builder) GetKind() string {
+	return "SecurityContextConstraints"
 }
 
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *Builder) validate() (bool, error) {
-	resourceCRD := "SecurityContextConstraints"
-
-	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
-
-		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
-	}
-
-	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
-
-		return false, fmt.Errorf("%s", msg.UndefinedCrdObjectErrString(resourceCRD))
-	}
-
-	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
-
-		return false, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD)
-	}
-
-	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
-
-		return false, fmt.Errorf("%s", builder.errorMsg)
-	}
+	err := common.Validate(builder)
 
-	return true, nil
+	return err == nil, err
 }
@@ -80,6 +80,11 @@ func NewBuilder(apiClient *clients.Settings, name, nsname string, secretType cor
 
 // Pull loads an existing secret into Builder struct.
 func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
+	return PullWithContext(context.Background(), apiClient, name, nsname)
+}
+
+// PullWithContext loads an existing secret like Pull using the provided context.
+func PullWithContext(ctx context.Context, apiClient *clients.Settings, name, nsname string) (*Builder, error) {
 	glog.V(100).Infof("Pulling existing secret name: %s under namespace: %s", name, nsname)
 
 	if apiClient == nil {
@@ -110,7 +115,7 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
 		return nil, fmt.Errorf("secret 'nsname' cannot be empty")
 	}
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		return nil, fmt.Errorf("secret object %s does not exist in namespace %s", name, nsname)
 	}
 
@@ -121,6 +126,11 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
 
 // Create makes a secret in the cluster and stores the created object in struct.
 func (builder *Builder) Create() (*Builder, error) {
+	return builder.CreateWithContext(context.Background())
+}
+
+// CreateWithContext makes a secret in the cluster using the provided context and stores the created object in struct.
+func (builder *Builder) CreateWithContext(ctx context.Context) (*Builder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
@@ -128,9 +138,9 @@ func (builder *Builder) Create() (*Builder, error) {
 	glog.V(100).Infof("Creating the secret %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		builder.Object, err = builder.apiClient.Secrets(builder.Definition.Namespace).Create(
-			context.TODO(), builder.Definition, metav1.CreateOptions{})
+			ctx, builder.Definition, metav1.CreateOptions{})
 	}
 
 	return builder, err
@@ -138,13 +148,18 @@ func (builder *Builder) Create() (*Builder, error) {
 
 // Delete removes a secret from the cluster.
 func (builder *Builder) Delete() error {
+	return builder.DeleteWithContext(context.Background())
+}
+
+// DeleteWithContext removes a secret from the cluster using the provided context.
+func (builder *Builder) DeleteWithContext(ctx context.Context) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
 
 	glog.V(100).Infof("Deleting the secret %s from namespace %s", builder.Definition.Name, builder.Definition.Namespace)
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		glog.V(100).Infof("Secret %s does not exist in namespace %s",
 			builder.Definition.Name, builder.Definition.Namespace)
 
@@ -154,7 +169,7 @@ func (builder *Builder) Delete() error {
 	}
 
 	err := builder.apiClient.Secrets(builder.Definition.Namespace).Delete(
-		context.TODO(), builder.Definition.Name, metav1.DeleteOptions{})
+		ctx, builder.Definition.Name, metav1.DeleteOptions{})
 
 	if err != nil {
 		return err
@@ -167,6 +182,11 @@ func (builder *Builder) Delete() error {
 
 // Exists checks whether the given secret exists.
 func (builder *Builder) Exists() bool {
+	return builder.ExistsWithContext(context.Background())
+}
+
+// ExistsWithContext checks whether the given secret exists using the provided context.
+func (builder *Builder) ExistsWithContext(ctx context.Context) bool {
 	if valid, _ := builder.validate(); !valid {
 		return false
 	}
@@ -176,13 +196,18 @@ func (builder *Builder) Exists() bool {
 
 	var err error
 	builder.Object, err = builder.apiClient.Secrets(builder.Definition.Namespace).Get(
-		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+		ctx, builder.Definition.Name, metav1.GetOptions{})
 
 	return err == nil || !k8serrors.IsNotFound(err)
 }
 
 // Update modifies the existing secret in the cluster.
 func (builder *Builder) Update() (*Builder, error) {
+	return builder.UpdateWithContext(context.Background())
+}
+
+// UpdateWithContext modifies the existing secret in the cluster using the provided context.
+func (builder *Builder) UpdateWithContext(ctx context.Context) (*Builder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
@@ -193,7 +218,7 @@ func (builder *Builder) Update() (*Builder, error) {
 
 	var err error
 	builder.Object, err = builder.apiClient.Secrets(builder.Definition.Namespace).Update(
-		context.TODO(), builder.Definition, metav1.UpdateOptions{})
+		ctx, builder.Definition, metav1.UpdateOptions{})
 
 	return builder, err
 }
@@ -169,7 +169,11 @@ func TestSecretCreate(t *testing.T) {
 		} else {
 			assert.NoError(t, err)
 			assert.NotNil(t, Builder)
-			assert.Equal(t, Builder.Definition, Builder.Object)
+
+			// The resource version is set by the fake cluster on creation.
+			createdSecret := Builder.Object.DeepCopy()
+			createdSecret.ResourceVersion = ""
+			assert.Equal(t, Builder.Definition, createdSecret)
 		}
 	}
 }
@@ -11,6 +11,12 @@ import (
 
 // List returns service inventory in the given namespace.
 func List(apiClient *clients.Settings, nsname string, options ...metav1.ListOptions) ([]*Builder, error) {
+	return ListWithContext(context.Background(), apiClient, nsname, options...)
+}
+
+// ListWithContext returns service inventory in the given namespace using the provided context.
+func ListWithContext(
+	ctx context.Context, apiClient *clients.Settings, nsname string, options ...metav1.ListOptions) ([]*Builder, error) {
 	if nsname == "" {
 		glog.V(100).Infof("service 'nsname' parameter can not be empty")
 
@@ -33,7 +39,7 @@ func List(apiClient *clients.Settings, nsname string, options ...metav1.ListOpti
 
 	glog.V(100).Infof(logMessage)
 
-	serviceList, err := apiClient.Services(nsname).List(context.TODO(), passedOptions)
+	serviceList, err := apiClient.Services(nsname).List(ctx, passedOptions)
 
 	if err != nil {
 		glog.V(100).Infof("Failed to list services in the namespace %s due to %s", nsname, err.Error())
@@ -46,7 +52,7 @@ func List(apiClient *clients.Settings, nsname string, options ...metav1.ListOpti
 	for _, runningService := range serviceList.Items {
 		copiedService := runningService
 		serviceBuilder := &Builder{
-			apiClient:  apiClient.CoreV1Interface,
+			apiClient:  apiClient,
 			Object:     &copiedService,
 			Definition: &copiedService,
 		}
@@ -0,0 +1,142 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/golang/glog"
+	"github.com/openshift-kni/eco-goinfra/pkg/pod"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// PortForward forwards localPort on the loopback interface to servicePort of the service. The connection goes to a
+// single ready pod backing the service, using the target port of servicePort in that pod. If localPort is 0, a free
+// local port is chosen. The returned PortForwarder must be closed once no longer needed.
+func (builder *Builder) PortForward(localPort, servicePort uint16) (*pod.PortForwarder, error) {
+	return builder.PortForwardWithContext(context.Background(), localPort, servicePort)
+}
+
+// PortForwardWithContext forwards localPort on the loopback interface to servicePort of the service through one of
+// its ready pods. Forwarding stops when the returned PortForwarder is closed or ctx is done.
+func (builder *Builder) PortForwardWithContext(
+	ctx context.Context, localPort, servicePort uint16) (*pod.PortForwarder, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	glog.V(100).Infof("Forwarding local port %d to port %d of service %s in namespace %s",
+		localPort, servicePort, builder.Definition.Name, builder.Definition.Namespace)
+
+	service, err := builder.apiClient.Services(builder.Definition.Namespace).Get(
+		ctx, builder.Definition.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get service %s in namespace %s to forward port: %w",
+			builder.Definition.Name, builder.Definition.Namespace, err)
+	}
+
+	builder.Object = service
+
+	port, err := getServicePort(service, servicePort)
+	if err != nil {
+		return nil, err
+	}
+
+	backendPod, err := builder.getReadyBackendPod(ctx, service)
+	if err != nil {
+		return nil, err
+	}
+
+	targetPort, err := getTargetPort(backendPod, port)
+	if err != nil {
+		return nil, err
+	}
+
+	podBuilder, err := pod.Pull(builder.apiClient, backendPod.Name, backendPod.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	return podBuilder.PortForwardWithContext(ctx, localPort, targetPort)
+}
+
+// getServicePort returns the port of service with the given port number.
+func getServicePort(service *corev1.Service, servicePort uint16) (*corev1.ServicePort, error) {
+	for index, port := range service.Spec.Ports {
+		if port.Port == int32(servicePort) {
+			return &service.Spec.Ports[index], nil
+		}
+	}
+
+	return nil, fmt.Errorf("service %s in namespace %s has no port %d", service.Name, service.Namespace, servicePort)
+}
+
+// getReadyBackendPod returns a running and ready pod selected by service.
+func (builder *Builder) getReadyBackendPod(ctx context.Context, service *corev1.Service) (*corev1.Pod, error) {
+	if len(service.Spec.Selector) == 0 {
+		return nil, fmt.Errorf("service %s in namespace %s has no selector", service.Name, service.Namespace)
+	}
+
+	podList, err := builder.apiClient.Pods(service.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(service.Spec.Selector).String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods of service %s in namespace %s: %w", service.Name, service.Namespace, err)
+	}
+
+	for index, backendPod := range podList.Items {
+		if backendPod.DeletionTimestamp == nil && backendPod.Status.Phase == corev1.PodRunning &&
+			isPodReady(&backendPod) {
+			return &podList.Items[index], nil
+		}
+	}
+
+	return nil, fmt.Errorf("service %s in namespace %s has no ready pod", service.Name, service.Namespace)
+}
+
+// getTargetPort resolves the target port of servicePort in backendPod. Named target ports are looked up in the
+// container ports of the pod and an unset target port defaults to the service port.
+func getTargetPort(backendPod *corev1.Pod, servicePort *corev1.ServicePort) (uint16, error) {
+	targetPort := servicePort.TargetPort
+
+	switch {
+	case targetPort.Type == intstr.String && targetPort.StrVal != "":
+		for _, container := range backendPod.Spec.Containers {
+			for _, containerPort := range container.Ports {
+				if containerPort.Name == targetPort.StrVal {
+					return toPortNumber(containerPort.ContainerPort)
+				}
+			}
+		}
+
+		return 0, fmt.Errorf("pod %s in namespace %s has no port named %s",
+			backendPod.Name, backendPod.Namespace, targetPort.StrVal)
+	case targetPort.Type == intstr.Int && targetPort.IntVal != 0:
+		return toPortNumber(targetPort.IntVal)
+	default:
+		return toPortNumber(servicePort.Port)
+	}
+}
+
+// toPortNumber converts port to a port number, checking it is in range.
+func toPortNumber(port int32) (uint16, error) {
+	if port <= 0 || port > math.MaxUint16 {
+		return 0, fmt.Errorf("invalid port number %d", port)
+	}
+
+	return uint16(port), nil
+}
+
+// isPodReady returns true if the Ready condition of backendPod is true.
+func isPodReady(backendPod *corev1.Pod) bool {
+	for _, condition := range backendPod.Status.Conditions {
+		if condition.Type == corev1.PodReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,128 @@
+package service
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestServicePortForward(t *testing.T) {
+	testCases := []struct {
+		backendPods   []runtime.Object
+		servicePort   uint16
+		expectedError string
+	}{
+		{
+			backendPods:   []runtime.Object{buildDummyBackendPod("ready", true)},
+			servicePort:   80,
+			expectedError: "pod port-forward requires an apiClient with a rest config",
+		},
+		{
+			backendPods: []runtime.Object{buildDummyBackendPod("ready", true)},
+			servicePort: 443,
+			expectedError: fmt.Sprintf(
+				"service %s in namespace %s has no port 443", defaultServiceName, defaultServiceNamespace),
+		},
+		{
+			backendPods: []runtime.Object{buildDummyBackendPod("not-ready", false)},
+			servicePort: 80,
+			expectedError: fmt.Sprintf(
+				"service %s in namespace %s has no ready pod", defaultServiceName, defaultServiceNamespace),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects: append(buildDummyService(), testCase.backendPods...),
+		})
+
+		forwarder, err := buildValidServiceBuilder(testSettings).PortForward(0, testCase.servicePort)
+		assert.EqualError(t, err, testCase.expectedError)
+		assert.Nil(t, forwarder)
+	}
+}
+
+func TestServicePortForwardFromList(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: append(buildDummyService(), buildDummyBackendPod("ready", true)),
+	})
+
+	serviceBuilders, err := List(testSettings, defaultServiceNamespace)
+	assert.Nil(t, err)
+	assert.Len(t, serviceBuilders, 1)
+
+	// The listed builder reaches the backing pod, which fails only because the test client has no rest config.
+	forwarder, err := serviceBuilders[0].PortForward(0, 80)
+	assert.EqualError(t, err, "pod port-forward requires an apiClient with a rest config")
+	assert.Nil(t, forwarder)
+}
+
+func TestServiceGetTargetPort(t *testing.T) {
+	testCases := []struct {
+		targetPort    intstr.IntOrString
+		expectedPort  uint16
+		expectedError string
+	}{
+		{
+			targetPort:   intstr.FromInt32(8080),
+			expectedPort: 8080,
+		},
+		{
+			targetPort:   intstr.FromString("metrics"),
+			expectedPort: 9090,
+		},
+		{
+			targetPort:   intstr.IntOrString{},
+			expectedPort: 80,
+		},
+		{
+			targetPort:    intstr.FromString("missing"),
+			expectedError: "pod ready in namespace test-service-namespace has no port named missing",
+		},
+	}
+
+	for _, testCase := range testCases {
+		servicePort := defaultServicePort
+		servicePort.TargetPort = testCase.targetPort
+
+		port, err := getTargetPort(buildDummyBackendPod("ready", true), &servicePort)
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+		} else {
+			assert.Nil(t, err)
+			assert.Equal(t, testCase.expectedPort, port)
+		}
+	}
+}
+
+func buildDummyBackendPod(name string, ready bool) *corev1.Pod {
+	readyStatus := corev1.ConditionFalse
+	if ready {
+		readyStatus = corev1.ConditionTrue
+	}
+
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: defaultServiceNamespace,
+			Labels:    defaultServiceSelector,
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{
+				Name:  "test",
+				Ports: []corev1.ContainerPort{{Name: "metrics", ContainerPort: 9090}},
+			}},
+		},
+		Status: corev1.PodStatus{
+			Phase:      corev1.PodRunning,
+			Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: readyStatus}},
+		},
+	}
+}
@@ -13,7 +13,6 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/intstr"
-	corev1Typed "k8s.io/client-go/kubernetes/typed/core/v1"
 )
 
 // Builder provides struct for service object containing connection to the cluster and the service definitions.
@@ -25,7 +24,7 @@ type Builder struct {
 	// Used in functions that define or mutate the service definition.
 	// errorMsg is processed before the service object is created
 	errorMsg  string
-	apiClient corev1Typed.CoreV1Interface
+	apiClient *clients.Settings
 }
 
 // AdditionalOptions additional options for service object.
@@ -44,7 +43,7 @@ func NewBuilder(
 		"Initializing new service structure with the following params: %s, %s", name, nsname)
 
 	builder := Builder{
-		apiClient: apiClient.CoreV1Interface,
+		apiClient: apiClient,
 		Definition: &corev1.Service{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      name,
@@ -97,6 +96,11 @@ func (builder *Builder) WithNodePort() *Builder {
 
 // Pull loads an existing service into Builder struct.
 func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
+	return PullWithContext(context.Background(), apiClient, name, nsname)
+}
+
+// PullWithContext loads an existing service like Pull using the provided context.
+func PullWithContext(ctx context.Context, apiClient *clients.Settings, name, nsname string) (*Builder, error) {
 	glog.V(100).Infof("Pulling existing service name: %s under namespace: %s", name, nsname)
 
 	if apiClient == nil {
@@ -106,7 +110,7 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
 	}
 
 	builder := Builder{
-		apiClient: apiClient.CoreV1Interface,
+		apiClient: apiClient,
 		Definition: &corev1.Service{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      name,
@@ -123,7 +127,7 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
 		return nil, fmt.Errorf("service 'namespace' cannot be empty")
 	}
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		return nil, fmt.Errorf("service object %s does not exist in namespace %s", name, nsname)
 	}
 
@@ -134,6 +138,12 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
 
 // Create the service in the cluster and store the created object in Object.
 func (builder *Builder) Create() (*Builder, error) {
+	return builder.CreateWithContext(context.Background())
+}
+
+// CreateWithContext creates the service in the cluster using the provided context and stores the created object in
+// Object.
+func (builder *Builder) CreateWithContext(ctx context.Context) (*Builder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
@@ -141,9 +151,9 @@ func (builder *Builder) Create() (*Builder, error) {
 	glog.V(100).Infof("Creating the service %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		builder.Object, err = builder.apiClient.Services(builder.Definition.Namespace).Create(
-			context.TODO(), builder.Definition, metav1.CreateOptions{})
+			ctx, builder.Definition, metav1.CreateOptions{})
 	}
 
 	return builder, err
@@ -151,6 +161,11 @@ func (builder *Builder) Create() (*Builder, error) {
 
 // Exists checks whether the given service exists.
 func (builder *Builder) Exists() bool {
+	return builder.ExistsWithContext(context.Background())
+}
+
+// ExistsWithContext checks whether the given service exists using the provided context.
+func (builder *Builder) ExistsWithContext(ctx context.Context) bool {
 	if valid, _ := builder.validate(); !valid {
 		return false
 	}
@@ -161,27 +176,32 @@ func (builder *Builder) Exists() bool {
 
 	var err error
 	builder.Object, err = builder.apiClient.Services(builder.Definition.Namespace).Get(
-		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+		ctx, builder.Definition.Name, metav1.GetOptions{})
 
 	return err == nil || !k8serrors.IsNotFound(err)
 }
 
 // Delete a service.
 func (builder *Builder) Delete() error {
+	return builder.DeleteWithContext(context.Background())
+}
+
+// DeleteWithContext deletes a service using the provided context.
+func (builder *Builder) DeleteWithContext(ctx context.Context) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
 
 	glog.V(100).Infof("Deleting the service %s from namespace %s", builder.Definition.Name, builder.Definition.Namespace)
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		builder.Object = nil
 
 		return nil
 	}
 
 	err := builder.apiClient.Services(builder.Definition.Namespace).Delete(
-		context.TODO(), builder.Definition.Name, metav1.DeleteOptions{})
+		ctx, builder.Definition.Name, metav1.DeleteOptions{})
 
 	if err != nil {
 		return err
@@ -194,6 +214,12 @@ func (builder *Builder) Delete() error {
 
 // Update renovates the existing service object with service definition in builder.
 func (builder *Builder) Update() (*Builder, error) {
+	return builder.UpdateWithContext(context.Background())
+}
+
+// UpdateWithContext renovates the existing service object with service definition in builder using the provided
+// context.
+func (builder *Builder) UpdateWithContext(ctx context.Context) (*Builder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
@@ -203,7 +229,7 @@ func (builder *Builder) Update() (*Builder, error) {
 
 	var err error
 	builder.Object, err = builder.apiClient.Services(builder.Definition.Namespace).Update(
-		context.TODO(), builder.Definition, metav1.UpdateOptions{})
+		ctx, builder.Definition, metav1.UpdateOptions{})
 
 	return builder, err
 }
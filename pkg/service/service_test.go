@@ -668,7 +668,7 @@ func buildInValidServiceBuilder(apiClient *clients.Settings) *Builder {
 
 func buildInValidPortServiceBuilder(apiClient *clients.Settings) *Builder {
 	serviceBuilder := &Builder{
-		apiClient: apiClient.CoreV1Interface,
+		apiClient: apiClient,
 		Definition: &corev1.Service{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      defaultServiceName,
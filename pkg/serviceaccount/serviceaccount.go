@@ -103,6 +103,12 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
 
 // Create makes a serviceaccount in cluster and stores the created object in struct.
 func (builder *Builder) Create() (*Builder, error) {
+	return builder.CreateWithContext(context.Background())
+}
+
+// CreateWithContext makes a serviceaccount in cluster using the provided context and stores the created object in
+// struct.
+func (builder *Builder) CreateWithContext(ctx context.Context) (*Builder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
@@ -112,9 +118,9 @@ func (builder *Builder) Create() (*Builder, error) {
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		builder.Object, err = builder.apiClient.Create(
-			context.TODO(), builder.Definition, metav1.CreateOptions{})
+			ctx, builder.Definition, metav1.CreateOptions{})
 	}
 
 	return builder, err
@@ -124,6 +130,13 @@ func (builder *Builder) Create() (*Builder, error) {
 // values of duration and audiences are both allowed. Note that the duration of the token returned is not guaranteed to
 // match the requested duration. Its expiration will be logged, however.
 func (builder *Builder) CreateToken(duration time.Duration, audiences ...string) (string, error) {
+	return builder.CreateTokenWithContext(context.Background(), duration, audiences...)
+}
+
+// CreateTokenWithContext creates a new token for the builder's service account using the provided context, duration and
+// audiences, as CreateToken does.
+func (builder *Builder) CreateTokenWithContext(
+	ctx context.Context, duration time.Duration, audiences ...string) (string, error) {
 	if valid, err := builder.validate(); !valid {
 		return "", err
 	}
@@ -131,7 +144,7 @@ func (builder *Builder) CreateToken(duration time.Duration, audiences ...string)
 	glog.V(100).Infof("Creating token for serviceaccount %s in namespace %s with duration %s",
 		builder.Definition.Name, builder.Definition.Namespace, duration)
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		glog.V(100).Infof("Cannot create a token for serviceaccount %s in namespace %s because it does not exist",
 			builder.Definition.Name, builder.Definition.Namespace)
 
@@ -151,7 +164,7 @@ func (builder *Builder) CreateToken(duration time.Duration, audiences ...string)
 		},
 	}
 	tokenRequest, err := builder.apiClient.CreateToken(
-		context.TODO(), builder.Definition.Name, tokenRequest, metav1.CreateOptions{})
+		ctx, builder.Definition.Name, tokenRequest, metav1.CreateOptions{})
 
 	if err != nil {
 		return "", err
@@ -165,6 +178,11 @@ func (builder *Builder) CreateToken(duration time.Duration, audiences ...string)
 
 // Delete removes a serviceaccount.
 func (builder *Builder) Delete() error {
+	return builder.DeleteWithContext(context.Background())
+}
+
+// DeleteWithContext removes a serviceaccount using the provided context.
+func (builder *Builder) DeleteWithContext(ctx context.Context) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
@@ -173,7 +191,7 @@ func (builder *Builder) Delete() error {
 		"Deleting serviceaccount %s from namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		glog.V(100).Infof("ServiceAccount %s namespace %s does not exist and cannot be deleted",
 			builder.Definition.Name, builder.Definition.Namespace)
 
@@ -183,7 +201,7 @@ func (builder *Builder) Delete() error {
 	}
 
 	err := builder.apiClient.Delete(
-		context.TODO(), builder.Definition.Name, metav1.DeleteOptions{})
+		ctx, builder.Definition.Name, metav1.DeleteOptions{})
 
 	if err != nil {
 		return err
@@ -196,6 +214,11 @@ func (builder *Builder) Delete() error {
 
 // Exists checks whether the given serviceaccount exists.
 func (builder *Builder) Exists() bool {
+	return builder.ExistsWithContext(context.Background())
+}
+
+// ExistsWithContext checks whether the given serviceaccount exists using the provided context.
+func (builder *Builder) ExistsWithContext(ctx context.Context) bool {
 	if valid, _ := builder.validate(); !valid {
 		return false
 	}
@@ -206,7 +229,7 @@ func (builder *Builder) Exists() bool {
 
 	var err error
 	builder.Object, err = builder.apiClient.Get(
-		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+		ctx, builder.Definition.Name, metav1.GetOptions{})
 
 	return err == nil || !k8serrors.IsNotFound(err)
 }
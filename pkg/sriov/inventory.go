@@ -0,0 +1,251 @@
+package sriov
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/golang/glog"
+	srIovV1 "github.com/k8snetworkplumbingwg/sriov-network-operator/api/v1"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// UnknownNUMANode is the NUMANode of an InventoryNIC whose NUMA node has not been set with SetNUMANode.
+	UnknownNUMANode = -1
+
+	mellanoxVendorID = "15b3"
+	linkTypeIB       = "IB"
+	linkSpeedDown    = "-1 Mb/s"
+)
+
+// InventoryNIC is an SR-IOV capable NIC reported by the SriovNetworkNodeState of a node.
+type InventoryNIC struct {
+	srIovV1.InterfaceExt
+	// Node is the name of the node of the NIC.
+	Node string
+	// NUMANode is the NUMA node of the NIC, UnknownNUMANode unless set with SetNUMANode since the
+	// SriovNetworkNodeState does not report it.
+	NUMANode int
+	// RDMACapable is true for InfiniBand NICs and NVIDIA Mellanox NICs, which are the ones the SR-IOV operator can
+	// configure in RDMA mode.
+	RDMACapable bool
+}
+
+// Model returns the model of the NIC in vendor:deviceID format, for example 8086:158b.
+func (nic InventoryNIC) Model() string {
+	return strings.ToLower(nic.Vendor + ":" + nic.DeviceID)
+}
+
+// IsUp returns true if the link of the NIC is up, following the definition of GetUpNICs.
+func (nic InventoryNIC) IsUp() bool {
+	return nic.LinkSpeed != "" && nic.LinkSpeed != linkSpeedDown
+}
+
+// NICFilter selects NICs of an Inventory. Empty fields match every NIC.
+type NICFilter struct {
+	// Vendor and DeviceID are the PCI vendor and device IDs of the NIC, for example 8086 and 158b.
+	Vendor   string
+	DeviceID string
+	Driver   string
+	// LinkUp selects the NICs whose link is up if true, or down if false.
+	LinkUp *bool
+	// MinTotalVFs is the minimum number of VFs the NIC supports.
+	MinTotalVFs int
+	// NUMANode selects the NICs on this NUMA node. NICs with an unknown NUMA node never match.
+	NUMANode *int
+	// RDMACapable selects the NICs supporting RDMA if true, or not supporting it if false.
+	RDMACapable *bool
+	// IncludeExternallyManaged also selects the NICs whose configuration is managed outside of the SR-IOV operator.
+	IncludeExternallyManaged bool
+}
+
+// Matches returns true if nic passes every field of the filter.
+func (filter NICFilter) Matches(nic InventoryNIC) bool {
+	switch {
+	case filter.Vendor != "" && !strings.EqualFold(filter.Vendor, nic.Vendor):
+		return false
+	case filter.DeviceID != "" && !strings.EqualFold(filter.DeviceID, nic.DeviceID):
+		return false
+	case filter.Driver != "" && filter.Driver != nic.Driver:
+		return false
+	case filter.LinkUp != nil && *filter.LinkUp != nic.IsUp():
+		return false
+	case nic.TotalVfs < filter.MinTotalVFs:
+		return false
+	case filter.NUMANode != nil && (nic.NUMANode == UnknownNUMANode || *filter.NUMANode != nic.NUMANode):
+		return false
+	case filter.RDMACapable != nil && *filter.RDMACapable != nic.RDMACapable:
+		return false
+	case nic.ExternallyManaged && !filter.IncludeExternallyManaged:
+		return false
+	}
+
+	return true
+}
+
+// Inventory is the SR-IOV hardware of the cluster, as reported by the SriovNetworkNodeStates.
+type Inventory struct {
+	// NICs are sorted by node name then PCI address.
+	NICs []InventoryNIC
+}
+
+// ModelSelection is a NIC model found on several nodes, with one NIC of that model per node.
+type ModelSelection struct {
+	// Model is the model of the NICs in vendor:deviceID format.
+	Model string
+	// NICs has one NIC per node, sorted by node name.
+	NICs []InventoryNIC
+}
+
+// Nodes returns the names of the nodes of the selection.
+func (selection *ModelSelection) Nodes() []string {
+	var nodeNames []string
+
+	for _, nic := range selection.NICs {
+		nodeNames = append(nodeNames, nic.Node)
+	}
+
+	return nodeNames
+}
+
+// GetInventory returns the Inventory of the SriovNetworkNodeStates in the namespace of the SR-IOV operator. The
+// options, for example a label selector, are restricted to that namespace.
+func GetInventory(apiClient *clients.Settings, nsname string, options ...client.ListOptions) (*Inventory, error) {
+	glog.V(100).Infof("Collecting SR-IOV inventory from SriovNetworkNodeStates in namespace %s", nsname)
+
+	passedOptions := []client.ListOptions{{}}
+	if len(options) > 0 {
+		passedOptions = slices.Clone(options)
+	}
+
+	for index := range passedOptions {
+		passedOptions[index].Namespace = nsname
+	}
+
+	nodeStates, err := ListNetworkNodeState(apiClient, nsname, passedOptions...)
+	if err != nil {
+		return nil, err
+	}
+
+	inventory := &Inventory{}
+
+	for _, nodeState := range nodeStates {
+		for _, nicStatus := range nodeState.Objects.Status.Interfaces {
+			inventory.NICs = append(inventory.NICs, InventoryNIC{
+				InterfaceExt: nicStatus,
+				Node:         nodeState.Objects.Name,
+				NUMANode:     UnknownNUMANode,
+				RDMACapable: strings.EqualFold(nicStatus.Vendor, mellanoxVendorID) ||
+					strings.EqualFold(nicStatus.LinkType, linkTypeIB),
+			})
+		}
+	}
+
+	slices.SortFunc(inventory.NICs, func(first, second InventoryNIC) int {
+		return cmp.Or(cmp.Compare(first.Node, second.Node), cmp.Compare(first.PciAddress, second.PciAddress))
+	})
+
+	glog.V(100).Infof("Collected %d SR-IOV NICs on %d nodes", len(inventory.NICs), len(inventory.Nodes()))
+
+	return inventory, nil
+}
+
+// SetNUMANode sets the NUMA node of the NIC with pciAddress on nodeName. It allows filtering on the NUMA node once
+// it has been discovered by other means, for example from /sys/bus/pci/devices/<pciAddress>/numa_node on the node.
+func (inventory *Inventory) SetNUMANode(nodeName, pciAddress string, numaNode int) error {
+	for index := range inventory.NICs {
+		if inventory.NICs[index].Node == nodeName && inventory.NICs[index].PciAddress == pciAddress {
+			inventory.NICs[index].NUMANode = numaNode
+
+			return nil
+		}
+	}
+
+	return fmt.Errorf("SR-IOV NIC %s not found on node %s", pciAddress, nodeName)
+}
+
+// Filter returns the NICs matching filter.
+func (inventory *Inventory) Filter(filter NICFilter) []InventoryNIC {
+	var nics []InventoryNIC
+
+	for _, nic := range inventory.NICs {
+		if filter.Matches(nic) {
+			nics = append(nics, nic)
+		}
+	}
+
+	return nics
+}
+
+// Nodes returns the sorted names of the nodes with at least one SR-IOV NIC.
+func (inventory *Inventory) Nodes() []string {
+	var nodeNames []string
+
+	for _, nic := range inventory.NICs {
+		if !slices.Contains(nodeNames, nic.Node) {
+			nodeNames = append(nodeNames, nic.Node)
+		}
+	}
+
+	return nodeNames
+}
+
+// Models returns the sorted models of the NICs in vendor:deviceID format.
+func (inventory *Inventory) Models() []string {
+	var models []string
+
+	for _, nic := range inventory.NICs {
+		if !slices.Contains(models, nic.Model()) {
+			models = append(models, nic.Model())
+		}
+	}
+
+	slices.Sort(models)
+
+	return models
+}
+
+// FindNodesWithSharedModel returns nodeCount nodes sharing a NIC model with at least minVFs VFs, with one such NIC
+// per node, among the NICs matching filter. The model found on the most nodes is preferred, ties being broken by
+// model name, and the nodes are chosen in name order. On each node, the first NIC by PCI address is used.
+func (inventory *Inventory) FindNodesWithSharedModel(
+	nodeCount, minVFs int, filter NICFilter) (*ModelSelection, error) {
+	glog.V(100).Infof("Looking for %d nodes sharing an SR-IOV NIC model with at least %d VFs", nodeCount, minVFs)
+
+	if nodeCount <= 0 {
+		return nil, fmt.Errorf("SR-IOV inventory 'nodeCount' must be positive, got %d", nodeCount)
+	}
+
+	if filter.MinTotalVFs < minVFs {
+		filter.MinTotalVFs = minVFs
+	}
+
+	var best *ModelSelection
+
+	for _, model := range inventory.Models() {
+		selection := &ModelSelection{Model: model}
+
+		for _, nic := range inventory.Filter(filter) {
+			if nic.Model() == model && !slices.Contains(selection.Nodes(), nic.Node) {
+				selection.NICs = append(selection.NICs, nic)
+			}
+		}
+
+		if best == nil || len(selection.NICs) > len(best.NICs) {
+			best = selection
+		}
+	}
+
+	if best == nil || len(best.NICs) < nodeCount {
+		return nil, fmt.Errorf("no SR-IOV NIC model with at least %d VFs is available on %d nodes", minVFs, nodeCount)
+	}
+
+	best.NICs = best.NICs[:nodeCount]
+
+	glog.V(100).Infof("Selected SR-IOV NIC model %s on nodes %v", best.Model, best.Nodes())
+
+	return best, nil
+}
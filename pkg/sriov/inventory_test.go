@@ -0,0 +1,221 @@
+package sriov
+
+import (
+	"testing"
+
+	srIovV1 "github.com/k8snetworkplumbingwg/sriov-network-operator/api/v1"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestGetInventory(t *testing.T) {
+	testCases := []struct {
+		client        bool
+		nsName        string
+		expectedNICs  int
+		expectedError string
+	}{
+		{
+			client:       true,
+			nsName:       defaultNodeNsName,
+			expectedNICs: 6,
+		},
+		{
+			client:       true,
+			nsName:       "othernamespace",
+			expectedNICs: 0,
+		},
+		{
+			client:        true,
+			nsName:        "",
+			expectedError: "failed to list SriovNetworkNodeStates, 'nsname' parameter is empty",
+		},
+		{
+			client:        false,
+			nsName:        defaultNodeNsName,
+			expectedError: "failed to list SriovNetworkNodeStates, 'apiClient' parameter is empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var testSettings *clients.Settings
+
+		if testCase.client {
+			testSettings = buildTestClientWithInventory()
+		}
+
+		inventory, err := GetInventory(testSettings, testCase.nsName)
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.Nil(t, err)
+		assert.Len(t, inventory.NICs, testCase.expectedNICs)
+	}
+
+	inventory, err := GetInventory(buildTestClientWithInventory(), defaultNodeNsName)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"worker-0", "worker-1", "worker-2"}, inventory.Nodes())
+	assert.Equal(t, []string{"15b3:1015", "8086:158b", "8086:1592"}, inventory.Models())
+	assert.Equal(t, "worker-0", inventory.NICs[0].Node)
+	assert.Equal(t, "0000:3b:00.0", inventory.NICs[0].PciAddress)
+	assert.Equal(t, UnknownNUMANode, inventory.NICs[0].NUMANode)
+	assert.False(t, inventory.NICs[0].RDMACapable)
+	assert.True(t, inventory.NICs[1].RDMACapable)
+}
+
+func TestInventoryFilter(t *testing.T) {
+	linkUp := true
+	rdma := true
+	numaNode := 1
+
+	testCases := []struct {
+		filter        NICFilter
+		expectedNames []string
+	}{
+		{
+			filter:        NICFilter{},
+			expectedNames: []string{"w0-e810", "w0-cx5", "w1-xxv710", "w1-e810", "w2-e810"},
+		},
+		{
+			filter:        NICFilter{IncludeExternallyManaged: true},
+			expectedNames: []string{"w0-e810", "w0-cx5", "w1-xxv710", "w1-e810", "w2-e810", "w2-managed"},
+		},
+		{
+			filter:        NICFilter{Vendor: "8086", DeviceID: "1592"},
+			expectedNames: []string{"w0-e810", "w1-e810", "w2-e810"},
+		},
+		{
+			filter:        NICFilter{Driver: "mlx5_core"},
+			expectedNames: []string{"w0-cx5"},
+		},
+		{
+			filter:        NICFilter{LinkUp: &linkUp, MinTotalVFs: 64},
+			expectedNames: []string{"w0-e810", "w1-xxv710", "w1-e810"},
+		},
+		{
+			filter:        NICFilter{RDMACapable: &rdma},
+			expectedNames: []string{"w0-cx5"},
+		},
+		{
+			filter:        NICFilter{NUMANode: &numaNode},
+			expectedNames: []string{"w1-e810"},
+		},
+	}
+
+	inventory, err := GetInventory(buildTestClientWithInventory(), defaultNodeNsName)
+	assert.Nil(t, err)
+	assert.Nil(t, inventory.SetNUMANode("worker-1", "0000:86:00.0", 1))
+	assert.EqualError(t, inventory.SetNUMANode("worker-1", "0000:00:00.0", 1),
+		"SR-IOV NIC 0000:00:00.0 not found on node worker-1")
+
+	for _, testCase := range testCases {
+		var names []string
+
+		for _, nic := range inventory.Filter(testCase.filter) {
+			names = append(names, nic.Name)
+		}
+
+		assert.Equal(t, testCase.expectedNames, names)
+	}
+}
+
+func TestInventoryFindNodesWithSharedModel(t *testing.T) {
+	linkUp := true
+
+	testCases := []struct {
+		nodeCount     int
+		minVFs        int
+		filter        NICFilter
+		expectedModel string
+		expectedNodes []string
+		expectedError string
+	}{
+		{
+			nodeCount:     3,
+			minVFs:        8,
+			expectedModel: "8086:1592",
+			expectedNodes: []string{"worker-0", "worker-1", "worker-2"},
+		},
+		{
+			nodeCount:     2,
+			minVFs:        8,
+			filter:        NICFilter{LinkUp: &linkUp},
+			expectedModel: "8086:1592",
+			expectedNodes: []string{"worker-0", "worker-1"},
+		},
+		{
+			nodeCount:     1,
+			minVFs:        8,
+			filter:        NICFilter{Driver: "mlx5_core"},
+			expectedModel: "15b3:1015",
+			expectedNodes: []string{"worker-0"},
+		},
+		{
+			nodeCount:     3,
+			minVFs:        128,
+			expectedError: "no SR-IOV NIC model with at least 128 VFs is available on 3 nodes",
+		},
+		{
+			nodeCount:     0,
+			minVFs:        8,
+			expectedError: "SR-IOV inventory 'nodeCount' must be positive, got 0",
+		},
+	}
+
+	inventory, err := GetInventory(buildTestClientWithInventory(), defaultNodeNsName)
+	assert.Nil(t, err)
+
+	for _, testCase := range testCases {
+		selection, err := inventory.FindNodesWithSharedModel(testCase.nodeCount, testCase.minVFs, testCase.filter)
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+			assert.Nil(t, selection)
+
+			continue
+		}
+
+		assert.Nil(t, err)
+		assert.Equal(t, testCase.expectedModel, selection.Model)
+		assert.Equal(t, testCase.expectedNodes, selection.Nodes())
+	}
+}
+
+// buildTestClientWithInventory returns a client with the SriovNetworkNodeStates of three workers sharing an E810 NIC,
+// the link of which is down on the last worker.
+func buildTestClientWithInventory() *clients.Settings {
+	buildNodeState := func(nodeName string, nics ...srIovV1.InterfaceExt) runtime.Object {
+		nodeState := buildNodeNetworkState(nodeName, defaultNodeNsName)
+		nodeState.Status.Interfaces = nics
+
+		return nodeState
+	}
+
+	e810 := func(name, pciAddress, linkSpeed string) srIovV1.InterfaceExt {
+		return srIovV1.InterfaceExt{Name: name, PciAddress: pciAddress, Vendor: "8086", DeviceID: "1592",
+			Driver: "ice", LinkType: "ETH", LinkSpeed: linkSpeed, TotalVfs: 64}
+	}
+
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: []runtime.Object{
+			buildNodeState("worker-1",
+				srIovV1.InterfaceExt{Name: "w1-xxv710", PciAddress: "0000:3b:00.0", Vendor: "8086", DeviceID: "158b",
+					Driver: "i40e", LinkType: "ETH", LinkSpeed: "25000 Mb/s", TotalVfs: 64},
+				e810("w1-e810", "0000:86:00.0", "100000 Mb/s")),
+			buildNodeState("worker-0",
+				srIovV1.InterfaceExt{Name: "w0-cx5", PciAddress: "0000:d8:00.0", Vendor: "15b3", DeviceID: "1015",
+					Driver: "mlx5_core", LinkType: "ETH", LinkSpeed: "25000 Mb/s", TotalVfs: 8},
+				e810("w0-e810", "0000:3b:00.0", "100000 Mb/s")),
+			buildNodeState("worker-2",
+				e810("w2-e810", "0000:3b:00.0", "-1 Mb/s"),
+				srIovV1.InterfaceExt{Name: "w2-managed", PciAddress: "0000:5e:00.0", Vendor: "8086", DeviceID: "158b",
+					Driver: "i40e", LinkType: "ETH", LinkSpeed: "25000 Mb/s", TotalVfs: 64, ExternallyManaged: true}),
+		},
+		SchemeAttachers: testSchemes,
+	})
+}
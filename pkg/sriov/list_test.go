@@ -103,7 +103,7 @@ func TestNetworkCleanAllNetworksByTargetNamespace(t *testing.T) {
 			testNetwork:    []*NetworkBuilder{buildValidSriovNetworkTestBuilder(buildTestClientWithDummyObject())},
 			operatorNsName: "testnamespace",
 			targetNsName:   "targetns",
-			listOptions:    []client.ListOptions{{Namespace: "test"}},
+			listOptions:    []client.ListOptions{{Namespace: "testnamespace"}},
 			client:         true,
 		},
 		{
@@ -127,7 +127,8 @@ func TestNetworkCleanAllNetworksByTargetNamespace(t *testing.T) {
 
 		if testCase.client {
 			testSettings = clients.GetTestClients(clients.TestClientParams{
-				K8sMockObjects: buildDummySrIovNetworkObject(),
+				K8sMockObjects:  buildDummySrIovNetworkObject(),
+				SchemeAttachers: testSchemes,
 			})
 		}
 
@@ -351,7 +352,8 @@ func TestCleanAllNetworkNodePolicies(t *testing.T) {
 
 		if testCase.client {
 			testSettings = clients.GetTestClients(clients.TestClientParams{
-				K8sMockObjects: runtimeObjects,
+				K8sMockObjects:  runtimeObjects,
+				SchemeAttachers: testSchemes,
 			})
 		}
 
@@ -297,6 +297,11 @@ func PullPolicy(apiClient *clients.Settings, name, nsname string) (*PolicyBuilde
 
 // Get returns CatalogSource object if found.
 func (builder *PolicyBuilder) Get() (*srIovV1.SriovNetworkNodePolicy, error) {
+	return builder.GetWithContext(context.Background())
+}
+
+// GetWithContext returns the SriovNetworkNodePolicy object if found, using the provided context.
+func (builder *PolicyBuilder) GetWithContext(ctx context.Context) (*srIovV1.SriovNetworkNodePolicy, error) {
 	if valid, err := builder.validate(); !valid {
 		return nil, err
 	}
@@ -306,7 +311,7 @@ func (builder *PolicyBuilder) Get() (*srIovV1.SriovNetworkNodePolicy, error) {
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	nodePolicy := &srIovV1.SriovNetworkNodePolicy{}
-	err := builder.apiClient.Get(context.TODO(),
+	err := builder.apiClient.Get(ctx,
 		runtimeClient.ObjectKey{Name: builder.Definition.Name, Namespace: builder.Definition.Namespace},
 		nodePolicy)
 
@@ -323,12 +328,18 @@ func (builder *PolicyBuilder) Get() (*srIovV1.SriovNetworkNodePolicy, error) {
 
 // Create generates an SriovNetworkNodePolicy in the cluster and stores the created object in struct.
 func (builder *PolicyBuilder) Create() (*PolicyBuilder, error) {
+	return builder.CreateWithContext(context.Background())
+}
+
+// CreateWithContext generates an SriovNetworkNodePolicy in the cluster using the provided context and stores the
+// created object in struct.
+func (builder *PolicyBuilder) CreateWithContext(ctx context.Context) (*PolicyBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
 
-	if !builder.Exists() {
-		err := builder.apiClient.Create(context.TODO(), builder.Definition)
+	if !builder.ExistsWithContext(ctx) {
+		err := builder.apiClient.Create(ctx, builder.Definition)
 
 		if err != nil {
 			return nil, err
@@ -342,11 +353,16 @@ func (builder *PolicyBuilder) Create() (*PolicyBuilder, error) {
 
 // Delete removes an SriovNetworkNodePolicy object.
 func (builder *PolicyBuilder) Delete() error {
+	return builder.DeleteWithContext(context.Background())
+}
+
+// DeleteWithContext removes an SriovNetworkNodePolicy object using the provided context.
+func (builder *PolicyBuilder) DeleteWithContext(ctx context.Context) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		glog.V(100).Infof("SriovNetworkNodePolicy %s in namespace %s cannot be deleted because it does not exist",
 			builder.Definition.Name, builder.Definition.Namespace)
 
@@ -355,7 +371,7 @@ func (builder *PolicyBuilder) Delete() error {
 		return nil
 	}
 
-	err := builder.apiClient.Delete(context.TODO(), builder.Definition)
+	err := builder.apiClient.Delete(ctx, builder.Definition)
 
 	if err != nil {
 		return err
@@ -368,6 +384,12 @@ func (builder *PolicyBuilder) Delete() error {
 
 // Exists checks whether the given SriovNetworkNodePolicy object exists in the cluster.
 func (builder *PolicyBuilder) Exists() bool {
+	return builder.ExistsWithContext(context.Background())
+}
+
+// ExistsWithContext checks whether the given SriovNetworkNodePolicy object exists in the cluster using the provided
+// context.
+func (builder *PolicyBuilder) ExistsWithContext(ctx context.Context) bool {
 	if valid, _ := builder.validate(); !valid {
 		return false
 	}
@@ -375,7 +397,7 @@ func (builder *PolicyBuilder) Exists() bool {
 	glog.V(100).Infof("Checking if SriovNetworkNodePolicy %s exists", builder.Definition.Name)
 
 	var err error
-	builder.Object, err = builder.Get()
+	builder.Object, err = builder.GetWithContext(ctx)
 
 	return err == nil || !k8serrors.IsNotFound(err)
 }
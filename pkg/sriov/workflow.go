@@ -0,0 +1,439 @@
+package sriov
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	srIovV1 "github.com/k8snetworkplumbingwg/sriov-network-operator/api/v1"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// DefaultPolicyWorkflowTimeout is the time allowed for the nodes to apply or remove the policies, which may
+	// involve draining and rebooting them.
+	DefaultPolicyWorkflowTimeout = 30 * time.Minute
+	// DefaultPolicyWorkflowPollInterval is the interval between two observations of the nodes.
+	DefaultPolicyWorkflowPollInterval = 10 * time.Second
+	// DefaultResourcePrefix is the prefix of the extended resources advertised by the SR-IOV device plugin.
+	DefaultResourcePrefix = "openshift.io"
+
+	syncStatusSucceeded = "Succeeded"
+	syncStatusFailed    = "Failed"
+)
+
+// PolicyWorkflowOptions configures a PolicyWorkflow.
+type PolicyWorkflowOptions struct {
+	// Timeout bounds the wait for the nodes in Apply and Rollback, DefaultPolicyWorkflowTimeout when zero.
+	Timeout time.Duration
+	// PollInterval is the interval between two observations of the nodes, DefaultPolicyWorkflowPollInterval when
+	// zero.
+	PollInterval time.Duration
+	// ResourcePrefix is the prefix of the resource names in the node allocatable, DefaultResourcePrefix when empty.
+	ResourcePrefix string
+}
+
+// ResourceAllocation is the number of VFs of an SR-IOV resource allocatable on a node.
+type ResourceAllocation struct {
+	Node     string
+	Resource string
+	// Expected is the number of VFs the policies request for the resource on the node, none once they are removed.
+	Expected int64
+	// Allocatable is the number of VFs of the resource in the allocatable of the node.
+	Allocatable int64
+}
+
+// PolicyWorkflow applies SriovNetworkNodePolicies and waits for the nodes they select to be configured accordingly,
+// and removes them again on Rollback.
+type PolicyWorkflow struct {
+	// Policies are the SriovNetworkNodePolicies applied by the workflow.
+	Policies []*PolicyBuilder
+	// Nodes are the names of the nodes selected by the policies, set by Apply and Rollback.
+	Nodes []string
+	// Allocations are the SR-IOV resources of the policies verified on the nodes by the last Apply or Rollback.
+	Allocations []ResourceAllocation
+
+	apiClient *clients.Settings
+	nsName    string
+	options   PolicyWorkflowOptions
+}
+
+// NewPolicyWorkflow returns a PolicyWorkflow for policies after checking that they are valid and share the
+// namespace of the SR-IOV operator.
+func NewPolicyWorkflow(
+	apiClient *clients.Settings, options PolicyWorkflowOptions, policies ...*PolicyBuilder) (*PolicyWorkflow, error) {
+	glog.V(100).Infof("Initializing new SR-IOV policy workflow for %d policies", len(policies))
+
+	if apiClient == nil {
+		glog.V(100).Infof("The apiClient of the SR-IOV policy workflow is nil")
+
+		return nil, fmt.Errorf("sriov policy workflow 'apiClient' cannot be nil")
+	}
+
+	if len(policies) == 0 {
+		return nil, fmt.Errorf("sriov policy workflow 'policies' cannot be empty")
+	}
+
+	for _, policy := range policies {
+		if valid, err := policy.validate(); !valid {
+			return nil, err
+		}
+
+		if policy.Definition.Namespace != policies[0].Definition.Namespace {
+			return nil, fmt.Errorf("sriov policy workflow policies must be in the same namespace, found %s and %s",
+				policies[0].Definition.Namespace, policy.Definition.Namespace)
+		}
+	}
+
+	if err := apiClient.AttachScheme(srIovV1.AddToScheme); err != nil {
+		glog.V(100).Infof("Failed to add sriovv1 scheme to client schemes")
+
+		return nil, err
+	}
+
+	if options.Timeout <= 0 {
+		options.Timeout = DefaultPolicyWorkflowTimeout
+	}
+
+	if options.PollInterval <= 0 {
+		options.PollInterval = DefaultPolicyWorkflowPollInterval
+	}
+
+	if options.ResourcePrefix == "" {
+		options.ResourcePrefix = DefaultResourcePrefix
+	}
+
+	return &PolicyWorkflow{
+		Policies:  policies,
+		apiClient: apiClient,
+		nsName:    policies[0].Definition.Namespace,
+		options:   options,
+	}, nil
+}
+
+// Apply creates the policies and waits for the nodes to apply them. See ApplyWithContext.
+func (workflow *PolicyWorkflow) Apply() error {
+	return workflow.ApplyWithContext(context.Background())
+}
+
+// ApplyWithContext creates the policies and waits until the timeout expires, ctx is done or, on every node selected
+// by the policies, the SriovNetworkNodeState includes the VF groups of the policies, has syncStatus Succeeded with
+// the number of VFs the policies request on every PF they select and the node advertises as many allocatable VFs per
+// resource as the policies request, counting only the VF range of a PF partitioned with pfNames. A
+// SriovNetworkNodeState failing to sync the policies ends the wait with its lastSyncError.
+func (workflow *PolicyWorkflow) ApplyWithContext(ctx context.Context) error {
+	if workflow == nil || workflow.apiClient == nil {
+		return fmt.Errorf("cannot apply an uninitialized sriov policy workflow")
+	}
+
+	glog.V(100).Infof("Applying %d SR-IOV policies in namespace %s", len(workflow.Policies), workflow.nsName)
+
+	if err := workflow.selectNodes(ctx); err != nil {
+		return err
+	}
+
+	for _, policy := range workflow.Policies {
+		if _, err := policy.CreateWithContext(ctx); err != nil {
+			return fmt.Errorf("failed to create SriovNetworkNodePolicy %s in namespace %s: %w",
+				policy.Definition.Name, workflow.nsName, err)
+		}
+	}
+
+	return workflow.waitForNodes(ctx, true)
+}
+
+// Rollback removes the policies and waits for the nodes to settle. See RollbackWithContext.
+func (workflow *PolicyWorkflow) Rollback() error {
+	return workflow.RollbackWithContext(context.Background())
+}
+
+// RollbackWithContext deletes the policies and waits until the timeout expires, ctx is done or, on every node selected
+// by the policies, the SriovNetworkNodeState no longer includes the VF groups of the policies, has syncStatus
+// Succeeded and the node no longer advertises allocatable VFs of the resources of the policies. Policies that do not
+// exist are ignored, so that Rollback can clean up after a failed Apply.
+func (workflow *PolicyWorkflow) RollbackWithContext(ctx context.Context) error {
+	if workflow == nil || workflow.apiClient == nil {
+		return fmt.Errorf("cannot roll back an uninitialized sriov policy workflow")
+	}
+
+	glog.V(100).Infof("Rolling back %d SR-IOV policies in namespace %s", len(workflow.Policies), workflow.nsName)
+
+	if err := workflow.selectNodes(ctx); err != nil {
+		return err
+	}
+
+	var errs []error
+
+	for _, policy := range workflow.Policies {
+		if err := policy.DeleteWithContext(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("failed to delete SriovNetworkNodePolicy %s in namespace %s: %w",
+				policy.Definition.Name, workflow.nsName, err))
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return err
+	}
+
+	return workflow.waitForNodes(ctx, false)
+}
+
+// selectNodes sets Nodes to the names of the nodes selected by at least one policy. Every policy has to select at
+// least one node.
+func (workflow *PolicyWorkflow) selectNodes(ctx context.Context) error {
+	nodeList := &corev1.NodeList{}
+	if err := workflow.apiClient.List(ctx, nodeList); err != nil {
+		return fmt.Errorf("failed to list nodes: %w", err)
+	}
+
+	workflow.Nodes = nil
+
+	for _, policy := range workflow.Policies {
+		var selected bool
+
+		for index := range nodeList.Items {
+			node := &nodeList.Items[index]
+
+			if !policy.Definition.Selected(node) {
+				continue
+			}
+
+			selected = true
+
+			if !slices.Contains(workflow.Nodes, node.Name) {
+				workflow.Nodes = append(workflow.Nodes, node.Name)
+			}
+		}
+
+		if !selected {
+			return fmt.Errorf("SriovNetworkNodePolicy %s selects no node with node selector %v",
+				policy.Definition.Name, policy.Definition.Spec.NodeSelector)
+		}
+	}
+
+	slices.Sort(workflow.Nodes)
+
+	return nil
+}
+
+// waitForNodes waits for all the nodes to have applied the policies if applied is true, or to have removed them
+// otherwise. On timeout, the error lists what every node is still waiting for.
+func (workflow *PolicyWorkflow) waitForNodes(ctx context.Context, applied bool) error {
+	action := "apply"
+	if !applied {
+		action = "remove"
+	}
+
+	var pending []string
+
+	err := wait.PollUntilContextTimeout(
+		ctx, workflow.options.PollInterval, workflow.options.Timeout, true, func(ctx context.Context) (bool, error) {
+			var allocations []ResourceAllocation
+
+			pending = nil
+
+			for _, nodeName := range workflow.Nodes {
+				nodeAllocations, reason, nodeErr := workflow.checkNode(ctx, nodeName, applied)
+				if nodeErr != nil {
+					return false, nodeErr
+				}
+
+				if reason != "" {
+					pending = append(pending, fmt.Sprintf("node %s %s", nodeName, reason))
+				}
+
+				allocations = append(allocations, nodeAllocations...)
+			}
+
+			workflow.Allocations = allocations
+
+			if len(pending) > 0 {
+				glog.V(100).Infof("Waiting for SR-IOV nodes to %s policies: %s", action, strings.Join(pending, "; "))
+			}
+
+			return len(pending) == 0, nil
+		})
+
+	if err == nil {
+		glog.V(100).Infof("All %d SR-IOV nodes finished to %s policies", len(workflow.Nodes), action)
+
+		return nil
+	}
+
+	if len(pending) > 0 && (wait.Interrupted(err) || errors.Is(err, context.Canceled)) {
+		return fmt.Errorf("failed waiting for SR-IOV nodes to %s policies: %s: %w",
+			action, strings.Join(pending, "; "), err)
+	}
+
+	return fmt.Errorf("failed waiting for SR-IOV nodes to %s policies: %w", action, err)
+}
+
+// checkNode returns the allocations of the resources of the policies on nodeName and, if the node is not done yet,
+// the reason why. An error is returned if the SriovNetworkNodeState of the node failed to sync.
+func (workflow *PolicyWorkflow) checkNode(
+	ctx context.Context, nodeName string, applied bool) ([]ResourceAllocation, string, error) {
+	nodeState := &srIovV1.SriovNetworkNodeState{}
+
+	err := workflow.apiClient.Get(ctx, runtimeClient.ObjectKey{Name: nodeName, Namespace: workflow.nsName}, nodeState)
+	if k8serrors.IsNotFound(err) {
+		return nil, "has no SriovNetworkNodeState", nil
+	}
+
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get SriovNetworkNodeState %s in namespace %s: %w",
+			nodeName, workflow.nsName, err)
+	}
+
+	if missing := workflow.policiesInNodeState(nodeState, !applied); len(missing) > 0 {
+		if applied {
+			return nil, fmt.Sprintf("does not include policies %s", strings.Join(missing, ", ")), nil
+		}
+
+		return nil, fmt.Sprintf("still includes policies %s", strings.Join(missing, ", ")), nil
+	}
+
+	switch nodeState.Status.SyncStatus {
+	case syncStatusSucceeded:
+	case syncStatusFailed:
+		return nil, "", fmt.Errorf("SriovNetworkNodeState %s failed to sync: %s",
+			nodeName, nodeState.Status.LastSyncError)
+	default:
+		return nil, fmt.Sprintf("has syncStatus %q", nodeState.Status.SyncStatus), nil
+	}
+
+	node := &corev1.Node{}
+	if err := workflow.apiClient.Get(ctx, runtimeClient.ObjectKey{Name: nodeName}, node); err != nil {
+		return nil, "", fmt.Errorf("failed to get node %s: %w", nodeName, err)
+	}
+
+	if applied {
+		for _, policy := range workflow.Policies {
+			if !policy.Definition.Selected(node) {
+				continue
+			}
+
+			for _, statusInterface := range nodeState.Status.Interfaces {
+				if requestedVfs(policy.Definition, &statusInterface) > 0 &&
+					statusInterface.NumVfs != policy.Definition.Spec.NumVfs {
+					return nil, fmt.Sprintf("has %d VFs on interface %s instead of %d",
+						statusInterface.NumVfs, statusInterface.Name, policy.Definition.Spec.NumVfs), nil
+				}
+			}
+		}
+	}
+
+	allocations := workflow.getAllocations(nodeState, node, applied)
+
+	for _, allocation := range allocations {
+		if allocation.Allocatable != allocation.Expected {
+			return allocations, fmt.Sprintf("has %d allocatable VFs of resource %s instead of %d",
+				allocation.Allocatable, allocation.Resource, allocation.Expected), nil
+		}
+	}
+
+	return allocations, "", nil
+}
+
+// policiesInNodeState returns the names of the policies whose VF groups nodeState includes if included is true, or
+// of the policies that have a VF group on none of the interfaces of nodeState otherwise.
+func (workflow *PolicyWorkflow) policiesInNodeState(
+	nodeState *srIovV1.SriovNetworkNodeState, included bool) []string {
+	var policyNames []string
+
+	for _, policy := range workflow.Policies {
+		var found bool
+
+		for _, specInterface := range nodeState.Spec.Interfaces {
+			for _, vfGroup := range specInterface.VfGroups {
+				found = found || vfGroup.PolicyName == policy.Definition.Name
+			}
+		}
+
+		if found == included {
+			policyNames = append(policyNames, policy.Definition.Name)
+		}
+	}
+
+	return policyNames
+}
+
+// getAllocations compares, for every resource of the policies, the number of VFs the policies selecting node request
+// on the PFs of nodeState if applied is true, or none otherwise, with the allocatable of node.
+func (workflow *PolicyWorkflow) getAllocations(
+	nodeState *srIovV1.SriovNetworkNodeState, node *corev1.Node, applied bool) []ResourceAllocation {
+	var allocations []ResourceAllocation
+
+	for _, policy := range workflow.Policies {
+		resourceName := policy.Definition.Spec.ResourceName
+
+		index := slices.IndexFunc(allocations, func(allocation ResourceAllocation) bool {
+			return allocation.Resource == resourceName
+		})
+		if index < 0 {
+			allocation := ResourceAllocation{Node: node.Name, Resource: resourceName}
+
+			quantity, ok := node.Status.Allocatable[corev1.ResourceName(workflow.options.ResourcePrefix+"/"+resourceName)]
+			if ok {
+				allocation.Allocatable = quantity.Value()
+			}
+
+			index = len(allocations)
+			allocations = append(allocations, allocation)
+		}
+
+		if !applied || !policy.Definition.Selected(node) {
+			continue
+		}
+
+		for _, statusInterface := range nodeState.Status.Interfaces {
+			allocations[index].Expected += int64(requestedVfs(policy.Definition, &statusInterface))
+		}
+	}
+
+	return allocations
+}
+
+// requestedVfs returns the number of VFs policy requests on the PF iface: the size of the VF range given for iface in
+// the pfNames of the policy if any, or else its numVfs. It is zero if the policy does not select iface.
+func requestedVfs(policy *srIovV1.SriovNetworkNodePolicy, iface *srIovV1.InterfaceExt) int {
+	if policy.Spec.NicSelector.IsEmpty() || !policy.Spec.NicSelector.Selected(iface) {
+		return 0
+	}
+
+	for _, pfName := range policy.Spec.NicSelector.PfNames {
+		if name, vfRange := srIovV1.SplitDeviceFromRange(pfName); name == iface.Name && vfRange != "" {
+			return getVfRangeSize(vfRange)
+		}
+	}
+
+	return policy.Spec.NumVfs
+}
+
+// getVfRangeSize returns the number of VFs in vfRange, which is in first-last format.
+func getVfRangeSize(vfRange string) int {
+	first, last, found := strings.Cut(vfRange, "-")
+	if !found {
+		return 0
+	}
+
+	firstVF, err := strconv.Atoi(first)
+	if err != nil {
+		return 0
+	}
+
+	lastVF, err := strconv.Atoi(last)
+	if err != nil || lastVF < firstVF {
+		return 0
+	}
+
+	return lastVF - firstVF + 1
+}
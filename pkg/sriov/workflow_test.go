@@ -0,0 +1,413 @@
+package sriov
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	srIovV1 "github.com/k8snetworkplumbingwg/sriov-network-operator/api/v1"
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const defaultWorkflowPciAddress = "0000:3b:00.0"
+
+var defaultWorkflowOptions = PolicyWorkflowOptions{Timeout: 200 * time.Millisecond, PollInterval: 10 * time.Millisecond}
+
+func TestNewPolicyWorkflow(t *testing.T) {
+	testCases := []struct {
+		policies      func(apiClient *clients.Settings) []*PolicyBuilder
+		client        bool
+		expectedError string
+	}{
+		{
+			policies: func(apiClient *clients.Settings) []*PolicyBuilder {
+				return []*PolicyBuilder{buildValidSriovPolicyTestBuilder(apiClient)}
+			},
+			client: true,
+		},
+		{
+			policies: func(apiClient *clients.Settings) []*PolicyBuilder {
+				return []*PolicyBuilder{buildValidSriovPolicyTestBuilder(apiClient)}
+			},
+			client:        false,
+			expectedError: "sriov policy workflow 'apiClient' cannot be nil",
+		},
+		{
+			policies: func(apiClient *clients.Settings) []*PolicyBuilder {
+				return nil
+			},
+			client:        true,
+			expectedError: "sriov policy workflow 'policies' cannot be empty",
+		},
+		{
+			policies: func(apiClient *clients.Settings) []*PolicyBuilder {
+				return []*PolicyBuilder{buildInvalidSriovPolicyTestBuilder(apiClient)}
+			},
+			client:        true,
+			expectedError: "SriovNetworkNodePolicy 'nsname' cannot be empty",
+		},
+		{
+			policies: func(apiClient *clients.Settings) []*PolicyBuilder {
+				return []*PolicyBuilder{
+					buildValidSriovPolicyTestBuilder(apiClient),
+					NewPolicyBuilder(apiClient, "other", "othernamespace", defaultPolicyResName, defaultPolicyVFNum,
+						defaultPolicyNICs, defaultPolicyNodeSelector),
+				}
+			},
+			client: true,
+			expectedError: "sriov policy workflow policies must be in the same namespace, " +
+				"found testnamespace and othernamespace",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var testSettings *clients.Settings
+
+		apiClient := clients.GetTestClients(clients.TestClientParams{SchemeAttachers: testSchemes})
+
+		if testCase.client {
+			testSettings = apiClient
+		}
+
+		workflow, err := NewPolicyWorkflow(testSettings, PolicyWorkflowOptions{}, testCase.policies(apiClient)...)
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+			assert.Nil(t, workflow)
+
+			continue
+		}
+
+		assert.Nil(t, err)
+		assert.Equal(t, defaultPolicyNsName, workflow.nsName)
+		assert.Equal(t, DefaultPolicyWorkflowTimeout, workflow.options.Timeout)
+		assert.Equal(t, DefaultPolicyWorkflowPollInterval, workflow.options.PollInterval)
+		assert.Equal(t, DefaultResourcePrefix, workflow.options.ResourcePrefix)
+	}
+}
+
+//nolint:funlen
+func TestPolicyWorkflowApply(t *testing.T) {
+	testCases := []struct {
+		nodeState           *srIovV1.SriovNetworkNodeState
+		nodeLabels          map[string]string
+		allocatable         int64
+		expectedAllocations []ResourceAllocation
+		expectedError       string
+		expectedTimeout     bool
+	}{
+		{
+			nodeState:   buildWorkflowNodeState(true, "Succeeded"),
+			nodeLabels:  defaultPolicyNodeSelector,
+			allocatable: 1,
+			expectedAllocations: []ResourceAllocation{
+				{Node: defaultNodeName, Resource: defaultPolicyResName, Expected: 1, Allocatable: 1},
+			},
+		},
+		{
+			nodeState:   buildWorkflowNodeState(true, "InProgress"),
+			nodeLabels:  defaultPolicyNodeSelector,
+			allocatable: 1,
+			expectedError: "failed waiting for SR-IOV nodes to apply policies: node test1 has syncStatus " +
+				"\"InProgress\": context deadline exceeded",
+			expectedTimeout: true,
+		},
+		{
+			nodeState:   buildWorkflowNodeState(true, "Succeeded"),
+			nodeLabels:  defaultPolicyNodeSelector,
+			allocatable: 0,
+			expectedError: "failed waiting for SR-IOV nodes to apply policies: node test1 has 0 allocatable VFs " +
+				"of resource resname instead of 1: context deadline exceeded",
+			expectedTimeout: true,
+		},
+		{
+			nodeState:   buildWorkflowNodeState(false, "Succeeded"),
+			nodeLabels:  defaultPolicyNodeSelector,
+			allocatable: 1,
+			expectedError: "failed waiting for SR-IOV nodes to apply policies: node test1 does not include " +
+				"policies sriovnet: context deadline exceeded",
+			expectedTimeout: true,
+		},
+		{
+			nodeState:   nil,
+			nodeLabels:  defaultPolicyNodeSelector,
+			allocatable: 1,
+			expectedError: "failed waiting for SR-IOV nodes to apply policies: node test1 has no " +
+				"SriovNetworkNodeState: context deadline exceeded",
+			expectedTimeout: true,
+		},
+		{
+			nodeState:   buildWorkflowNodeState(true, "Failed"),
+			nodeLabels:  defaultPolicyNodeSelector,
+			allocatable: 1,
+			expectedError: "failed waiting for SR-IOV nodes to apply policies: SriovNetworkNodeState test1 failed to sync: " +
+				"failed to configure VFs",
+		},
+		{
+			nodeState:     buildWorkflowNodeState(true, "Succeeded"),
+			nodeLabels:    map[string]string{"node": "other"},
+			allocatable:   1,
+			expectedError: "SriovNetworkNodePolicy sriovnet selects no node with node selector map[node:selector]",
+		},
+	}
+
+	for _, testCase := range testCases {
+		objects := []runtime.Object{buildWorkflowNode(testCase.nodeLabels, testCase.allocatable)}
+
+		if testCase.nodeState != nil {
+			objects = append(objects, testCase.nodeState)
+		}
+
+		testSettings := clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects:  objects,
+			SchemeAttachers: testSchemes,
+		})
+
+		workflow, err := NewPolicyWorkflow(
+			testSettings, defaultWorkflowOptions, buildValidSriovPolicyTestBuilder(testSettings))
+		assert.Nil(t, err)
+
+		err = workflow.Apply()
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+			assert.Equal(t, testCase.expectedTimeout, errors.Is(err, context.DeadlineExceeded))
+
+			continue
+		}
+
+		assert.Nil(t, err)
+		assert.Equal(t, []string{defaultNodeName}, workflow.Nodes)
+		assert.Equal(t, testCase.expectedAllocations, workflow.Allocations)
+		assert.True(t, workflow.Policies[0].Exists())
+	}
+}
+
+func TestPolicyWorkflowApplyWithSimulator(t *testing.T) {
+	markSynced := func(syncStatus string) func(object runtime.Object) {
+		return func(object runtime.Object) {
+			nodeState, ok := object.(*srIovV1.SriovNetworkNodeState)
+			if ok {
+				nodeState.Status.SyncStatus = syncStatus
+			}
+		}
+	}
+
+	testSettings := clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: []runtime.Object{
+			buildWorkflowNode(defaultPolicyNodeSelector, 1), buildWorkflowNodeState(true, "")},
+		SchemeAttachers: testSchemes,
+		Simulators: []clients.ControllerSimulator{{
+			Object:   &srIovV1.SriovNetworkNodeState{},
+			Triggers: []clients.SimulatorTrigger{clients.SimulatorTriggerRead},
+			Reconcile: clients.SimulateSequence(
+				markSynced("InProgress"), markSynced("InProgress"), markSynced("Succeeded")),
+		}},
+	})
+
+	workflow, err := NewPolicyWorkflow(
+		testSettings, defaultWorkflowOptions, buildValidSriovPolicyTestBuilder(testSettings))
+	assert.Nil(t, err)
+
+	err = workflow.ApplyWithContext(t.Context())
+	assert.Nil(t, err)
+	assert.Equal(t, []ResourceAllocation{
+		{Node: defaultNodeName, Resource: defaultPolicyResName, Expected: 1, Allocatable: 1},
+	}, workflow.Allocations)
+}
+
+func TestPolicyWorkflowApplyRequestedVfs(t *testing.T) {
+	testCases := []struct {
+		vfRange             bool
+		renderedVfs         int
+		allocatable         int64
+		expectedAllocations []ResourceAllocation
+		expectedError       string
+	}{
+		{
+			vfRange:     true,
+			renderedVfs: 4,
+			allocatable: 2,
+			expectedAllocations: []ResourceAllocation{
+				{Node: defaultNodeName, Resource: defaultPolicyResName, Expected: 2, Allocatable: 2},
+			},
+		},
+		{
+			vfRange:     false,
+			renderedVfs: 4,
+			allocatable: 4,
+			expectedAllocations: []ResourceAllocation{
+				{Node: defaultNodeName, Resource: defaultPolicyResName, Expected: 4, Allocatable: 4},
+			},
+		},
+		{
+			vfRange:     false,
+			renderedVfs: 1,
+			allocatable: 1,
+			expectedError: "failed waiting for SR-IOV nodes to apply policies: node test1 has 1 VFs on interface eth1 " +
+				"instead of 4: context deadline exceeded",
+		},
+		{
+			vfRange:     false,
+			renderedVfs: 4,
+			allocatable: 2,
+			expectedError: "failed waiting for SR-IOV nodes to apply policies: node test1 has 2 allocatable VFs " +
+				"of resource resname instead of 4: context deadline exceeded",
+		},
+	}
+
+	for _, testCase := range testCases {
+		// The node state is synced with renderedVfs VFs, which may be stale compared to the policy.
+		nodeState := buildWorkflowNodeState(true, "Succeeded")
+		nodeState.Spec.Interfaces[0].NumVfs = testCase.renderedVfs
+		nodeState.Status.Interfaces[0].NumVfs = testCase.renderedVfs
+
+		testSettings := clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects:  []runtime.Object{buildWorkflowNode(defaultPolicyNodeSelector, testCase.allocatable), nodeState},
+			SchemeAttachers: testSchemes,
+		})
+
+		policy := NewPolicyBuilder(testSettings, defaultPolicyName, defaultPolicyNsName, defaultPolicyResName, 4,
+			defaultPolicyNICs, defaultPolicyNodeSelector)
+		if testCase.vfRange {
+			policy.WithVFRange(0, 1)
+		}
+
+		workflow, err := NewPolicyWorkflow(testSettings, defaultWorkflowOptions, policy)
+		assert.Nil(t, err)
+
+		err = workflow.ApplyWithContext(t.Context())
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.Nil(t, err)
+		assert.Equal(t, testCase.expectedAllocations, workflow.Allocations)
+	}
+}
+
+func TestPolicyWorkflowRollback(t *testing.T) {
+	testCases := []struct {
+		nodeState       *srIovV1.SriovNetworkNodeState
+		allocatable     int64
+		expectedError   string
+		expectedTimeout bool
+	}{
+		{
+			nodeState:   buildWorkflowNodeState(false, "Succeeded"),
+			allocatable: 0,
+		},
+		{
+			nodeState:   buildWorkflowNodeState(true, "Succeeded"),
+			allocatable: 1,
+			expectedError: "failed waiting for SR-IOV nodes to remove policies: node test1 still includes " +
+				"policies sriovnet: context deadline exceeded",
+			expectedTimeout: true,
+		},
+		{
+			nodeState:   buildWorkflowNodeState(false, "Succeeded"),
+			allocatable: 1,
+			expectedError: "failed waiting for SR-IOV nodes to remove policies: node test1 has 1 allocatable VFs " +
+				"of resource resname instead of 0: context deadline exceeded",
+			expectedTimeout: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects: []runtime.Object{
+				buildWorkflowNode(defaultPolicyNodeSelector, testCase.allocatable),
+				testCase.nodeState,
+				buildDummySrIovPolicy(defaultPolicyName, defaultPolicyNsName),
+			},
+			SchemeAttachers: testSchemes,
+		})
+
+		workflow, err := NewPolicyWorkflow(
+			testSettings, defaultWorkflowOptions, buildValidSriovPolicyTestBuilder(testSettings))
+		assert.Nil(t, err)
+
+		err = workflow.Rollback()
+		assert.False(t, workflow.Policies[0].Exists())
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+			assert.Equal(t, testCase.expectedTimeout, errors.Is(err, context.DeadlineExceeded))
+
+			continue
+		}
+
+		assert.Nil(t, err)
+		assert.Equal(t, []ResourceAllocation{
+			{Node: defaultNodeName, Resource: defaultPolicyResName, Expected: 0, Allocatable: 0},
+		}, workflow.Allocations)
+	}
+}
+
+func TestGetVfRangeSize(t *testing.T) {
+	testCases := []struct {
+		vfRange      string
+		expectedSize int
+	}{
+		{vfRange: "0-7", expectedSize: 8},
+		{vfRange: "2-2", expectedSize: 1},
+		{vfRange: "4-2", expectedSize: 0},
+		{vfRange: "4", expectedSize: 0},
+		{vfRange: "a-2", expectedSize: 0},
+	}
+
+	for _, testCase := range testCases {
+		assert.Equal(t, testCase.expectedSize, getVfRangeSize(testCase.vfRange), fmt.Sprintf("range %s", testCase.vfRange))
+	}
+}
+
+// buildWorkflowNode returns the node selected by the default policy when labels match its node selector, with
+// allocatable VFs of the default resource.
+func buildWorkflowNode(labels map[string]string, allocatable int64) *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: defaultNodeName, Labels: labels},
+		Status: corev1.NodeStatus{Allocatable: corev1.ResourceList{
+			corev1.ResourceName(DefaultResourcePrefix + "/" + defaultPolicyResName): *resource.NewQuantity(
+				allocatable, resource.DecimalSI),
+		}},
+	}
+}
+
+// buildWorkflowNodeState returns the SriovNetworkNodeState of the default node, with one VF of the default policy if
+// withPolicy is true.
+func buildWorkflowNodeState(withPolicy bool, syncStatus string) *srIovV1.SriovNetworkNodeState {
+	nodeState := buildNodeNetworkStateSyncStatus(defaultNodeName, defaultNodeNsName, syncStatus)
+	nodeState.Status.Interfaces = srIovV1.InterfaceExts{{Name: "eth1", PciAddress: defaultWorkflowPciAddress}}
+
+	if syncStatus == "Failed" {
+		nodeState.Status.LastSyncError = "failed to configure VFs"
+	}
+
+	if !withPolicy {
+		return nodeState
+	}
+
+	nodeState.Spec.Interfaces = srIovV1.Interfaces{{
+		PciAddress: defaultWorkflowPciAddress,
+		Name:       "eth1",
+		NumVfs:     defaultPolicyVFNum,
+		VfGroups: []srIovV1.VfGroup{{
+			ResourceName: defaultPolicyResName,
+			VfRange:      "0-0",
+			PolicyName:   defaultPolicyName,
+		}},
+	}}
+	nodeState.Status.Interfaces[0].NumVfs = defaultPolicyVFNum
+
+	return nodeState
+}
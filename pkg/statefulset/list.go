@@ -11,6 +11,12 @@ import (
 
 // List returns statefulset inventory in the given namespace.
 func List(apiClient *clients.Settings, nsname string, options ...metav1.ListOptions) ([]*Builder, error) {
+	return ListWithContext(context.Background(), apiClient, nsname, options...)
+}
+
+// ListWithContext returns statefulset inventory in the given namespace using the provided context.
+func ListWithContext(
+	ctx context.Context, apiClient *clients.Settings, nsname string, options ...metav1.ListOptions) ([]*Builder, error) {
 	if nsname == "" {
 		glog.V(100).Infof("statefulset 'nsname' parameter can not be empty")
 
@@ -33,7 +39,7 @@ func List(apiClient *clients.Settings, nsname string, options ...metav1.ListOpti
 
 	glog.V(100).Infof(logMessage)
 
-	statefulsetList, err := apiClient.StatefulSets(nsname).List(context.TODO(), passedOptions)
+	statefulsetList, err := apiClient.StatefulSets(nsname).List(ctx, passedOptions)
 
 	if err != nil {
 		glog.V(100).Infof("Failed to list statefulsets in the namespace %s due to %s", nsname, err.Error())
@@ -59,6 +65,12 @@ func List(apiClient *clients.Settings, nsname string, options ...metav1.ListOpti
 
 // ListInAllNamespaces returns statefulset inventory in all namespaces.
 func ListInAllNamespaces(apiClient *clients.Settings, options ...metav1.ListOptions) ([]*Builder, error) {
+	return ListInAllNamespacesWithContext(context.Background(), apiClient, options...)
+}
+
+// ListInAllNamespacesWithContext returns statefulset inventory in all namespaces using the provided context.
+func ListInAllNamespacesWithContext(
+	ctx context.Context, apiClient *clients.Settings, options ...metav1.ListOptions) ([]*Builder, error) {
 	passedOptions := metav1.ListOptions{}
 	logMessage := "Listing statefulsets in all namespaces"
 
@@ -75,7 +87,7 @@ func ListInAllNamespaces(apiClient *clients.Settings, options ...metav1.ListOpti
 
 	glog.V(100).Infof(logMessage)
 
-	statefulsetList, err := apiClient.StatefulSets("").List(context.TODO(), passedOptions)
+	statefulsetList, err := apiClient.StatefulSets("").List(ctx, passedOptions)
 
 	if err != nil {
 		glog.V(100).Infof("Failed to list statefulsets in all namespaces due to %s", err.Error())
@@ -182,6 +182,12 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
 
 // Create generates a statefulset in cluster and stores the created object in struct.
 func (builder *Builder) Create() (*Builder, error) {
+	return builder.CreateWithContext(context.Background())
+}
+
+// CreateWithContext generates a statefulset in cluster using the provided context and stores the created object in
+// struct.
+func (builder *Builder) CreateWithContext(ctx context.Context) (*Builder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
@@ -189,9 +195,9 @@ func (builder *Builder) Create() (*Builder, error) {
 	glog.V(100).Infof("Creating statefulset %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
 
 	var err error
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		builder.Object, err = builder.apiClient.StatefulSets(builder.Definition.Namespace).Create(
-			context.TODO(), builder.Definition, metav1.CreateOptions{})
+			ctx, builder.Definition, metav1.CreateOptions{})
 	}
 
 	return builder, err
@@ -199,6 +205,11 @@ func (builder *Builder) Create() (*Builder, error) {
 
 // Exists checks whether the given statefulset exists.
 func (builder *Builder) Exists() bool {
+	return builder.ExistsWithContext(context.Background())
+}
+
+// ExistsWithContext checks whether the given statefulset exists using the provided context.
+func (builder *Builder) ExistsWithContext(ctx context.Context) bool {
 	if valid, _ := builder.validate(); !valid {
 		return false
 	}
@@ -208,20 +219,25 @@ func (builder *Builder) Exists() bool {
 
 	var err error
 	builder.Object, err = builder.apiClient.StatefulSets(builder.Definition.Namespace).Get(
-		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+		ctx, builder.Definition.Name, metav1.GetOptions{})
 
 	return err == nil || !k8serrors.IsNotFound(err)
 }
 
 // Delete a statefulset from the cluster.
 func (builder *Builder) Delete() error {
+	return builder.DeleteWithContext(context.Background())
+}
+
+// DeleteWithContext deletes a statefulset from the cluster using the provided context.
+func (builder *Builder) DeleteWithContext(ctx context.Context) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
 
 	glog.V(100).Infof("Deleting statefulset %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		glog.V(100).Infof("Statefulset %s cannot be deleted because it does not exist", builder.Definition.Name)
 
 		builder.Object = nil
@@ -230,7 +246,7 @@ func (builder *Builder) Delete() error {
 	}
 
 	err := builder.apiClient.StatefulSets(builder.Definition.Namespace).Delete(
-		context.TODO(), builder.Definition.Name, metav1.DeleteOptions{})
+		ctx, builder.Definition.Name, metav1.DeleteOptions{})
 
 	if err != nil {
 		return err
@@ -243,6 +259,11 @@ func (builder *Builder) Delete() error {
 
 // IsReady periodically checks if statefulset is in ready status.
 func (builder *Builder) IsReady(timeout time.Duration) bool {
+	return builder.IsReadyWithContext(context.Background(), timeout)
+}
+
+// IsReadyWithContext periodically checks if statefulset is in ready status until the timeout or until ctx is done.
+func (builder *Builder) IsReadyWithContext(ctx context.Context, timeout time.Duration) bool {
 	if valid, _ := builder.validate(); !valid {
 		return false
 	}
@@ -250,15 +271,15 @@ func (builder *Builder) IsReady(timeout time.Duration) bool {
 	glog.V(100).Infof("Running periodic check until statefulset %s in namespace %s is ready",
 		builder.Definition.Name, builder.Definition.Namespace)
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		return false
 	}
 
 	err := wait.PollUntilContextTimeout(
-		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+		ctx, time.Second, timeout, true, func(ctx context.Context) (bool, error) {
 			var err error
 			builder.Object, err = builder.apiClient.StatefulSets(builder.Definition.Namespace).Get(
-				context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+				ctx, builder.Definition.Name, metav1.GetOptions{})
 
 			if err != nil {
 				return false, err
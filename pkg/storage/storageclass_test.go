@@ -307,7 +307,10 @@ func TestClassCreate(t *testing.T) {
 		assert.Equal(t, testCase.expectedError, err)
 
 		if testCase.expectedError == nil {
-			assert.Equal(t, classBuilder.Definition, classBuilder.Object)
+			// The resource version is set by the fake cluster on creation.
+			createdClass := classBuilder.Object.DeepCopy()
+			createdClass.ResourceVersion = ""
+			assert.Equal(t, classBuilder.Definition, createdClass)
 		}
 	}
 }
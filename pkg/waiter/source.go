@@ -0,0 +1,151 @@
+package waiter
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/openshift-kni/eco-goinfra/pkg/events"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+// TypedClient is the part of a typed clientset interface for a resource, such as the one returned by
+// kubernetes.Interface.CoreV1().Pods(namespace), used to get and watch an object.
+type TypedClient[T runtime.Object] interface {
+	Get(ctx context.Context, name string, options metav1.GetOptions) (T, error)
+	Watch(ctx context.Context, options metav1.ListOptions) (watch.Interface, error)
+}
+
+// NewTypedSource returns a Source for the object with the given name using a typed clientset interface.
+func NewTypedSource[T runtime.Object](client TypedClient[T], name string) Source[T] {
+	return Source[T]{
+		Name: name,
+		Get: func(ctx context.Context) (T, error) {
+			return client.Get(ctx, name, metav1.GetOptions{})
+		},
+		Watch: func(ctx context.Context, resourceVersion string) (watch.Interface, error) {
+			return client.Watch(ctx, metav1.ListOptions{
+				FieldSelector:   fields.OneTermEqualSelector("metadata.name", name).String(),
+				ResourceVersion: resourceVersion,
+			})
+		},
+	}
+}
+
+// NewRuntimeSource returns a Source for the object with the given name and namespace using a controller-runtime
+// client. The namespace is empty for cluster-scoped objects. The object is only watched if client supports watches.
+func NewRuntimeSource[T any, PT interface {
+	*T
+	runtimeclient.Object
+}](client runtimeclient.Client, namespace, name string) Source[PT] {
+	source := Source[PT]{
+		Name: name,
+		Get: func(ctx context.Context) (PT, error) {
+			object := PT(new(T))
+			err := client.Get(ctx, runtimeclient.ObjectKey{Name: name, Namespace: namespace}, object)
+
+			return object, err
+		},
+	}
+
+	watchClient, ok := client.(runtimeclient.WithWatch)
+	if !ok {
+		return source
+	}
+
+	source.Watch = func(ctx context.Context, resourceVersion string) (watch.Interface, error) {
+		list, err := newObjectList(client.Scheme(), PT(new(T)))
+		if err != nil {
+			return nil, err
+		}
+
+		watcher, err := watchClient.Watch(ctx, list, &runtimeclient.ListOptions{
+			Namespace:     namespace,
+			FieldSelector: fields.OneTermEqualSelector("metadata.name", name),
+			Raw:           &metav1.ListOptions{ResourceVersion: resourceVersion},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return watch.Filter(watcher, convertEvent[T, PT]), nil
+	}
+
+	return source
+}
+
+// WithEvents returns a copy of source listing the events about the object of the given kind in namespace, so that
+// they are included in the WaitTimeoutError. The namespace is empty for cluster-scoped objects. Source is returned
+// unchanged if apiClient is nil.
+func WithEvents[T runtime.Object](source Source[T], apiClient *clients.Settings, kind, namespace string) Source[T] {
+	if apiClient == nil {
+		return source
+	}
+
+	source.Events = func(ctx context.Context) ([]corev1.Event, error) {
+		eventBuilders, err := events.ListForObjectWithContext(ctx, apiClient, namespace, kind, source.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		var objectEvents []corev1.Event
+
+		for _, eventBuilder := range eventBuilders {
+			objectEvents = append(objectEvents, *eventBuilder.Object)
+		}
+
+		return objectEvents, nil
+	}
+
+	return source
+}
+
+// newObjectList returns an empty list for the kind of object, typed if the scheme knows it.
+func newObjectList(scheme *runtime.Scheme, object runtime.Object) (runtimeclient.ObjectList, error) {
+	gvk, err := apiutil.GVKForObject(object, scheme)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get kind of object to watch: %w", err)
+	}
+
+	gvk.Kind += "List"
+
+	if typedList, err := scheme.New(gvk); err == nil {
+		if list, ok := typedList.(runtimeclient.ObjectList); ok {
+			return list, nil
+		}
+	}
+
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(gvk)
+
+	return list, nil
+}
+
+// convertEvent converts the unstructured object of event to its typed form. Other events are kept unchanged.
+func convertEvent[T any, PT interface {
+	*T
+	runtimeclient.Object
+}](event watch.Event) (watch.Event, bool) {
+	unstructuredObject, ok := event.Object.(*unstructured.Unstructured)
+	if !ok {
+		return event, true
+	}
+
+	object := PT(new(T))
+
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(unstructuredObject.UnstructuredContent(), object)
+	if err != nil {
+		return event, false
+	}
+
+	event.Object = object
+
+	return event, true
+}
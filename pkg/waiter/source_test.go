@@ -0,0 +1,66 @@
+package waiter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+func TestNewRuntimeSource(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: []runtime.Object{buildDummyConfigMap(map[string]string{"state": "running"})},
+	})
+
+	source := NewRuntimeSource[corev1.ConfigMap](testSettings.Client, defaultConfigMapNamespace, defaultConfigMapName)
+	assert.NotNil(t, source.Watch)
+
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		updateDummyConfigMap(t, testSettings, "done")
+	}()
+
+	start := time.Now()
+
+	configMap, err := For(t.Context(), source,
+		Options{Description: "test", Timeout: 5 * time.Second}, hasState("done"))
+	assert.Nil(t, err)
+	assert.Equal(t, "done", configMap.Data["state"])
+	assert.Less(t, time.Since(start), DefaultPollInterval)
+}
+
+func TestNewObjectList(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{})
+
+	list, err := newObjectList(testSettings.Scheme(), &corev1.ConfigMap{})
+	assert.Nil(t, err)
+	assert.IsType(t, &corev1.ConfigMapList{}, list)
+
+	unknown := &unstructured.Unstructured{}
+	unknown.SetAPIVersion("test.io/v1")
+	unknown.SetKind("Unknown")
+
+	list, err = newObjectList(testSettings.Scheme(), unknown)
+	assert.Nil(t, err)
+	assert.Equal(t, "UnknownList", list.GetObjectKind().GroupVersionKind().Kind)
+}
+
+func TestConvertEvent(t *testing.T) {
+	unstructuredConfigMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(
+		buildDummyConfigMap(map[string]string{"state": "done"}))
+	assert.Nil(t, err)
+
+	event, ok := convertEvent[corev1.ConfigMap](
+		watch.Event{Type: watch.Modified, Object: &unstructured.Unstructured{Object: unstructuredConfigMap}})
+	assert.True(t, ok)
+	assert.Equal(t, "done", event.Object.(*corev1.ConfigMap).Data["state"])
+
+	event, ok = convertEvent[corev1.ConfigMap](watch.Event{Type: watch.Modified, Object: buildDummyConfigMap(nil)})
+	assert.True(t, ok)
+	assert.IsType(t, &corev1.ConfigMap{}, event.Object)
+}
@@ -0,0 +1,631 @@
+package waiter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+const (
+	// DefaultPollInterval is the interval between two gets of the object when it cannot be watched.
+	DefaultPollInterval = time.Second
+	// maxHistoryLength is the number of observations and condition transitions kept in the history of a wait.
+	maxHistoryLength = 50
+	// maxSummaryEvents is the number of most recent events included in the message of a WaitTimeoutError.
+	maxSummaryEvents = 3
+	// eventsTimeout bounds the time spent listing the events of the object once a wait timed out.
+	eventsTimeout = 10 * time.Second
+)
+
+// Condition reports whether object satisfies a wait. Conditions are only evaluated for existing objects, a missing
+// object satisfies no condition. Returning an error aborts the wait.
+type Condition[T runtime.Object] func(object T) (bool, error)
+
+// AllOf returns a Condition satisfied when all the conditions are satisfied.
+func AllOf[T runtime.Object](conditions ...Condition[T]) Condition[T] {
+	return func(object T) (bool, error) {
+		for _, condition := range conditions {
+			satisfied, err := condition(object)
+			if err != nil || !satisfied {
+				return false, err
+			}
+		}
+
+		return true, nil
+	}
+}
+
+// Source gets and watches the single object a wait is about.
+type Source[T runtime.Object] struct {
+	// Name is the name of the object. Watch events for other objects are ignored.
+	Name string
+	// Get returns the current version of the object. A not found error means the object does not exist.
+	Get func(ctx context.Context) (T, error)
+	// Watch returns a watch of the object starting after resourceVersion. When nil or failing, the object is polled.
+	Watch func(ctx context.Context, resourceVersion string) (watch.Interface, error)
+	// Events returns the events about the object. When set, they are included in the WaitTimeoutError.
+	Events func(ctx context.Context) ([]corev1.Event, error)
+}
+
+// Options configures a wait.
+type Options struct {
+	// Description describes what is waited for in logs and errors, for example "pod test in namespace ns to run".
+	Description string
+	// Timeout bounds the duration of the wait when set, in addition to the context.
+	Timeout time.Duration
+	// HoldFor is how long a condition must be satisfied without interruption for the wait to succeed.
+	HoldFor time.Duration
+	// PollInterval is the interval between two gets of the object when it cannot be watched. Defaults to
+	// DefaultPollInterval.
+	PollInterval time.Duration
+}
+
+// Observation is a change in the state of the waited object observed during a wait.
+type Observation struct {
+	// Time is when the change was observed.
+	Time time.Time
+	// ResourceVersion is the resource version of the object, empty when it does not exist.
+	ResourceVersion string
+	// Exists is true if the object existed.
+	Exists bool
+	// Matched is the index of the first satisfied condition or -1 if none was.
+	Matched int
+	// Err is the error getting or watching the object, if any.
+	Err error
+}
+
+// String returns a short description of the observation.
+func (observation Observation) String() string {
+	timestamp := observation.Time.Format(time.RFC3339)
+
+	switch {
+	case observation.Err != nil:
+		return fmt.Sprintf("%s: error: %v", timestamp, observation.Err)
+	case !observation.Exists:
+		return fmt.Sprintf("%s: not found", timestamp)
+	case observation.Matched < 0:
+		return fmt.Sprintf("%s: resourceVersion %s not satisfied", timestamp, observation.ResourceVersion)
+	default:
+		return fmt.Sprintf("%s: resourceVersion %s satisfied condition %d",
+			timestamp, observation.ResourceVersion, observation.Matched)
+	}
+}
+
+// ConditionTransition is a change in a status condition of the waited object observed during a wait.
+type ConditionTransition struct {
+	// Time is when the change was observed.
+	Time time.Time
+	// Type, Status, Reason and Message are the fields of the condition after the change.
+	Type    string
+	Status  string
+	Reason  string
+	Message string
+}
+
+// String returns a short description of the transition.
+func (transition ConditionTransition) String() string {
+	description := fmt.Sprintf("%s=%s", transition.Type, transition.Status)
+
+	if transition.Reason != "" {
+		description += fmt.Sprintf(" (%s)", transition.Reason)
+	}
+
+	return description
+}
+
+// WaitTimeoutError is returned when a wait ends before its conditions are satisfied because the timeout expired or
+// the context was done. It unwraps to the context error, so errors.Is(err, context.DeadlineExceeded) still holds. Its
+// message summarizes the last observed status, conditions and events of the object.
+type WaitTimeoutError struct {
+	// Description describes what was waited for.
+	Description string
+	// LastObject is the last observed version of the object, nil if it was never observed to exist.
+	LastObject runtime.Object
+	// Status is a snapshot of the status of LastObject, nil if it has none.
+	Status map[string]any
+	// Conditions are the most recent changes in the status conditions of the object observed during the wait.
+	Conditions []ConditionTransition
+	// Events are the events about the object, from the oldest to the most recent, when the source provides them.
+	Events []corev1.Event
+	// History is the list of the most recent changes in the state of the object observed during the wait.
+	History []Observation
+	// Err is the context error that ended the wait.
+	Err error
+}
+
+// Error returns the error message.
+func (err *WaitTimeoutError) Error() string {
+	message := fmt.Sprintf("stopped waiting for %s: %v", err.Description, err.Err)
+
+	if errors.Is(err.Err, context.DeadlineExceeded) {
+		message = fmt.Sprintf("timed out waiting for %s: %v", err.Description, err.Err)
+	}
+
+	if summary := err.summary(); summary != "" {
+		message += fmt.Sprintf(" (%s)", summary)
+	}
+
+	return message
+}
+
+// Unwrap returns the context error that ended the wait.
+func (err *WaitTimeoutError) Unwrap() error {
+	return err.Err
+}
+
+// summary returns a one-line description of the last observed state of the object.
+func (err *WaitTimeoutError) summary() string {
+	if len(err.History) == 0 {
+		return ""
+	}
+
+	if err.LastObject == nil {
+		return "object not found"
+	}
+
+	var details []string
+
+	if fields := getStatusFields(err.Status); len(fields) > 0 {
+		details = append(details, "status: "+strings.Join(fields, ", "))
+	}
+
+	if conditions := getConditions(err.Status); len(conditions) > 0 {
+		var descriptions []string
+
+		for _, condition := range conditions {
+			descriptions = append(descriptions, condition.String())
+		}
+
+		details = append(details, "conditions: "+strings.Join(descriptions, ", "))
+	}
+
+	if len(err.Events) > 0 {
+		var descriptions []string
+
+		for _, event := range err.Events[max(0, len(err.Events)-maxSummaryEvents):] {
+			descriptions = append(descriptions,
+				fmt.Sprintf("%s %s: %s", event.Type, event.Reason, strings.TrimSpace(event.Message)))
+		}
+
+		details = append(details, "events: "+strings.Join(descriptions, "; "))
+	}
+
+	return strings.Join(details, "; ")
+}
+
+// For waits until the object from source satisfies condition according to options and returns the last observed
+// version of the object. The object is watched when possible and polled otherwise.
+func For[T runtime.Object](ctx context.Context, source Source[T], options Options, condition Condition[T]) (T, error) {
+	object, _, err := ForAny(ctx, source, options, condition)
+
+	return object, err
+}
+
+// ForAny waits until the object from source satisfies any of conditions according to options. It returns the last
+// observed version of the object and the index of the satisfied condition, or -1 with a *WaitTimeoutError if the wait
+// ends first.
+func ForAny[T runtime.Object](
+	ctx context.Context, source Source[T], options Options, conditions ...Condition[T]) (T, int, error) {
+	var object T
+
+	if len(conditions) == 0 {
+		return object, -1, fmt.Errorf("wait for %s requires at least one condition", options.Description)
+	}
+
+	if source.Get == nil {
+		return object, -1, fmt.Errorf("wait for %s requires a source with a get function", options.Description)
+	}
+
+	if options.PollInterval <= 0 {
+		options.PollInterval = DefaultPollInterval
+	}
+
+	if options.Timeout > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, options.Timeout)
+		defer cancel()
+	}
+
+	glog.V(100).Infof("Waiting for %s", options.Description)
+
+	objectWaiter := &waiter[T]{source: source, options: options, conditions: conditions, matched: -1}
+
+	return objectWaiter.run(ctx)
+}
+
+// waiter holds the state of a single wait.
+type waiter[T runtime.Object] struct {
+	source     Source[T]
+	options    Options
+	conditions []Condition[T]
+
+	object          T
+	exists          bool
+	resourceVersion string
+	matched         int
+	satisfiedSince  time.Time
+	holdTimer       *time.Timer
+	history         []Observation
+	lastConditions  map[string]ConditionTransition
+	transitions     []ConditionTransition
+}
+
+// run observes the object until the wait succeeds, fails or ctx is done.
+func (objectWaiter *waiter[T]) run(ctx context.Context) (T, int, error) {
+	var watcher watch.Interface
+
+	defer func() {
+		if watcher != nil {
+			watcher.Stop()
+		}
+
+		objectWaiter.stopHoldTimer()
+	}()
+
+	pollTicker := time.NewTicker(objectWaiter.options.PollInterval)
+	defer pollTicker.Stop()
+
+	resync := true
+
+	for {
+		if ctx.Err() != nil {
+			return objectWaiter.object, -1, objectWaiter.timeoutError(ctx.Err())
+		}
+
+		if resync {
+			resync = false
+
+			object, err := objectWaiter.source.Get(ctx)
+			if err = objectWaiter.observeGet(object, err); err != nil {
+				return objectWaiter.object, -1, err
+			}
+
+			if objectWaiter.isDone() {
+				return objectWaiter.object, objectWaiter.matched, nil
+			}
+
+			if watcher == nil {
+				watcher = objectWaiter.watch(ctx)
+			}
+		}
+
+		var events <-chan watch.Event
+		if watcher != nil {
+			events = watcher.ResultChan()
+		}
+
+		select {
+		case <-ctx.Done():
+			return objectWaiter.object, -1, objectWaiter.timeoutError(ctx.Err())
+		case <-objectWaiter.holdTimerChannel():
+		case <-pollTicker.C:
+			resync = watcher == nil
+		case event, open := <-events:
+			if !open {
+				watcher = nil
+				resync = true
+
+				continue
+			}
+
+			if err := objectWaiter.observeEvent(event); err != nil {
+				return objectWaiter.object, -1, err
+			}
+
+			if event.Type == watch.Error {
+				// The watch is retried on the next poll, the object being polled in the meantime.
+				watcher.Stop()
+				watcher = nil
+			}
+		}
+
+		if objectWaiter.isDone() {
+			return objectWaiter.object, objectWaiter.matched, nil
+		}
+	}
+}
+
+// watch starts watching the object, returning nil if it cannot be watched.
+func (objectWaiter *waiter[T]) watch(ctx context.Context) watch.Interface {
+	if objectWaiter.source.Watch == nil {
+		return nil
+	}
+
+	watcher, err := objectWaiter.source.Watch(ctx, objectWaiter.resourceVersion)
+	if err != nil {
+		glog.V(100).Infof("Failed to watch while waiting for %s, polling instead: %v", objectWaiter.options.Description, err)
+
+		return nil
+	}
+
+	return watcher
+}
+
+// observeGet records the result of getting the object.
+func (objectWaiter *waiter[T]) observeGet(object T, err error) error {
+	if k8serrors.IsNotFound(err) {
+		var missing T
+
+		return objectWaiter.observe(missing, false)
+	}
+
+	if err != nil {
+		glog.V(100).Infof("Failed to get object while waiting for %s: %v", objectWaiter.options.Description, err)
+
+		objectWaiter.record(Observation{
+			Time: time.Now(), Exists: objectWaiter.exists, Matched: objectWaiter.matched, Err: err})
+
+		return nil
+	}
+
+	return objectWaiter.observe(object, true)
+}
+
+// observeEvent records the change of the object reported by event.
+func (objectWaiter *waiter[T]) observeEvent(event watch.Event) error {
+	switch event.Type {
+	case watch.Added, watch.Modified, watch.Deleted:
+		object, ok := event.Object.(T)
+		if !ok || !objectWaiter.isWatched(object) {
+			return nil
+		}
+
+		if event.Type == watch.Deleted {
+			var missing T
+
+			return objectWaiter.observe(missing, false)
+		}
+
+		return objectWaiter.observe(object, true)
+	case watch.Error:
+		err := k8serrors.FromObject(event.Object)
+
+		glog.V(100).Infof("Watch failed while waiting for %s: %v", objectWaiter.options.Description, err)
+
+		objectWaiter.record(Observation{
+			Time: time.Now(), Exists: objectWaiter.exists, Matched: objectWaiter.matched, Err: err})
+	case watch.Bookmark:
+	}
+
+	return nil
+}
+
+// observe evaluates the conditions against the new state of the object and records it.
+func (objectWaiter *waiter[T]) observe(object T, exists bool) error {
+	matched := -1
+
+	if exists {
+		for index, condition := range objectWaiter.conditions {
+			satisfied, err := condition(object)
+			if err != nil {
+				return fmt.Errorf("failed to wait for %s: %w", objectWaiter.options.Description, err)
+			}
+
+			if satisfied {
+				matched = index
+
+				break
+			}
+		}
+	}
+
+	objectWaiter.object = object
+	objectWaiter.exists = exists
+	objectWaiter.resourceVersion = ""
+
+	if accessor, err := meta.Accessor(object); exists && err == nil {
+		objectWaiter.resourceVersion = accessor.GetResourceVersion()
+	}
+
+	if exists {
+		objectWaiter.recordConditions(getConditions(getStatus(object)))
+	}
+
+	switch {
+	case matched < 0:
+		objectWaiter.satisfiedSince = time.Time{}
+		objectWaiter.stopHoldTimer()
+	case objectWaiter.matched < 0:
+		objectWaiter.satisfiedSince = time.Now()
+
+		if objectWaiter.options.HoldFor > 0 {
+			objectWaiter.holdTimer = time.NewTimer(objectWaiter.options.HoldFor)
+		}
+	}
+
+	objectWaiter.matched = matched
+
+	objectWaiter.record(Observation{
+		Time: time.Now(), ResourceVersion: objectWaiter.resourceVersion, Exists: exists, Matched: matched})
+
+	return nil
+}
+
+// record appends observation to the history if the state of the object changed since the last observation.
+func (objectWaiter *waiter[T]) record(observation Observation) {
+	if length := len(objectWaiter.history); length > 0 {
+		last := objectWaiter.history[length-1]
+
+		if last.Exists == observation.Exists && last.Matched == observation.Matched &&
+			errorMessage(last.Err) == errorMessage(observation.Err) {
+			return
+		}
+	}
+
+	objectWaiter.history = append(objectWaiter.history, observation)
+
+	if len(objectWaiter.history) > maxHistoryLength {
+		objectWaiter.history = objectWaiter.history[len(objectWaiter.history)-maxHistoryLength:]
+	}
+}
+
+// recordConditions appends the conditions that changed since the last observation to the transitions.
+func (objectWaiter *waiter[T]) recordConditions(conditions []ConditionTransition) {
+	if objectWaiter.lastConditions == nil {
+		objectWaiter.lastConditions = map[string]ConditionTransition{}
+	}
+
+	for _, condition := range conditions {
+		last, found := objectWaiter.lastConditions[condition.Type]
+		if found && last.Status == condition.Status && last.Reason == condition.Reason {
+			continue
+		}
+
+		objectWaiter.lastConditions[condition.Type] = condition
+		objectWaiter.transitions = append(objectWaiter.transitions, condition)
+	}
+
+	if len(objectWaiter.transitions) > maxHistoryLength {
+		objectWaiter.transitions = objectWaiter.transitions[len(objectWaiter.transitions)-maxHistoryLength:]
+	}
+}
+
+// isDone returns true if a condition has been satisfied for long enough.
+func (objectWaiter *waiter[T]) isDone() bool {
+	return objectWaiter.matched >= 0 && time.Since(objectWaiter.satisfiedSince) >= objectWaiter.options.HoldFor
+}
+
+// isWatched returns true if object is the object the wait is about.
+func (objectWaiter *waiter[T]) isWatched(object T) bool {
+	if objectWaiter.source.Name == "" {
+		return true
+	}
+
+	accessor, err := meta.Accessor(object)
+
+	return err == nil && accessor.GetName() == objectWaiter.source.Name
+}
+
+// holdTimerChannel returns the channel of the hold timer, nil if no condition is being held.
+func (objectWaiter *waiter[T]) holdTimerChannel() <-chan time.Time {
+	if objectWaiter.holdTimer == nil {
+		return nil
+	}
+
+	return objectWaiter.holdTimer.C
+}
+
+// stopHoldTimer stops the hold timer if it is running.
+func (objectWaiter *waiter[T]) stopHoldTimer() {
+	if objectWaiter.holdTimer != nil {
+		objectWaiter.holdTimer.Stop()
+		objectWaiter.holdTimer = nil
+	}
+}
+
+// timeoutError returns the error ending the wait because of err, the context error.
+func (objectWaiter *waiter[T]) timeoutError(err error) *WaitTimeoutError {
+	glog.V(100).Infof("Stopped waiting for %s: %v", objectWaiter.options.Description, err)
+
+	timeoutErr := &WaitTimeoutError{
+		Description: objectWaiter.options.Description,
+		Conditions:  objectWaiter.transitions,
+		History:     objectWaiter.history,
+		Err:         err,
+	}
+
+	if objectWaiter.exists {
+		timeoutErr.LastObject = objectWaiter.object
+		timeoutErr.Status = getStatus(objectWaiter.object)
+	}
+
+	if objectWaiter.source.Events != nil && len(objectWaiter.history) > 0 {
+		// The context of the wait is done, so the events are listed with a new one.
+		ctx, cancel := context.WithTimeout(context.Background(), eventsTimeout)
+		defer cancel()
+
+		events, eventsErr := objectWaiter.source.Events(ctx)
+		if eventsErr != nil {
+			glog.V(100).Infof("Failed to list events after waiting for %s: %v", objectWaiter.options.Description, eventsErr)
+		}
+
+		timeoutErr.Events = events
+	}
+
+	return timeoutErr
+}
+
+// errorMessage returns the message of err or an empty string if err is nil.
+func errorMessage(err error) string {
+	if err == nil {
+		return ""
+	}
+
+	return err.Error()
+}
+
+// getStatus returns the status of object as unstructured content, nil if it has none.
+func getStatus(object runtime.Object) map[string]any {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(object)
+	if err != nil {
+		return nil
+	}
+
+	status, found, err := unstructured.NestedMap(content, "status")
+	if err != nil || !found {
+		return nil
+	}
+
+	return status
+}
+
+// getStatusFields returns the scalar top-level fields of status, such as the phase, formatted as key=value.
+func getStatusFields(status map[string]any) []string {
+	var fields []string
+
+	for key, value := range status {
+		switch value.(type) {
+		case string, bool, int64, float64:
+			fields = append(fields, fmt.Sprintf("%s=%v", key, value))
+		}
+	}
+
+	sort.Strings(fields)
+
+	return fields
+}
+
+// getConditions returns the standard conditions of status, with the time set to now.
+func getConditions(status map[string]any) []ConditionTransition {
+	rawConditions, found, err := unstructured.NestedSlice(status, "conditions")
+	if err != nil || !found {
+		return nil
+	}
+
+	var conditions []ConditionTransition
+
+	now := time.Now()
+
+	for _, rawCondition := range rawConditions {
+		condition, ok := rawCondition.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		conditionType, _, _ := unstructured.NestedString(condition, "type")
+		if conditionType == "" {
+			continue
+		}
+
+		conditionStatus, _, _ := unstructured.NestedString(condition, "status")
+		reason, _, _ := unstructured.NestedString(condition, "reason")
+		message, _, _ := unstructured.NestedString(condition, "message")
+
+		conditions = append(conditions, ConditionTransition{
+			Time: now, Type: conditionType, Status: conditionStatus, Reason: reason, Message: message})
+	}
+
+	return conditions
+}
@@ -0,0 +1,357 @@
+package waiter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	defaultConfigMapName      = "test-configmap"
+	defaultConfigMapNamespace = "test-namespace"
+)
+
+func TestWaiterForAny(t *testing.T) {
+	testCases := []struct {
+		data            map[string]string
+		exists          bool
+		expectedMatched int
+		expectedError   error
+		expectedMessage string
+	}{
+		{
+			data:            map[string]string{"state": "done"},
+			exists:          true,
+			expectedMatched: 0,
+		},
+		{
+			data:            map[string]string{"state": "failed"},
+			exists:          true,
+			expectedMatched: 1,
+		},
+		{
+			data:            map[string]string{"state": "running"},
+			exists:          true,
+			expectedMatched: -1,
+			expectedError:   context.DeadlineExceeded,
+			expectedMessage: "timed out waiting for test: context deadline exceeded",
+		},
+		{
+			exists:          false,
+			expectedMatched: -1,
+			expectedError:   context.DeadlineExceeded,
+			expectedMessage: "timed out waiting for test: context deadline exceeded (object not found)",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.exists {
+			runtimeObjects = append(runtimeObjects, buildDummyConfigMap(testCase.data))
+		}
+
+		testSettings := clients.GetTestClients(clients.TestClientParams{K8sMockObjects: runtimeObjects})
+
+		configMap, matched, err := ForAny(t.Context(), buildTestSource(testSettings),
+			Options{Description: "test", Timeout: 100 * time.Millisecond},
+			hasState("done"), hasState("failed"))
+
+		assert.Equal(t, testCase.expectedMatched, matched)
+
+		if testCase.expectedError == nil {
+			assert.Nil(t, err)
+			assert.Equal(t, testCase.data, configMap.Data)
+
+			continue
+		}
+
+		var timeoutErr *WaitTimeoutError
+
+		assert.ErrorIs(t, err, testCase.expectedError)
+		assert.True(t, errors.As(err, &timeoutErr))
+		assert.Equal(t, testCase.expectedMessage, err.Error())
+		assert.Len(t, timeoutErr.History, 1)
+		assert.Equal(t, testCase.exists, timeoutErr.History[0].Exists)
+		assert.Equal(t, testCase.exists, timeoutErr.LastObject != nil)
+	}
+}
+
+func TestWaiterForWatched(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: []runtime.Object{buildDummyConfigMap(map[string]string{"state": "running"})},
+	})
+
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		updateDummyConfigMap(t, testSettings, "done")
+	}()
+
+	start := time.Now()
+
+	configMap, err := For(t.Context(), buildTestSource(testSettings),
+		Options{Description: "test", Timeout: 5 * time.Second}, hasState("done"))
+	assert.Nil(t, err)
+	assert.Equal(t, "done", configMap.Data["state"])
+	assert.Less(t, time.Since(start), DefaultPollInterval)
+}
+
+func TestWaiterForPolled(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: []runtime.Object{buildDummyConfigMap(map[string]string{"state": "running"})},
+	})
+
+	source := buildTestSource(testSettings)
+	source.Watch = nil
+
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		updateDummyConfigMap(t, testSettings, "done")
+	}()
+
+	configMap, err := For(t.Context(), source,
+		Options{Description: "test", Timeout: 5 * time.Second, PollInterval: 50 * time.Millisecond}, hasState("done"))
+	assert.Nil(t, err)
+	assert.Equal(t, "done", configMap.Data["state"])
+}
+
+func TestWaiterForHoldFor(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: []runtime.Object{buildDummyConfigMap(map[string]string{"state": "done"})},
+	})
+
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		updateDummyConfigMap(t, testSettings, "running")
+		time.Sleep(100 * time.Millisecond)
+		updateDummyConfigMap(t, testSettings, "done")
+	}()
+
+	start := time.Now()
+
+	_, err := For(t.Context(), buildTestSource(testSettings),
+		Options{Description: "test", Timeout: 5 * time.Second, HoldFor: 300 * time.Millisecond}, hasState("done"))
+	assert.Nil(t, err)
+	assert.GreaterOrEqual(t, time.Since(start), 500*time.Millisecond)
+
+	_, err = For(t.Context(), buildTestSource(testSettings),
+		Options{Description: "test", Timeout: 200 * time.Millisecond, HoldFor: time.Second}, hasState("done"))
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestWaiterForDeleted(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: []runtime.Object{buildDummyConfigMap(map[string]string{"state": "done"})},
+	})
+
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+
+		err := testSettings.CoreV1Interface.ConfigMaps(defaultConfigMapNamespace).Delete(
+			t.Context(), defaultConfigMapName, metav1.DeleteOptions{})
+		assert.Nil(t, err)
+	}()
+
+	_, err := For(t.Context(), buildTestSource(testSettings),
+		Options{Description: "test", Timeout: 500 * time.Millisecond}, hasState("failed"))
+
+	var timeoutErr *WaitTimeoutError
+
+	assert.True(t, errors.As(err, &timeoutErr))
+	assert.Nil(t, timeoutErr.LastObject)
+	assert.Len(t, timeoutErr.History, 2)
+	assert.True(t, timeoutErr.History[0].Exists)
+	assert.False(t, timeoutErr.History[1].Exists)
+}
+
+func TestWaiterForTimeoutDetails(t *testing.T) {
+	testPod := buildDummyPod(corev1.PodPending, corev1.ConditionFalse, "Unschedulable")
+	testSettings := clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: []runtime.Object{
+			testPod,
+			buildDummyEvent("first", "FailedScheduling", "0/3 nodes are available", 1),
+			buildDummyEvent("second", "Scheduled", "Successfully assigned", 2),
+		},
+	})
+
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+
+		_, err := testSettings.CoreV1Interface.Pods(defaultConfigMapNamespace).UpdateStatus(t.Context(),
+			buildDummyPod(corev1.PodPending, corev1.ConditionTrue, ""), metav1.UpdateOptions{})
+		assert.Nil(t, err)
+	}()
+
+	source := WithEvents(NewTypedSource(testSettings.CoreV1Interface.Pods(defaultConfigMapNamespace), testPod.Name),
+		testSettings, "Pod", defaultConfigMapNamespace)
+
+	_, err := For(t.Context(), source, Options{Description: "test", Timeout: 500 * time.Millisecond},
+		func(pod *corev1.Pod) (bool, error) {
+			return pod.Status.Phase == corev1.PodRunning, nil
+		})
+
+	var timeoutErr *WaitTimeoutError
+
+	assert.True(t, errors.As(err, &timeoutErr))
+	assert.Equal(t, "Pending", timeoutErr.Status["phase"])
+	assert.Len(t, timeoutErr.Conditions, 2)
+	assert.Equal(t, "PodScheduled=False (Unschedulable)", timeoutErr.Conditions[0].String())
+	assert.Equal(t, "PodScheduled=True", timeoutErr.Conditions[1].String())
+	assert.Len(t, timeoutErr.Events, 2)
+	assert.Equal(t, "Scheduled", timeoutErr.Events[1].Reason)
+	assert.Equal(t, "timed out waiting for test: context deadline exceeded (status: phase=Pending; "+
+		"conditions: PodScheduled=True; events: Warning FailedScheduling: 0/3 nodes are available; "+
+		"Warning Scheduled: Successfully assigned)", err.Error())
+}
+
+func TestWithEvents(t *testing.T) {
+	source := WithEvents(Source[*corev1.Pod]{Name: "test"}, nil, "Pod", defaultConfigMapNamespace)
+	assert.Nil(t, source.Events)
+
+	testSettings := clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: []runtime.Object{buildDummyEvent("first", "Created", "Created container", 1)},
+	})
+
+	source = WithEvents(Source[*corev1.Pod]{Name: "test-pod"}, testSettings, "Pod", defaultConfigMapNamespace)
+	assert.NotNil(t, source.Events)
+
+	podEvents, err := source.Events(t.Context())
+	assert.Nil(t, err)
+	assert.Len(t, podEvents, 1)
+
+	source = WithEvents(Source[*corev1.Pod]{Name: "other-pod"}, testSettings, "Pod", defaultConfigMapNamespace)
+
+	podEvents, err = source.Events(t.Context())
+	assert.Nil(t, err)
+	assert.Empty(t, podEvents)
+}
+
+func TestWaiterForInvalid(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: []runtime.Object{buildDummyConfigMap(nil)},
+	})
+
+	testCases := []struct {
+		source        Source[*corev1.ConfigMap]
+		conditions    []Condition[*corev1.ConfigMap]
+		expectedError error
+	}{
+		{
+			source:        buildTestSource(testSettings),
+			conditions:    nil,
+			expectedError: fmt.Errorf("wait for test requires at least one condition"),
+		},
+		{
+			source:        Source[*corev1.ConfigMap]{},
+			conditions:    []Condition[*corev1.ConfigMap]{hasState("done")},
+			expectedError: fmt.Errorf("wait for test requires a source with a get function"),
+		},
+		{
+			source: buildTestSource(testSettings),
+			conditions: []Condition[*corev1.ConfigMap]{func(*corev1.ConfigMap) (bool, error) {
+				return false, fmt.Errorf("invalid state")
+			}},
+			expectedError: fmt.Errorf("failed to wait for test: invalid state"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		_, matched, err := ForAny(t.Context(), testCase.source,
+			Options{Description: "test", Timeout: time.Second}, testCase.conditions...)
+		assert.Equal(t, -1, matched)
+		assert.EqualError(t, err, testCase.expectedError.Error())
+	}
+}
+
+func TestWaiterForCanceled(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: []runtime.Object{buildDummyConfigMap(nil)},
+	})
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	_, err := For(ctx, buildTestSource(testSettings), Options{Description: "test"}, hasState("done"))
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, "stopped waiting for test: context canceled", err.Error())
+}
+
+func TestWaiterAllOf(t *testing.T) {
+	configMap := buildDummyConfigMap(map[string]string{"state": "done"})
+
+	satisfied, err := AllOf(hasState("done"), hasState("done"))(configMap)
+	assert.Nil(t, err)
+	assert.True(t, satisfied)
+
+	satisfied, err = AllOf(hasState("done"), hasState("failed"))(configMap)
+	assert.Nil(t, err)
+	assert.False(t, satisfied)
+}
+
+func buildTestSource(apiClient *clients.Settings) Source[*corev1.ConfigMap] {
+	return NewTypedSource(apiClient.CoreV1Interface.ConfigMaps(defaultConfigMapNamespace), defaultConfigMapName)
+}
+
+func buildDummyConfigMap(data map[string]string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      defaultConfigMapName,
+			Namespace: defaultConfigMapNamespace,
+		},
+		Data: data,
+	}
+}
+
+func updateDummyConfigMap(t *testing.T, apiClient *clients.Settings, state string) {
+	t.Helper()
+
+	_, err := apiClient.CoreV1Interface.ConfigMaps(defaultConfigMapNamespace).Update(
+		t.Context(), buildDummyConfigMap(map[string]string{"state": state}), metav1.UpdateOptions{})
+	assert.Nil(t, err)
+}
+
+func hasState(state string) Condition[*corev1.ConfigMap] {
+	return func(configMap *corev1.ConfigMap) (bool, error) {
+		return configMap.Data["state"] == state, nil
+	}
+}
+
+func buildDummyPod(
+	phase corev1.PodPhase, scheduled corev1.ConditionStatus, reason string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-pod",
+			Namespace: defaultConfigMapNamespace,
+		},
+		Status: corev1.PodStatus{
+			Phase: phase,
+			Conditions: []corev1.PodCondition{{
+				Type:   corev1.PodScheduled,
+				Status: scheduled,
+				Reason: reason,
+			}},
+		},
+	}
+}
+
+func buildDummyEvent(name, reason, message string, minute int) *corev1.Event {
+	return &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: defaultConfigMapNamespace,
+		},
+		InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "test-pod", Namespace: defaultConfigMapNamespace},
+		Type:           corev1.EventTypeWarning,
+		Reason:         reason,
+		Message:        message,
+		LastTimestamp:  metav1.NewTime(time.Date(2024, 1, 1, 0, minute, 0, 0, time.UTC)),
+	}
+}